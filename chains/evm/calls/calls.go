@@ -19,6 +19,7 @@ type ContractChecker interface {
 
 type ContractCaller interface {
 	CallContract(ctx context.Context, callArgs map[string]interface{}, blockNumber *big.Int) ([]byte, error)
+	BatchCallContract(ctx context.Context, callArgs []map[string]interface{}, blockNumber *big.Int) ([][]byte, error)
 }
 
 type GasPricer interface {
@@ -27,12 +28,17 @@ type GasPricer interface {
 
 type ClientDispatcher interface {
 	WaitAndReturnTxReceipt(h common.Hash) (*types.Receipt, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error)
 	SignAndSendTransaction(ctx context.Context, tx evmclient.CommonTransaction) (common.Hash, error)
+	SignTransaction(ctx context.Context, tx evmclient.CommonTransaction) ([]byte, error)
+	SendRawTransaction(ctx context.Context, tx []byte) error
 	GetTransactionByHash(h common.Hash) (tx *types.Transaction, isPending bool, err error)
 	UnsafeNonce() (*big.Int, error)
 	LockNonce()
 	UnlockNonce()
 	UnsafeIncreaseNonce() error
+	UnsafeResetNonce()
 	From() common.Address
 }
 
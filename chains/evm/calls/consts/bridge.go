@@ -2,3 +2,6 @@ package consts
 
 const BridgeABI = "[{\"inputs\":[{\"internalType\":\"uint8\",\"name\":\"domainID\",\"type\":\"uint8\"},{\"internalType\":\"address[]\",\"name\":\"initialRelayers\",\"type\":\"address[]\"},{\"internalType\":\"uint256\",\"name\":\"initialRelayerThreshold\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"fee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"expiry\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"destinationDomainID\",\"type\":\"uint8\"},{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"resourceID\",\"type\":\"bytes32\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"depositNonce\",\"type\":\"uint64\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"handlerResponse\",\"type\":\"bytes\"}],\"name\":\"Deposit\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"lowLevelData\",\"type\":\"bytes\"}],\"name\":\"FailedHandlerExecution\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"Paused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"originDomainID\",\"type\":\"uint8\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"depositNonce\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"enumBridge.ProposalStatus\",\"name\":\"status\",\"type\":\"uint8\"},{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"dataHash\",\"type\":\"bytes32\"}],\"name\":\"ProposalEvent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"originDomainID\",\"type\":\"uint8\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"depositNonce\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"enumBridge.ProposalStatus\",\"name\":\"status\",\"type\":\"uint8\"},{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"dataHash\",\"type\":\"bytes32\"}],\"name\":\"ProposalVote\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"relayer\",\"type\":\"address\"}],\"name\":\"RelayerAdded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"relayer\",\"type\":\"address\"}],\"name\":\"RelayerRemoved\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"newThreshold\",\"type\":\"uint256\"}],\"name\":\"RelayerThresholdChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"RoleGranted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"RoleRevoked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"Unpaused\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"DEFAULT_ADMIN_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MAX_RELAYERS\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"RELAYER_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"name\":\"_depositCounts\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"_domainID\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"_expiry\",\"outputs\":[{\"internalType\":\"uint40\",\"name\":\"\",\"type\":\"uint40\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"_fee\",\"outputs\":[{\"internalType\":\"uint128\",\"name\":\"\",\"type\":\"uint128\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"_relayerThreshold\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"name\":\"_resourceIDToHandlerAddress\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"}],\"name\":\"getRoleAdmin\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"index\",\"type\":\"uint256\"}],\"name\":\"getRoleMember\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"}],\"name\":\"getRoleMemberCount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"getRoleMemberIndex\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"grantRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"hasRole\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"isValidForwarder\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"paused\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"renounceRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"revokeRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint72\",\"name\":\"destNonce\",\"type\":\"uint72\"},{\"internalType\":\"bytes32\",\"name\":\"dataHash\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"relayer\",\"type\":\"address\"}],\"name\":\"_hasVotedOnProposal\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"relayer\",\"type\":\"address\"}],\"name\":\"isRelayer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newAdmin\",\"type\":\"address\"}],\"name\":\"renounceAdmin\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"adminPauseTransfers\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"adminUnpauseTransfers\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"newThreshold\",\"type\":\"uint256\"}],\"name\":\"adminChangeRelayerThreshold\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"relayerAddress\",\"type\":\"address\"}],\"name\":\"adminAddRelayer\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"relayerAddress\",\"type\":\"address\"}],\"name\":\"adminRemoveRelayer\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"handlerAddress\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"resourceID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"tokenAddress\",\"type\":\"address\"}],\"name\":\"adminSetResource\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"handlerAddress\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"resourceID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"contractAddress\",\"type\":\"address\"},{\"internalType\":\"bytes4\",\"name\":\"depositFunctionSig\",\"type\":\"bytes4\"},{\"internalType\":\"uint256\",\"name\":\"depositFunctionDepositerOffset\",\"type\":\"uint256\"},{\"internalType\":\"bytes4\",\"name\":\"executeFunctionSig\",\"type\":\"bytes4\"}],\"name\":\"adminSetGenericResource\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"handlerAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"tokenAddress\",\"type\":\"address\"}],\"name\":\"adminSetBurnable\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint8\",\"name\":\"domainID\",\"type\":\"uint8\"},{\"internalType\":\"uint64\",\"name\":\"nonce\",\"type\":\"uint64\"}],\"name\":\"adminSetDepositNonce\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"forwarder\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"valid\",\"type\":\"bool\"}],\"name\":\"adminSetForwarder\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint8\",\"name\":\"originDomainID\",\"type\":\"uint8\"},{\"internalType\":\"uint64\",\"name\":\"depositNonce\",\"type\":\"uint64\"},{\"internalType\":\"bytes32\",\"name\":\"dataHash\",\"type\":\"bytes32\"}],\"name\":\"getProposal\",\"outputs\":[{\"components\":[{\"internalType\":\"enumBridge.ProposalStatus\",\"name\":\"_status\",\"type\":\"uint8\"},{\"internalType\":\"uint200\",\"name\":\"_yesVotes\",\"type\":\"uint200\"},{\"internalType\":\"uint8\",\"name\":\"_yesVotesTotal\",\"type\":\"uint8\"},{\"internalType\":\"uint40\",\"name\":\"_proposedBlock\",\"type\":\"uint40\"}],\"internalType\":\"structBridge.Proposal\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"_totalRelayers\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"newFee\",\"type\":\"uint256\"}],\"name\":\"adminChangeFee\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"handlerAddress\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"adminWithdraw\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint8\",\"name\":\"destinationDomainID\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"resourceID\",\"type\":\"bytes32\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"deposit\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint8\",\"name\":\"domainID\",\"type\":\"uint8\"},{\"internalType\":\"uint64\",\"name\":\"depositNonce\",\"type\":\"uint64\"},{\"internalType\":\"bytes32\",\"name\":\"resourceID\",\"type\":\"bytes32\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"voteProposal\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint8\",\"name\":\"domainID\",\"type\":\"uint8\"},{\"internalType\":\"uint64\",\"name\":\"depositNonce\",\"type\":\"uint64\"},{\"internalType\":\"bytes32\",\"name\":\"dataHash\",\"type\":\"bytes32\"}],\"name\":\"cancelProposal\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint8\",\"name\":\"domainID\",\"type\":\"uint8\"},{\"internalType\":\"uint64\",\"name\":\"depositNonce\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"},{\"internalType\":\"bytes32\",\"name\":\"resourceID\",\"type\":\"bytes32\"},{\"internalType\":\"bool\",\"name\":\"revertOnFail\",\"type\":\"bool\"}],\"name\":\"executeProposal\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"addresspayable[]\",\"name\":\"addrs\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"amounts\",\"type\":\"uint256[]\"}],\"name\":\"transferFunds\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
 const BridgeBin = "0x60806040523480156200001157600080fd5b50604051620035f1380380620035f18339810160408190526200003491620003eb565b6000805460ff199081169091556002805490911660ff8716179055620000668362000176602090811b62001ab717901c565b600260016101000a81548160ff021916908360ff1602179055506200009682620001a960201b62001ade1760201c565b6002806101000a8154816001600160801b0302191690836001600160801b03160217905550620000d181620001d160201b62001b031760201c565b6002805464ffffffffff92909216600160901b0264ffffffffff60901b199092169190911790556200010e600062000108620001fb565b6200023e565b60005b84518110156200016a57620001617fe2b7fb3b832174769106daebcfd6d1970523240dda11281102db9363b83b0dc48683815181106200014d57fe5b60200260200101516200024e60201b60201c565b60010162000111565b50505050505062000613565b60006101008210620001a55760405162461bcd60e51b81526004016200019c9062000582565b60405180910390fd5b5090565b6000600160801b8210620001a55760405162461bcd60e51b81526004016200019c9062000514565b6000650100000000008210620001a55760405162461bcd60e51b81526004016200019c906200054b565b600033601436108015906200022857506001600160a01b03811660009081526005602052604090205460ff165b1562000239575060131936013560601c5b905090565b6200024a828262000294565b5050565b60008281526001602052604090206002015462000275906200026f620001fb565b6200030f565b6200023e5760405162461bcd60e51b81526004016200019c90620004c5565b6000828152600160209081526040909120620002bb91839062001b2a6200033e821b17901c565b156200024a57620002cb620001fb565b6001600160a01b0316816001600160a01b0316837f2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d60405160405180910390a45050565b600082815260016020908152604082206200033591849062001b3f62000355821b17901c565b90505b92915050565b600062000335836001600160a01b0384166200036c565b600062000335836001600160a01b038416620003bb565b60006200037a8383620003bb565b620003b25750815460018181018455600084815260208082209093018490558454848252828601909352604090209190915562000338565b50600062000338565b60009081526001919091016020526040902054151590565b80516001600160a01b03811681146200033857600080fd5b600080600080600060a0868803121562000403578081fd5b8551620004108162000600565b602087810151919650906001600160401b038111156200042e578283fd5b8701601f810189136200043f578283fd5b8051620004566200045082620005e0565b620005b9565b81815283810190838501858402850186018d101562000473578687fd5b8694505b83851015620004a1576200048c8d82620003d3565b83526001949094019391850191850162000477565b5060408b015160608c01516080909c01519a9d919c509a9998509650505050505050565b6020808252602f908201527f416363657373436f6e74726f6c3a2073656e646572206d75737420626520616e60408201526e0818591b5a5b881d1bc819dc985b9d608a1b606082015260800190565b6020808252601e908201527f76616c756520646f6573206e6f742066697420696e2031323820626974730000604082015260600190565b6020808252601d908201527f76616c756520646f6573206e6f742066697420696e2034302062697473000000604082015260600190565b6020808252601c908201527f76616c756520646f6573206e6f742066697420696e2038206269747300000000604082015260600190565b6040518181016001600160401b0381118282101715620005d857600080fd5b604052919050565b60006001600160401b03821115620005f6578081fd5b5060209081020190565b60ff811681146200061057600080fd5b50565b612fce80620006236000396000f3fe6080604052600436106102465760003560e01c806391c404ac11610139578063c5b37c22116100b6578063d15ef64e1161007a578063d15ef64e146106ae578063d547741f146106ce578063d7a9cd79146106ee578063edc20c3c14610703578063f8c39e4414610723578063ffaac0eb1461074357610246565b8063c5b37c221461060a578063c5ec89701461062c578063ca15c8731461064e578063cb10f2151461066e578063cdb0f73a1461068e57610246565b80639debb3bd116100fd5780639debb3bd14610573578063a217fddf14610588578063a9cf69fa1461059d578063bd2a1820146105ca578063c0331b3e146105ea57610246565b806391c404ac146104dc57806391d14854146104fc578063926d7d7f1461051c5780639d82dd63146105315780639dd694f41461055157610246565b8063541d5548116101c7578063802aabe81161018b578063802aabe81461044557806380ae1c281461045a57806384db809f1461046f5780638c0c26311461049c5780639010d07c146104bc57610246565b8063541d5548146103a35780635a1ad87c146103d05780635c975abb146103f05780635e1fab0f146104055780637febe63f1461042557610246565b806336568abe1161020e57806336568abe146102f65780634603ae38146103165780634b0b919d146103365780634e056005146103635780634e0df3f61461038357610246565b806305e2ca171461024b57806317f03ce514610260578063206a98fd14610280578063248a9ca3146102a05780632f2ff15d146102d6575b600080fd5b61025e61025936600461247a565b610758565b005b34801561026c57600080fd5b5061025e61027b3660046124f3565b6108f4565b34801561028c57600080fd5b5061025e61029b3660046125a0565b610b10565b3480156102ac57600080fd5b506102c06102bb366004612366565b610d60565b6040516102cd91906126b7565b60405180910390f35b3480156102e257600080fd5b5061025e6102f136600461237e565b610d75565b34801561030257600080fd5b5061025e61031136600461237e565b610dbd565b34801561032257600080fd5b5061025e6103313660046122fe565b610dff565b34801561034257600080fd5b5061035661035136600461245f565b610e8d565b6040516102cd9190612de8565b34801561036f57600080fd5b5061025e61037e366004612366565b610ea8565b34801561038f57600080fd5b506102c061039e36600461237e565b610f0d565b3480156103af57600080fd5b506103c36103be36600461213d565b610f39565b6040516102cd91906126ac565b3480156103dc57600080fd5b5061025e6103eb366004612203565b610f53565b3480156103fc57600080fd5b506103c3610fed565b34801561041157600080fd5b5061025e61042036600461213d565b610ff6565b34801561043157600080fd5b506103c3610440366004612435565b611052565b34801561045157600080fd5b506102c06110ea565b34801561046657600080fd5b5061025e611108565b34801561047b57600080fd5b5061048f61048a366004612366565b611122565b6040516102cd9190612698565b3480156104a857600080fd5b5061025e6104b7366004612159565b61113d565b3480156104c857600080fd5b5061048f6104d73660046123a2565b6111aa565b3480156104e857600080fd5b5061025e6104f7366004612366565b6111c9565b34801561050857600080fd5b506103c361051736600461237e565b611233565b34801561052857600080fd5b506102c061124b565b34801561053d57600080fd5b5061025e61054c36600461213d565b61125d565b34801561055d57600080fd5b506105666112d8565b6040516102cd9190612dfc565b34801561057f57600080fd5b506102c06112e1565b34801561059457600080fd5b506102c06112e6565b3480156105a957600080fd5b506105bd6105b83660046124f3565b6112eb565b6040516102cd9190612d77565b3480156105d657600080fd5b5061025e6105e5366004612272565b61139a565b3480156105f657600080fd5b5061025e610605366004612530565b6113d0565b34801561061657600080fd5b5061061f611821565b6040516102cd9190612dc2565b34801561063857600080fd5b50610641611836565b6040516102cd9190612dd6565b34801561065a57600080fd5b506102c0610669366004612366565b61184a565b34801561067a57600080fd5b5061025e6106893660046121c2565b611861565b34801561069a57600080fd5b5061025e6106a936600461213d565b6118f2565b3480156106ba57600080fd5b5061025e6106c9366004612191565b611995565b3480156106da57600080fd5b5061025e6106e936600461237e565b6119c8565b3480156106fa57600080fd5b50610566611a02565b34801561070f57600080fd5b5061025e61071e3660046124c6565b611a10565b34801561072f57600080fd5b506103c361073e36600461213d565b611a8a565b34801561074f57600080fd5b5061025e611a9f565b610760611b54565b6002546201000090046001600160801b031634146107995760405162461bcd60e51b815260040161079090612969565b60405180910390fd5b6000838152600460205260409020546001600160a01b0316806107ce5760405162461bcd60e51b815260040161079090612a07565b60ff85166000908152600360205260408120805467ffffffffffffffff19811660016001600160401b0392831601918216179091559061080c611b77565b60405163b07e54bb60e01b815290915083906060906001600160a01b0383169063b07e54bb90610846908b9087908c908c9060040161270d565b600060405180830381600087803b15801561086057600080fd5b505af1158015610874573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f1916820160405261089c91908101906123c3565b9050826001600160a01b03167f17bc3181e17a9620a479c24e6c606e474ba84fc036877b768926872e8cd0e11f8a8a878b8b876040516108e196959493929190612e0a565b60405180910390a2505050505050505050565b6108fc611bb6565b68ffffffffffffffff00600883901b1660ff841617610919612065565b6001600160481b0382166000908152600660209081526040808320868452909152908190208151608081019092528054829060ff16600481111561095957fe5b600481111561096457fe5b8152905461010081046001600160c81b03166020830152600160d01b810460ff166040830152600160d81b900464ffffffffff16606090910152805190915060018160048111156109b157fe5b14806109c8575060028160048111156109c657fe5b145b6109e45760405162461bcd60e51b815260040161079090612999565b600254606083015164ffffffffff600160901b909204821691610a0991439116611c0a565b64ffffffffff1611610a2d5760405162461bcd60e51b815260040161079090612af8565b60048083526001600160481b03841660009081526006602090815260408083208884529091529020835181548593839160ff1916906001908490811115610a7057fe5b02179055506020820151815460408085015160609095015164ffffffffff16600160d81b026001600160d81b0360ff909616600160d01b0260ff60d01b196001600160c81b0390951661010002610100600160d01b03199094169390931793909316919091179390931617905551600080516020612f5983398151915290610b0090889088906004908990612e5b565b60405180910390a1505050505050565b610b18611c4c565b610b20611b54565b60008281526004602090815260408083205490516001600160a01b039091169268ffffffffffffffff0060088a901b1660ff8b1617929091610b689185918a918a910161266c565b60408051601f1981840301815291815281516020928301206001600160481b03851660009081526006845282812082825290935291209091506002815460ff166004811115610bb357fe5b14610bd05760405162461bcd60e51b815260040161079090612ac3565b805460ff19166003178155838515610c495760405163712467f960e11b81526001600160a01b0382169063e248cff290610c12908a908d908d90600401612742565b600060405180830381600087803b158015610c2c57600080fd5b505af1158015610c40573d6000803e3d6000fd5b50505050610d26565b60405163712467f960e11b81526001600160a01b0382169063e248cff290610c79908a908d908d90600401612742565b600060405180830381600087803b158015610c9357600080fd5b505af1925050508015610ca4575060015b610d26573d808015610cd2576040519150601f19603f3d011682016040523d82523d6000602084013e610cd7565b606091505b50825460ff191660021783556040517fbd37c1f0d53bb2f33fe4c2104de272fcdeb4d2fef3acdbf1e4ddc3d6833ca37690610d1390839061275c565b60405180910390a1505050505050610d58565b600080516020612f598339815191528b8b600386604051610d4a9493929190612e5b565b60405180910390a150505050505b505050505050565b60009081526001602052604090206002015490565b600082815260016020526040902060020154610d9390610517611b77565b610daf5760405162461bcd60e51b8152600401610790906127e8565b610db98282611c82565b5050565b610dc5611b77565b6001600160a01b0316816001600160a01b031614610df55760405162461bcd60e51b815260040161079090612d28565b610db98282611ceb565b610e07611d54565b60005b83811015610e8657848482818110610e1e57fe5b9050602002016020810190610e33919061213d565b6001600160a01b03166108fc848484818110610e4b57fe5b905060200201359081150290604051600060405180830381858888f19350505050158015610e7d573d6000803e3d6000fd5b50600101610e0a565b5050505050565b6003602052600090815260409020546001600160401b031681565b610eb0611d54565b610eb981611ab7565b600260016101000a81548160ff021916908360ff1602179055507fa20d6b84cd798a24038be305eff8a45ca82ef54a2aa2082005d8e14c0a4746c881604051610f0291906126b7565b60405180910390a150565b60008281526001602081815260408084206001600160a01b038616855290920190529020545b92915050565b6000610f33600080516020612f7983398151915283611233565b610f5b611d54565b60008581526004602081905260409182902080546001600160a01b0319166001600160a01b038a16908117909155915163de319d9960e01b815288929163de319d9991610fb2918a918a918a918a918a91016126d7565b600060405180830381600087803b158015610fcc57600080fd5b505af1158015610fe0573d6000803e3d6000fd5b5050505050505050505050565b60005460ff1690565b610ffe611d54565b6000611008611b77565b9050816001600160a01b0316816001600160a01b0316141561103c5760405162461bcd60e51b815260040161079090612bd4565b611047600083610d75565b610db9600082610dbd565b6001600160481b038316600090815260066020908152604080832085845290915280822081516080810190925280546110e2929190829060ff16600481111561109757fe5b60048111156110a257fe5b8152905461010081046001600160c81b03166020830152600160d01b810460ff166040830152600160d81b900464ffffffffff1660609091015283611d7d565b949350505050565b6000611103600080516020612f7983398151915261184a565b905090565b611110611d54565b61112061111b611b77565b611da0565b565b6004602052600090815260409020546001600160a01b031681565b611145611d54565b6040516307b7ed9960e01b815282906001600160a01b038216906307b7ed9990611173908590600401612698565b600060405180830381600087803b15801561118d57600080fd5b505af11580156111a1573d6000803e3d6000fd5b50505050505050565b60008281526001602052604081206111c29083611de5565b9392505050565b6111d1611d54565b6002546201000090046001600160801b03168114156112025760405162461bcd60e51b815260040161079090612c77565b61120b81611ade565b6002806101000a8154816001600160801b0302191690836001600160801b0316021790555050565b60008281526001602052604081206111c29083611b3f565b600080516020612f7983398151915281565b611275600080516020612f7983398151915282611233565b6112915760405162461bcd60e51b815260040161079090612894565b6112a9600080516020612f79833981519152826119c8565b7f10e1f7ce9fd7d1b90a66d13a2ab3cb8dd7f29f3f8d520b143b063ccfbab6906b81604051610f029190612698565b60025460ff1681565b60c881565b600081565b6112f3612065565b6001600160481b0360ff858116600886901b68ffffffffffffffff001617918216600090815260066020908152604080832087845290915290819020815160808101909252805491929091839116600481111561134c57fe5b600481111561135757fe5b8152905461010081046001600160c81b03166020830152600160d01b810460ff166040830152600160d81b900464ffffffffff1660609091015295945050505050565b6113a2611d54565b60405163025a3c9960e21b815282906001600160a01b03821690630968f2649061117390859060040161275c565b6113d8611c4c565b6113e0611b54565b60008381526004602090815260408083205490516001600160a01b039091169268ffffffffffffffff00600889901b1660ff8a1617929091611428918591889188910161266c565b604051602081830303815290604052805190602001209050611448612065565b6001600160481b0383166000908152600660209081526040808320858452909152908190208151608081019092528054829060ff16600481111561148857fe5b600481111561149357fe5b8152905461010081046001600160c81b0316602080840191909152600160d01b820460ff16604080850191909152600160d81b90920464ffffffffff1660609093019290925260008a815260049092529020549091506001600160a01b031661150e5760405162461bcd60e51b815260040161079090612cae565b60028151600481111561151d57fe5b141561153b57611532898988888b6001610b10565b50505050610e86565b6000611545611b77565b905060018260000151600481111561155957fe5b11156115775760405162461bcd60e51b815260040161079090612ce5565b6115818282611d7d565b1561159e5760405162461bcd60e51b815260040161079090612837565b6000825160048111156115ad57fe5b141561160d576040805160808101825260018082526000602083018190528284015264ffffffffff431660608301529151909350600080516020612f5983398151915291611600918d918d918890612e5b565b60405180910390a161166f565b600254606083015164ffffffffff600160901b90920482169161163291439116611c0a565b64ffffffffff16111561166f576004808352604051600080516020612f5983398151915291611666918d918d918890612e5b565b60405180910390a15b60048251600481111561167e57fe5b14611746576116a361168f82611df1565b83602001516001600160c81b031617611e1f565b6001600160c81b031660208301526040808301805160010160ff169052825190517f25f8daaa4635a7729927ba3f5b3d59cc3320aca7c32c9db4e7ca7b9574343640916116f5918d918d918890612e5b565b60405180910390a1600254604083015160ff6101009092048216911610611746576002808352604051600080516020612f598339815191529161173d918d918d918890612e5b565b60405180910390a15b6001600160481b038416600090815260066020908152604080832086845290915290208251815484929190829060ff1916600183600481111561178557fe5b021790555060208201518154604084015160609094015164ffffffffff16600160d81b026001600160d81b0360ff909516600160d01b0260ff60d01b196001600160c81b0390941661010002610100600160d01b03199093169290921792909216179290921691909117905560028251600481111561180057fe5b1415611815576118158a8a89898c6000610b10565b50505050505050505050565b6002546201000090046001600160801b031681565b600254600160901b900464ffffffffff1681565b6000818152600160205260408120610f3390611e44565b611869611d54565b60008281526004602081905260409182902080546001600160a01b0319166001600160a01b0387169081179091559151635c7d1b9b60e11b815285929163b8fa3736916118ba9187918791016126c0565b600060405180830381600087803b1580156118d457600080fd5b505af11580156118e8573d6000803e3d6000fd5b5050505050505050565b61190a600080516020612f7983398151915282611233565b156119275760405162461bcd60e51b815260040161079090612b9d565b60c86119316110ea565b1061194e5760405162461bcd60e51b815260040161079090612939565b611966600080516020612f7983398151915282610d75565b7f03580ee9f53a62b7cb409a2cb56f9be87747dd15017afc5cef6eef321e4fb2c581604051610f029190612698565b61199d611d54565b6001600160a01b03919091166000908152600560205260409020805460ff1916911515919091179055565b6000828152600160205260409020600201546119e690610517611b77565b610df55760405162461bcd60e51b815260040161079090612a73565b600254610100900460ff1681565b611a18611d54565b60ff82166000908152600360205260409020546001600160401b0390811690821611611a565760405162461bcd60e51b815260040161079090612b57565b60ff919091166000908152600360205260409020805467ffffffffffffffff19166001600160401b03909216919091179055565b60056020526000908152604090205460ff1681565b611aa7611d54565b611120611ab2611b77565b611e4f565b60006101008210611ada5760405162461bcd60e51b8152600401610790906129d0565b5090565b6000600160801b8210611ada5760405162461bcd60e51b8152600401610790906128cb565b6000650100000000008210611ada5760405162461bcd60e51b815260040161079090612902565b60006111c2836001600160a01b038416611e91565b60006111c2836001600160a01b038416611edb565b60005460ff16156111205760405162461bcd60e51b815260040161079090612b2d565b60003360143610801590611ba357506001600160a01b03811660009081526005602052604090205460ff165b1561110357505036601319013560601c90565b6000611bc0611b77565b9050611bcd600082611233565b80611beb5750611beb600080516020612f7983398151915282611233565b611c075760405162461bcd60e51b8152600401610790906127b1565b50565b60006111c283836040518060400160405280601e81526020017f536166654d6174683a207375627472616374696f6e206f766572666c6f770000815250611ef3565b611c66600080516020612f79833981519152610517611b77565b6111205760405162461bcd60e51b815260040161079090612c0b565b6000828152600160205260409020611c9a9082611b2a565b15610db957611ca7611b77565b6001600160a01b0316816001600160a01b0316837f2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d60405160405180910390a45050565b6000828152600160205260409020611d039082611f1f565b15610db957611d10611b77565b6001600160a01b0316816001600160a01b0316837ff6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b60405160405180910390a45050565b611d616000610517611b77565b6111205760405162461bcd60e51b815260040161079090612c40565b60008083602001516001600160c81b0316611d9784611df1565b16119392505050565b611da8611b54565b6000805460ff191660011790556040517f62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a25890610f02908390612698565b60006111c28383611f34565b6000611e15611e0e600080516020612f7983398151915284610f0d565b6001611c0a565b6001901b92915050565b6000600160c81b8210611ada5760405162461bcd60e51b815260040161079090612a3c565b6000610f3382611f79565b611e57611f7d565b6000805460ff191690556040517f5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa90610f02908390612698565b6000611e9d8383611edb565b611ed357508154600181810184556000848152602080822090930184905584548482528286019093526040902091909155610f33565b506000610f33565b60009081526001919091016020526040902054151590565b60008184841115611f175760405162461bcd60e51b8152600401610790919061275c565b505050900390565b60006111c2836001600160a01b038416611f9f565b81546000908210611f575760405162461bcd60e51b81526004016107909061276f565b826000018281548110611f6657fe5b9060005260206000200154905092915050565b5490565b60005460ff166111205760405162461bcd60e51b815260040161079090612866565b6000818152600183016020526040812054801561205b5783546000198083019190810190600090879083908110611fd257fe5b9060005260206000200154905080876000018481548110611fef57fe5b60009182526020808320909101929092558281526001898101909252604090209084019055865487908061201f57fe5b60019003818190600052602060002001600090559055866001016000878152602001908152602001600020600090556001945050505050610f33565b6000915050610f33565b604080516080810190915280600081526000602082018190526040820181905260609091015290565b60008083601f84011261209f578182fd5b5081356001600160401b038111156120b5578182fd5b60208301915083602080830285010111156120cf57600080fd5b9250929050565b60008083601f8401126120e7578182fd5b5081356001600160401b038111156120fd578182fd5b6020830191508360208285010111156120cf57600080fd5b80356001600160401b0381168114610f3357600080fd5b803560ff81168114610f3357600080fd5b60006020828403121561214e578081fd5b81356111c281612f09565b6000806040838503121561216b578081fd5b823561217681612f09565b9150602083013561218681612f09565b809150509250929050565b600080604083850312156121a3578182fd5b82356121ae81612f09565b915060208301358015158114612186578182fd5b6000806000606084860312156121d6578081fd5b83356121e181612f09565b92506020840135915060408401356121f881612f09565b809150509250925092565b60008060008060008060c0878903121561221b578182fd5b863561222681612f09565b955060208701359450604087013561223d81612f09565b9350606087013561224d81612f1e565b92506080870135915060a087013561226481612f1e565b809150509295509295509295565b60008060408385031215612284578182fd5b823561228f81612f09565b915060208301356001600160401b038111156122a9578182fd5b8301601f810185136122b9578182fd5b80356122cc6122c782612eb6565b612e90565b8181528660208385010111156122e0578384fd5b81602084016020830137908101602001929092525090939092509050565b60008060008060408587031215612313578384fd5b84356001600160401b0380821115612329578586fd5b6123358883890161208e565b9096509450602087013591508082111561234d578384fd5b5061235a8782880161208e565b95989497509550505050565b600060208284031215612377578081fd5b5035919050565b60008060408385031215612390578182fd5b82359150602083013561218681612f09565b600080604083850312156123b4578182fd5b50508035926020909101359150565b6000602082840312156123d4578081fd5b81516001600160401b038111156123e9578182fd5b8201601f810184136123f9578182fd5b80516124076122c782612eb6565b81815285602083850101111561241b578384fd5b61242c826020830160208601612ed9565b95945050505050565b600080600060608486031215612449578081fd5b83356001600160481b03811681146121e1578182fd5b600060208284031215612470578081fd5b6111c2838361212c565b6000806000806060858703121561248f578182fd5b612499868661212c565b93506020850135925060408501356001600160401b038111156124ba578283fd5b61235a878288016120d6565b600080604083850312156124d8578182fd5b82356124e381612f49565b9150602083013561218681612f34565b600080600060608486031215612507578081fd5b612511858561212c565b92506125208560208601612115565b9150604084013590509250925092565b600080600080600060808688031215612547578283fd5b853561255281612f49565b9450602086013561256281612f34565b93506040860135925060608601356001600160401b03811115612583578182fd5b61258f888289016120d6565b969995985093965092949392505050565b60008060008060008060a087890312156125b8578384fd5b6125c2888861212c565b95506125d18860208901612115565b945060408701356001600160401b038111156125eb578485fd5b6125f789828a016120d6565b9095509350506060870135915060808701358015158114612264578182fd5b60008284528282602086013780602084860101526020601f19601f85011685010190509392505050565b60008151808452612658816020860160208601612ed9565b601f01601f19169290920160200192915050565b60006bffffffffffffffffffffffff198560601b16825282846014840137910160140190815292915050565b6001600160a01b0391909116815260200190565b901515815260200190565b90815260200190565b9182526001600160a01b0316602082015260400190565b9485526001600160a01b039390931660208501526001600160e01b03199182166040850152606084015216608082015260a00190565b8481526001600160a01b03841660208201526060604082018190526000906127389083018486612616565b9695505050505050565b60008482526040602083015261242c604083018486612616565b6000602082526111c26020830184612640565b60208082526022908201527f456e756d657261626c655365743a20696e646578206f7574206f6620626f756e604082015261647360f01b606082015260800190565b6020808252601e908201527f73656e646572206973206e6f742072656c61796572206f722061646d696e0000604082015260600190565b6020808252602f908201527f416363657373436f6e74726f6c3a2073656e646572206d75737420626520616e60408201526e0818591b5a5b881d1bc819dc985b9d608a1b606082015260800190565b6020808252601590820152741c995b185e595c88185b1c9958591e481d9bdd1959605a1b604082015260600190565b60208082526014908201527314185d5cd8589b194e881b9bdd081c185d5cd95960621b604082015260600190565b6020808252601f908201527f6164647220646f65736e277420686176652072656c6179657220726f6c652100604082015260600190565b6020808252601e908201527f76616c756520646f6573206e6f742066697420696e2031323820626974730000604082015260600190565b6020808252601d908201527f76616c756520646f6573206e6f742066697420696e2034302062697473000000604082015260600190565b6020808252601690820152751c995b185e595c9cc81b1a5b5a5d081c995858da195960521b604082015260600190565b602080825260169082015275125b98dbdc9c9958dd08199959481cdd5c1c1b1a595960521b604082015260600190565b6020808252601c908201527f50726f706f73616c2063616e6e6f742062652063616e63656c6c656400000000604082015260600190565b6020808252601c908201527f76616c756520646f6573206e6f742066697420696e2038206269747300000000604082015260600190565b6020808252818101527f7265736f757263654944206e6f74206d617070656420746f2068616e646c6572604082015260600190565b6020808252601e908201527f76616c756520646f6573206e6f742066697420696e2032303020626974730000604082015260600190565b60208082526030908201527f416363657373436f6e74726f6c3a2073656e646572206d75737420626520616e60408201526f2061646d696e20746f207265766f6b6560801b606082015260800190565b6020808252818101527f50726f706f73616c206d75737420686176652050617373656420737461747573604082015260600190565b6020808252818101527f50726f706f73616c206e6f7420617420657870697279207468726573686f6c64604082015260600190565b60208082526010908201526f14185d5cd8589b194e881c185d5cd95960821b604082015260600190565b60208082526026908201527f446f6573206e6f7420616c6c6f772064656372656d656e7473206f6620746865604082015265206e6f6e636560d01b606082015260800190565b6020808252601e908201527f6164647220616c7265616479206861732072656c6179657220726f6c65210000604082015260600190565b60208082526017908201527f43616e6e6f742072656e6f756e6365206f6e6573656c66000000000000000000604082015260600190565b6020808252818101527f73656e64657220646f65736e277420686176652072656c6179657220726f6c65604082015260600190565b6020808252601e908201527f73656e64657220646f65736e277420686176652061646d696e20726f6c650000604082015260600190565b6020808252601f908201527f43757272656e742066656520697320657175616c20746f206e65772066656500604082015260600190565b60208082526019908201527f6e6f2068616e646c657220666f72207265736f75726365494400000000000000604082015260600190565b60208082526023908201527f70726f706f73616c20616c72656164792065786563757465642f63616e63656c6040820152621b195960ea1b606082015260800190565b6020808252602f908201527f416363657373436f6e74726f6c3a2063616e206f6e6c792072656e6f756e636560408201526e103937b632b9903337b91039b2b63360891b606082015260800190565b8151608082019060058110612d8857fe5b82526020838101516001600160c81b03169083015260408084015160ff169083015260609283015164ffffffffff16929091019190915290565b6001600160801b0391909116815260200190565b64ffffffffff91909116815260200190565b6001600160401b0391909116815260200190565b60ff91909116815260200190565b600060ff881682528660208301526001600160401b038616604083015260a06060830152612e3c60a083018587612616565b8281036080840152612e4e8185612640565b9998505050505050505050565b60ff851681526001600160401b03841660208201526080810160058410612e7e57fe5b60408201939093526060015292915050565b6040518181016001600160401b0381118282101715612eae57600080fd5b604052919050565b60006001600160401b03821115612ecb578081fd5b50601f01601f191660200190565b60005b83811015612ef4578181015183820152602001612edc565b83811115612f03576000848401525b50505050565b6001600160a01b0381168114611c0757600080fd5b6001600160e01b031981168114611c0757600080fd5b6001600160401b0381168114611c0757600080fd5b60ff81168114611c0757600080fdfe968626a768e76ba1363efe44e322a6c4900c5f084e0b45f35e294dfddaa9e0d5e2b7fb3b832174769106daebcfd6d1970523240dda11281102db9363b83b0dc4a2646970667358221220d0bf81b65bf25caa457c2f43685e675f99b70356107a8a4a1ccf0090b757cab364736f6c634300060c0033"
+
+// BridgeRuntimeCodeHash is the keccak256 hash of the runtime code deployed by BridgeBin
+const BridgeRuntimeCodeHash = "0x022027202be81d62f8a149a67096c317a6e1be7a3a9ab9a4f34a240b9f01445d"
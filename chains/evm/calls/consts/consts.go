@@ -3,8 +3,27 @@ package consts
 import "time"
 
 const DefaultGasLimit = 2000000
+const DefaultMinGasLimit = 21000
+const DefaultGasLimitMultiplier = 1.2
 const DefaultDeployGasLimit = 6000000
 const DefaultGasPrice = 20000000000
 const DefaultGasMultiplier = 1
+const DefaultFeeHistoryBlocks = 20
+
+// Gas price speeds select priority fee percentile and base fee inclusion horizon of fee history gas pricer
+const (
+	GasPriceSpeedSlow   = "slow"
+	GasPriceSpeedNormal = "normal"
+	GasPriceSpeedFast   = "fast"
+)
+
+// Units of prices returned by gas oracle
+const (
+	GasOracleUnitWei  = "wei"
+	GasOracleUnitGwei = "gwei"
+)
 const DefaultBlockConfirmations = 10
 const DefaultBlockRetryInterval = 5 * time.Second
+const DefaultRPCTimeout = 30 * time.Second
+const DefaultRPCRetries = 3
+const DefaultRPCRetryDelay = time.Second
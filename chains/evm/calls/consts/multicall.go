@@ -0,0 +1,6 @@
+package consts
+
+// MulticallForwarderABI is the ABI of a multicall contract that forwards each call with
+// the sender address appended to calldata as specified by ERC-2771.
+// Contract source is at chains/evm/calls/contracts/multicall/MulticallForwarder.sol
+const MulticallForwarderABI = `[{"inputs":[{"components":[{"internalType":"address","name":"target","type":"address"},{"internalType":"bytes","name":"callData","type":"bytes"}],"internalType":"struct MulticallForwarder.Call[]","name":"calls","type":"tuple[]"}],"name":"aggregate","outputs":[{"internalType":"uint256","name":"blockNumber","type":"uint256"},{"internalType":"bytes[]","name":"returnData","type":"bytes[]"}],"stateMutability":"nonpayable","type":"function"}]`
@@ -0,0 +1,259 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package bridge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/consts"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/voter/proposal"
+	bridgeTypes "github.com/ChainSafe/chainbridge-core/types"
+	"github.com/ChainSafe/chainbridge-core/util"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rs/zerolog/log"
+)
+
+// DefaultVersion is the version of the bridge contract compiled into consts.BridgeABI
+const DefaultVersion = "v2"
+
+// VoteProposalCall holds arguments of a voteProposal contract call
+type VoteProposalCall struct {
+	Source       uint8
+	DepositNonce uint64
+	ResourceID   bridgeTypes.ResourceID
+	Data         []byte
+}
+
+// ABIAdapter encodes and decodes calls and events of a specific bridge contract version
+type ABIAdapter interface {
+	Version() string
+	ABI() abi.ABI
+	Bytecode() []byte
+	// MatchesCode checks if deployed contract runtime code belongs to the adapter version
+	MatchesCode(code []byte) bool
+	// EventSig returns signature the bridge event has in the adapter version.
+	// Events are identified by util.EventSig signatures of the default version.
+	EventSig(event util.EventSig) util.EventSig
+	DepositEvent() util.EventSig
+	UnpackDepositLog(l types.Log) (*evmclient.DepositLogs, error)
+	UnpackEventLog(l types.Log) (*Event, error)
+	PackVoteProposal(p *proposal.Proposal) ([]byte, error)
+	UnpackVoteProposal(calldata []byte) (*VoteProposalCall, error)
+	PackExecuteProposal(p *proposal.Proposal, revertOnFail bool) ([]byte, error)
+}
+
+var (
+	// adapters are kept in registration order so that code detection is deterministic
+	adapters     []ABIAdapter
+	adaptersLock sync.RWMutex
+)
+
+func init() {
+	a, err := NewABIAdapter(DefaultVersion, consts.BridgeABI, consts.BridgeBin, common.HexToHash(consts.BridgeRuntimeCodeHash))
+	if err != nil {
+		panic(err)
+	}
+	RegisterABIAdapter(a)
+}
+
+// RegisterABIAdapter makes adapter available for selection by version and for code detection.
+// Adapter registered earlier for the same version is replaced.
+func RegisterABIAdapter(a ABIAdapter) {
+	adaptersLock.Lock()
+	defer adaptersLock.Unlock()
+	for i, registered := range adapters {
+		if registered.Version() == a.Version() {
+			adapters[i] = a
+			return
+		}
+	}
+	adapters = append(adapters, a)
+}
+
+// DefaultABIAdapter returns adapter of the bridge version compiled into consts.BridgeABI
+func DefaultABIAdapter() ABIAdapter {
+	a, _ := GetABIAdapter(DefaultVersion)
+	return a
+}
+
+// GetABIAdapter returns registered adapter for version
+func GetABIAdapter(version string) (ABIAdapter, error) {
+	adaptersLock.RLock()
+	defer adaptersLock.RUnlock()
+	return getABIAdapter(version)
+}
+
+func getABIAdapter(version string) (ABIAdapter, error) {
+	for _, a := range adapters {
+		if a.Version() == version {
+			return a, nil
+		}
+	}
+	return nil, fmt.Errorf("unsupported bridge version %s", version)
+}
+
+// DetectABIAdapter reads code deployed at bridge address and returns the adapter of matching version.
+// Adapters are matched in registration order. Error is returned if code does not match any registered version.
+func DetectABIAdapter(ctx context.Context, client calls.ContractChecker, address common.Address) (ABIAdapter, error) {
+	code, err := client.CodeAt(ctx, address, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(code) == 0 {
+		return nil, fmt.Errorf("no code at bridge address %s", address.Hex())
+	}
+
+	adaptersLock.RLock()
+	defer adaptersLock.RUnlock()
+	for _, a := range adapters {
+		if a.MatchesCode(code) {
+			log.Info().Str("bridge", address.Hex()).Str("version", a.Version()).Msg("Detected bridge contract version")
+			return a, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown code hash %s of bridge %s, bridge version has to be configured", crypto.Keccak256Hash(code).Hex(), address.Hex())
+}
+
+// abiAdapter is an ABIAdapter for bridge versions that share the proposal function
+// layout of the default version. Event signatures are read from the version ABI.
+type abiAdapter struct {
+	version    string
+	abi        abi.ABI
+	bytecode   []byte
+	codeHashes map[common.Hash]bool
+}
+
+// NewABIAdapter creates an adapter from bridge ABI and creation bytecode. Deployed code is matched
+// with the adapter if its hash is one of runtime code hashes of the version in codeHashes.
+func NewABIAdapter(version string, abiJSON string, bin string, codeHashes ...common.Hash) (ABIAdapter, error) {
+	a, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := make(map[common.Hash]bool)
+	for _, h := range codeHashes {
+		hashes[h] = true
+	}
+	return &abiAdapter{
+		version:    version,
+		abi:        a,
+		bytecode:   common.FromHex(bin),
+		codeHashes: hashes,
+	}, nil
+}
+
+func (a *abiAdapter) Version() string {
+	return a.version
+}
+
+func (a *abiAdapter) ABI() abi.ABI {
+	return a.abi
+}
+
+func (a *abiAdapter) Bytecode() []byte {
+	return a.bytecode
+}
+
+// MatchesCode checks if hash of deployed code is one of runtime code hashes of the version
+func (a *abiAdapter) MatchesCode(code []byte) bool {
+	if len(code) == 0 {
+		return false
+	}
+	return a.codeHashes[crypto.Keccak256Hash(code)]
+}
+
+// EventSig returns signature of the event with the same name in the adapter ABI
+// or event itself if ABI does not declare it
+func (a *abiAdapter) EventSig(event util.EventSig) util.EventSig {
+	e, ok := a.abi.Events[eventName(event)]
+	if !ok {
+		return event
+	}
+	return util.EventSig(e.Sig)
+}
+
+func (a *abiAdapter) DepositEvent() util.EventSig {
+	return a.EventSig(util.Deposit)
+}
+
+func (a *abiAdapter) UnpackDepositLog(l types.Log) (*evmclient.DepositLogs, error) {
+	if len(l.Topics) == 0 || l.Topics[0] != a.DepositEvent().GetTopic() {
+		return nil, errors.New("log is not a deposit event")
+	}
+	evt, err := a.UnpackEventLog(l)
+	if err != nil {
+		return nil, err
+	}
+	deposit, ok := evt.Data.(*evmclient.DepositLogs)
+	if !ok {
+		return nil, errors.New("log is not a deposit event")
+	}
+	return deposit, nil
+}
+
+func (a *abiAdapter) UnpackEventLog(l types.Log) (*Event, error) {
+	return UnpackEventLog(a.abi, l)
+}
+
+func (a *abiAdapter) PackVoteProposal(p *proposal.Proposal) ([]byte, error) {
+	return a.abi.Pack("voteProposal", p.Source, p.DepositNonce, p.ResourceId, p.Data)
+}
+
+func (a *abiAdapter) UnpackVoteProposal(calldata []byte) (*VoteProposalCall, error) {
+	if len(calldata) < 4 {
+		return nil, errors.New("calldata too short")
+	}
+	m, err := a.abi.MethodById(calldata[:4])
+	if err != nil {
+		return nil, err
+	}
+	if m.Name != "voteProposal" {
+		return nil, fmt.Errorf("calldata is a %s call", m.Name)
+	}
+
+	values, err := m.Inputs.UnpackValues(calldata[4:])
+	if err != nil {
+		return nil, err
+	}
+	if len(values) != 4 {
+		return nil, fmt.Errorf("voteProposal call has %d arguments", len(values))
+	}
+	source, ok := values[0].(uint8)
+	if !ok {
+		return nil, errors.New("invalid voteProposal domain ID")
+	}
+	depositNonce, ok := values[1].(uint64)
+	if !ok {
+		return nil, errors.New("invalid voteProposal deposit nonce")
+	}
+	resourceID, ok := values[2].([32]byte)
+	if !ok {
+		return nil, errors.New("invalid voteProposal resource ID")
+	}
+	data, ok := values[3].([]byte)
+	if !ok {
+		return nil, errors.New("invalid voteProposal data")
+	}
+	return &VoteProposalCall{
+		Source:       source,
+		DepositNonce: depositNonce,
+		ResourceID:   resourceID,
+		Data:         data,
+	}, nil
+}
+
+func (a *abiAdapter) PackExecuteProposal(p *proposal.Proposal, revertOnFail bool) ([]byte, error) {
+	return a.abi.Pack("executeProposal", p.Source, p.DepositNonce, p.Data, p.ResourceId, revertOnFail)
+}
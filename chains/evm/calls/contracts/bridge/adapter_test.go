@@ -0,0 +1,197 @@
+package bridge_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
+	mock_calls "github.com/ChainSafe/chainbridge-core/chains/evm/calls/mock"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/voter/proposal"
+	"github.com/ChainSafe/chainbridge-core/util"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ABIAdapterTestSuite struct {
+	suite.Suite
+	mockContractCaller *mock_calls.MockContractCallerDispatcher
+	adapter            bridge.ABIAdapter
+	bridgeAddress      common.Address
+}
+
+func TestRunABIAdapterTestSuite(t *testing.T) {
+	suite.Run(t, new(ABIAdapterTestSuite))
+}
+
+func (s *ABIAdapterTestSuite) SetupSuite()    {}
+func (s *ABIAdapterTestSuite) TearDownSuite() {}
+func (s *ABIAdapterTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.mockContractCaller = mock_calls.NewMockContractCallerDispatcher(gomockController)
+	s.adapter = bridge.DefaultABIAdapter()
+	s.bridgeAddress = common.HexToAddress(testContractAddress)
+}
+func (s *ABIAdapterTestSuite) TearDownTest() {}
+
+func (s *ABIAdapterTestSuite) TestGetABIAdapter_UnsupportedVersion() {
+	_, err := bridge.GetABIAdapter("v0")
+
+	s.NotNil(err)
+}
+
+func (s *ABIAdapterTestSuite) TestGetABIAdapter_DefaultVersion() {
+	adapter, err := bridge.GetABIAdapter(bridge.DefaultVersion)
+
+	s.Nil(err)
+	s.Equal(bridge.DefaultVersion, adapter.Version())
+}
+
+func (s *ABIAdapterTestSuite) TestPackAndUnpackVoteProposal() {
+	p := proposal.NewProposal(1, 0, 2, testResourceId, []byte{1, 2, 3}, common.Address{}, s.bridgeAddress, common.Hash{}, 0)
+
+	calldata, err := s.adapter.PackVoteProposal(p)
+	s.Nil(err)
+	call, err := s.adapter.UnpackVoteProposal(calldata)
+
+	s.Nil(err)
+	s.Equal(&bridge.VoteProposalCall{
+		Source:       1,
+		DepositNonce: 2,
+		ResourceID:   testResourceId,
+		Data:         []byte{1, 2, 3},
+	}, call)
+}
+
+func (s *ABIAdapterTestSuite) TestUnpackVoteProposal_OtherMethod() {
+	p := proposal.NewProposal(1, 0, 2, testResourceId, []byte{}, common.Address{}, s.bridgeAddress, common.Hash{}, 0)
+	calldata, err := s.adapter.PackExecuteProposal(p, true)
+	s.Nil(err)
+
+	_, err = s.adapter.UnpackVoteProposal(calldata)
+
+	s.NotNil(err)
+}
+
+func (s *ABIAdapterTestSuite) TestUnpackVoteProposal_ShortCalldata() {
+	_, err := s.adapter.UnpackVoteProposal([]byte{1, 2})
+
+	s.NotNil(err)
+}
+
+func (s *ABIAdapterTestSuite) TestUnpackVoteProposal_UnexpectedArgumentTypes() {
+	abiJSON := `[{"inputs":[{"internalType":"uint256","name":"domainID","type":"uint256"},{"internalType":"uint64","name":"depositNonce","type":"uint64"},{"internalType":"bytes32","name":"resourceID","type":"bytes32"},{"internalType":"bytes","name":"data","type":"bytes"}],"name":"voteProposal","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+	adapter, err := bridge.NewABIAdapter("wide-domain", abiJSON, "0x00")
+	s.Nil(err)
+	calldata, err := adapter.ABI().Pack("voteProposal", big.NewInt(1), uint64(2), testResourceId, []byte{1})
+	s.Nil(err)
+
+	_, err = adapter.UnpackVoteProposal(calldata)
+
+	s.NotNil(err)
+}
+
+func (s *ABIAdapterTestSuite) TestUnpackDepositLog_NotDepositEvent() {
+	_, err := s.adapter.UnpackDepositLog(types.Log{Topics: []common.Hash{util.ProposalEvent.GetTopic()}})
+
+	s.NotNil(err)
+}
+
+func (s *ABIAdapterTestSuite) TestDetectABIAdapter_FailedCodeAt() {
+	s.mockContractCaller.EXPECT().CodeAt(gomock.Any(), s.bridgeAddress, nil).Return(nil, errors.New("error"))
+
+	_, err := bridge.DetectABIAdapter(context.Background(), s.mockContractCaller, s.bridgeAddress)
+
+	s.NotNil(err)
+}
+
+func (s *ABIAdapterTestSuite) TestDetectABIAdapter_NoCode() {
+	s.mockContractCaller.EXPECT().CodeAt(gomock.Any(), s.bridgeAddress, nil).Return([]byte{}, nil)
+
+	_, err := bridge.DetectABIAdapter(context.Background(), s.mockContractCaller, s.bridgeAddress)
+
+	s.NotNil(err)
+}
+
+func (s *ABIAdapterTestSuite) TestDetectABIAdapter_MatchingCode() {
+	input, err := s.adapter.ABI().Pack("", uint8(1), []common.Address{common.HexToAddress(testRelayerAddress)}, big.NewInt(1), big.NewInt(0), big.NewInt(100))
+	s.Nil(err)
+	code, _, _, err := runtime.Create(append(s.adapter.Bytecode(), input...), &runtime.Config{GasLimit: 100000000})
+	s.Nil(err)
+	s.mockContractCaller.EXPECT().CodeAt(gomock.Any(), s.bridgeAddress, nil).Return(code, nil)
+
+	adapter, err := bridge.DetectABIAdapter(context.Background(), s.mockContractCaller, s.bridgeAddress)
+
+	s.Nil(err)
+	s.Equal(bridge.DefaultVersion, adapter.Version())
+}
+
+func (s *ABIAdapterTestSuite) TestDetectABIAdapter_PartOfBytecodeNotMatched() {
+	s.mockContractCaller.EXPECT().CodeAt(gomock.Any(), s.bridgeAddress, nil).Return(s.adapter.Bytecode()[100:400], nil)
+
+	_, err := bridge.DetectABIAdapter(context.Background(), s.mockContractCaller, s.bridgeAddress)
+
+	s.NotNil(err)
+}
+
+func (s *ABIAdapterTestSuite) TestDetectABIAdapter_UnknownCode() {
+	s.mockContractCaller.EXPECT().CodeAt(gomock.Any(), s.bridgeAddress, nil).Return([]byte{0xfe, 0xfe, 0xfe}, nil)
+
+	_, err := bridge.DetectABIAdapter(context.Background(), s.mockContractCaller, s.bridgeAddress)
+
+	s.NotNil(err)
+}
+
+func (s *ABIAdapterTestSuite) TestMatchesCode_KnownCodeHash() {
+	code := []byte{0xfe, 0xfe}
+	adapter, err := bridge.NewABIAdapter("hashed", "[]", "0x00", crypto.Keccak256Hash(code))
+	s.Nil(err)
+
+	s.True(adapter.MatchesCode(code))
+	s.False(adapter.MatchesCode([]byte{0xfe}))
+}
+
+func (s *ABIAdapterTestSuite) TestDetectABIAdapter_MatchesInRegistrationOrder() {
+	code := []byte{0xfe, 0xfd}
+	for _, version := range []string{"ordered-1", "ordered-2", "ordered-3"} {
+		adapter, err := bridge.NewABIAdapter(version, "[]", "0x00", crypto.Keccak256Hash(code))
+		s.Nil(err)
+		bridge.RegisterABIAdapter(adapter)
+	}
+	s.mockContractCaller.EXPECT().CodeAt(gomock.Any(), s.bridgeAddress, nil).Return(code, nil).Times(10)
+
+	for i := 0; i < 10; i++ {
+		adapter, err := bridge.DetectABIAdapter(context.Background(), s.mockContractCaller, s.bridgeAddress)
+
+		s.Nil(err)
+		s.Equal("ordered-1", adapter.Version())
+	}
+}
+
+func (s *ABIAdapterTestSuite) TestEventSig_VersionSpecificDepositEvent() {
+	abiJSON := `[{"anonymous":false,"inputs":[{"indexed":false,"internalType":"uint8","name":"destinationDomainID","type":"uint8"},{"indexed":false,"internalType":"bytes32","name":"resourceID","type":"bytes32"},{"indexed":false,"internalType":"uint64","name":"depositNonce","type":"uint64"},{"indexed":true,"internalType":"address","name":"user","type":"address"},{"indexed":false,"internalType":"bytes","name":"data","type":"bytes"}],"name":"Deposit","type":"event"}]`
+	adapter, err := bridge.NewABIAdapter("no-handler-response", abiJSON, "0x00")
+	s.Nil(err)
+	depositEvent := util.EventSig("Deposit(uint8,bytes32,uint64,address,bytes)")
+	data, err := adapter.ABI().Events["Deposit"].Inputs.NonIndexed().Pack(uint8(2), [32]byte{1}, uint64(3), []byte{5})
+	s.Nil(err)
+
+	s.Equal(depositEvent, adapter.DepositEvent())
+	s.Equal(util.ProposalVote, adapter.EventSig(util.ProposalVote))
+	evt, err := adapter.UnpackEventLog(types.Log{
+		Topics: []common.Hash{depositEvent.GetTopic(), common.HexToHash("0x1")},
+		Data:   data,
+	})
+	s.Nil(err)
+	s.Equal(util.Deposit, evt.Sig)
+	dl, err := adapter.UnpackDepositLog(types.Log{Topics: []common.Hash{depositEvent.GetTopic()}, Data: data})
+	s.Nil(err)
+	s.Equal(uint64(3), dl.DepositNonce)
+	_, err = adapter.UnpackDepositLog(types.Log{Topics: []common.Hash{util.Deposit.GetTopic()}, Data: data})
+	s.NotNil(err)
+}
@@ -4,10 +4,8 @@ import (
 	"bytes"
 	"math/big"
 	"strconv"
-	"strings"
 
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls"
-	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/consts"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/deposit"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
@@ -23,6 +21,7 @@ import (
 
 type BridgeContract struct {
 	contracts.Contract
+	adapter ABIAdapter
 }
 
 func NewBridgeContract(
@@ -30,9 +29,26 @@ func NewBridgeContract(
 	bridgeContractAddress common.Address,
 	transactor transactor.Transactor,
 ) *BridgeContract {
-	a, _ := abi.JSON(strings.NewReader(consts.BridgeABI))
-	b := common.FromHex(consts.BridgeBin)
-	return &BridgeContract{contracts.NewContract(bridgeContractAddress, a, b, client, transactor)}
+	return NewVersionedBridgeContract(client, bridgeContractAddress, transactor, DefaultABIAdapter())
+}
+
+// NewVersionedBridgeContract creates a bridge contract that encodes calls with ABI adapter
+// of the bridge version deployed at bridgeContractAddress
+func NewVersionedBridgeContract(
+	client calls.ContractCallerDispatcher,
+	bridgeContractAddress common.Address,
+	transactor transactor.Transactor,
+	adapter ABIAdapter,
+) *BridgeContract {
+	return &BridgeContract{
+		Contract: contracts.NewContract(bridgeContractAddress, adapter.ABI(), adapter.Bytecode(), client, transactor),
+		adapter:  adapter,
+	}
+}
+
+// ABIAdapter returns adapter of the bridge contract version
+func (c *BridgeContract) ABIAdapter() ABIAdapter {
+	return c.adapter
 }
 
 func (c *BridgeContract) AddRelayer(
@@ -199,11 +215,25 @@ func (c *BridgeContract) ExecuteProposal(
 		Str("resourceID", hexutil.Encode(proposal.ResourceId[:])).
 		Str("handler", proposal.HandlerAddress.String()).
 		Msgf("Execute proposal")
-	return c.ExecuteTransaction(
-		"executeProposal",
-		opts,
-		proposal.Source, proposal.DepositNonce, proposal.Data, proposal.ResourceId, true,
-	)
+	input, err := c.adapter.PackExecuteProposal(proposal, true)
+	if err != nil {
+		return nil, err
+	}
+	return c.ExecuteInput("executeProposal", input, opts)
+}
+
+// CancelProposal cancels proposal that stayed active or passed past bridge expiry
+func (c *BridgeContract) CancelProposal(
+	source uint8,
+	depositNonce uint64,
+	dataHash common.Hash,
+	opts transactor.TransactOptions,
+) (*common.Hash, error) {
+	log.Debug().
+		Str("depositNonce", strconv.FormatUint(depositNonce, 10)).
+		Str("dataHash", dataHash.Hex()).
+		Msgf("Cancel proposal")
+	return c.ExecuteTransaction("cancelProposal", opts, source, depositNonce, dataHash)
 }
 
 func (c *BridgeContract) VoteProposal(
@@ -215,11 +245,11 @@ func (c *BridgeContract) VoteProposal(
 		Str("resourceID", hexutil.Encode(proposal.ResourceId[:])).
 		Str("handler", proposal.HandlerAddress.String()).
 		Msgf("Vote proposal")
-	return c.ExecuteTransaction(
-		"voteProposal",
-		opts,
-		proposal.Source, proposal.DepositNonce, proposal.ResourceId, proposal.Data,
-	)
+	input, err := c.adapter.PackVoteProposal(proposal)
+	if err != nil {
+		return nil, err
+	}
+	return c.ExecuteInput("voteProposal", input, opts)
 }
 
 func (c *BridgeContract) SimulateVoteProposal(proposal *proposal.Proposal) error {
@@ -228,10 +258,11 @@ func (c *BridgeContract) SimulateVoteProposal(proposal *proposal.Proposal) error
 		Str("resourceID", hexutil.Encode(proposal.ResourceId[:])).
 		Str("handler", proposal.HandlerAddress.String()).
 		Msgf("Simulate vote proposal")
-	_, err := c.CallContract(
-		"voteProposal",
-		proposal.Source, proposal.DepositNonce, proposal.ResourceId, proposal.Data,
-	)
+	input, err := c.adapter.PackVoteProposal(proposal)
+	if err != nil {
+		return err
+	}
+	_, err = c.CallInput("voteProposal", input)
 	return err
 }
 
@@ -280,6 +311,27 @@ func (c *BridgeContract) GetThreshold() (uint8, error) {
 	return out, nil
 }
 
+// GetExpiry returns amount of blocks after which active proposals can be cancelled
+func (c *BridgeContract) GetExpiry() (uint64, error) {
+	log.Debug().Msg("Getting proposal expiry")
+	res, err := c.CallContract("_expiry")
+	if err != nil {
+		return 0, err
+	}
+	out := abi.ConvertType(res[0], new(big.Int)).(*big.Int)
+	return out.Uint64(), nil
+}
+
+func (c *BridgeContract) IsPaused() (bool, error) {
+	log.Debug().Msg("Getting is bridge paused")
+	res, err := c.CallContract("paused")
+	if err != nil {
+		return false, err
+	}
+	out := *abi.ConvertType(res[0], new(bool)).(*bool)
+	return out, nil
+}
+
 func (c *BridgeContract) IsRelayer(relayerAddress common.Address) (bool, error) {
 	log.Debug().Msgf("Getting is %s a relayer", relayerAddress.String())
 	res, err := c.CallContract("isRelayer", relayerAddress)
@@ -290,13 +342,55 @@ func (c *BridgeContract) IsRelayer(relayerAddress common.Address) (bool, error)
 	return *out, nil
 }
 
+// GetRelayers returns addresses of all relayers registered on the bridge
+func (c *BridgeContract) GetRelayers() ([]common.Address, error) {
+	log.Debug().Msg("Getting relayers")
+	res, err := c.CallContract("RELAYER_ROLE")
+	if err != nil {
+		return nil, err
+	}
+	role := *abi.ConvertType(res[0], new([32]byte)).(*[32]byte)
+
+	res, err = c.CallContract("getRoleMemberCount", role)
+	if err != nil {
+		return nil, err
+	}
+	count := abi.ConvertType(res[0], new(big.Int)).(*big.Int)
+
+	relayers := make([]common.Address, 0, count.Uint64())
+	for i := int64(0); i < count.Int64(); i++ {
+		res, err = c.CallContract("getRoleMember", role, big.NewInt(i))
+		if err != nil {
+			return nil, err
+		}
+		relayers = append(relayers, *abi.ConvertType(res[0], new(common.Address)).(*common.Address))
+	}
+	return relayers, nil
+}
+
+// IsAdmin returns true if address has bridge admin role
+func (c *BridgeContract) IsAdmin(address common.Address) (bool, error) {
+	log.Debug().Msgf("Getting is %s an admin", address.String())
+	res, err := c.CallContract("hasRole", [32]byte{}, address)
+	if err != nil {
+		return false, err
+	}
+	out := abi.ConvertType(res[0], new(bool)).(*bool)
+	return *out, nil
+}
+
 func (c *BridgeContract) ProposalStatus(p *proposal.Proposal) (message.ProposalStatus, error) {
 	log.Debug().
 		Str("depositNonce", strconv.FormatUint(p.DepositNonce, 10)).
 		Str("resourceID", hexutil.Encode(p.ResourceId[:])).
 		Str("handler", p.HandlerAddress.String()).
 		Msg("Getting proposal status")
-	res, err := c.CallContract("getProposal", p.Source, p.DepositNonce, p.GetDataHash())
+	return c.GetProposal(p.Source, p.DepositNonce, p.GetDataHash())
+}
+
+// GetProposal returns status of the proposal stored on the bridge under dataHash
+func (c *BridgeContract) GetProposal(source uint8, depositNonce uint64, dataHash common.Hash) (message.ProposalStatus, error) {
+	res, err := c.CallContract("getProposal", source, depositNonce, dataHash)
 	if err != nil {
 		return message.ProposalStatus{}, err
 	}
@@ -304,6 +398,45 @@ func (c *BridgeContract) ProposalStatus(p *proposal.Proposal) (message.ProposalS
 	return out, nil
 }
 
+// ProposalState is the state of a proposal on the bridge relevant for voting
+type ProposalState struct {
+	Status    message.ProposalStatus
+	Threshold uint8
+	HasVoted  bool // HasVoted is true if any of the queried relayers voted for the proposal
+}
+
+// ProposalState reads proposal status, relayer threshold and whether any of relayers in by already voted
+// for the proposal in a single batch request
+func (c *BridgeContract) ProposalState(by []common.Address, p *proposal.Proposal) (*ProposalState, error) {
+	log.Debug().
+		Str("depositNonce", strconv.FormatUint(p.DepositNonce, 10)).
+		Str("resourceID", hexutil.Encode(p.ResourceId[:])).
+		Str("handler", p.HandlerAddress.String()).
+		Msgf("Getting proposal state for %v", by)
+	calls := []contracts.Call{
+		{Method: "getProposal", Args: []interface{}{p.Source, p.DepositNonce, p.GetDataHash()}},
+		{Method: "_relayerThreshold"},
+	}
+	for _, relayer := range by {
+		calls = append(calls, contracts.Call{Method: "_hasVotedOnProposal", Args: []interface{}{idAndNonce(p.Source, p.DepositNonce), p.GetDataHash(), relayer}})
+	}
+	res, err := c.BatchCall(calls...)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &ProposalState{
+		Status:    *abi.ConvertType(res[0][0], new(message.ProposalStatus)).(*message.ProposalStatus),
+		Threshold: *abi.ConvertType(res[1][0], new(uint8)).(*uint8),
+	}
+	for _, voted := range res[2:] {
+		if *abi.ConvertType(voted[0], new(bool)).(*bool) {
+			state.HasVoted = true
+		}
+	}
+	return state, nil
+}
+
 func (c *BridgeContract) IsProposalVotedBy(by common.Address, p *proposal.Proposal) (bool, error) {
 	log.Debug().
 		Str("depositNonce", strconv.FormatUint(p.DepositNonce, 10)).
@@ -330,6 +463,26 @@ func (c *BridgeContract) GetHandlerAddressForResourceID(
 	return out, nil
 }
 
+// GetHandlerAddressesForResourceIDs returns handler addresses of resources read in a single batch request
+func (c *BridgeContract) GetHandlerAddressesForResourceIDs(
+	resourceIDs []types.ResourceID,
+) ([]common.Address, error) {
+	log.Debug().Msgf("Getting handler addresses for %d resources", len(resourceIDs))
+	methodCalls := make([]contracts.Call, len(resourceIDs))
+	for i, resourceID := range resourceIDs {
+		methodCalls[i] = contracts.Call{Method: "_resourceIDToHandlerAddress", Args: []interface{}{resourceID}}
+	}
+	res, err := c.BatchCall(methodCalls...)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]common.Address, len(res))
+	for i, r := range res {
+		out[i] = *abi.ConvertType(r[0], new(common.Address)).(*common.Address)
+	}
+	return out, nil
+}
+
 func idAndNonce(srcId uint8, nonce uint64) *big.Int {
 	var data []byte
 	data = append(data, big.NewInt(int64(nonce)).Bytes()...)
@@ -1,6 +1,7 @@
 package bridge_test
 
 import (
+	"context"
 	"encoding/hex"
 	"errors"
 	"math/big"
@@ -14,7 +15,9 @@ import (
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/signAndSend"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/voter/proposal"
 	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 )
@@ -51,11 +54,14 @@ func (s *ProposalStatusTestSuite) SetupTest() {
 	s.bridgeContract = bridge.NewBridgeContract(s.mockContractCaller, common.HexToAddress(testContractAddress), s.mockTransactor)
 	s.proposal = *proposal.NewProposal(
 		uint8(1),
+		uint8(0),
 		uint64(1),
 		testResourceId,
 		[]byte{},
 		common.HexToAddress(testHandlerAddress),
 		s.bridgeAddress,
+		common.Hash{},
+		uint64(1),
 	)
 }
 func (s *ProposalStatusTestSuite) TearDownTest() {}
@@ -350,6 +356,75 @@ func (s *ProposalStatusTestSuite) TestBridge_GetThreshold_Success() {
 	s.Nil(err)
 }
 
+func (s *ProposalStatusTestSuite) TestBridge_GetExpiry_Success() {
+	s.mockContractCaller.EXPECT().From().Return(common.HexToAddress(testInteractorAddress))
+	s.mockContractCaller.EXPECT().CallContract(
+		gomock.Any(),
+		gomock.Any(),
+		nil,
+	).Return([]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 100}, nil)
+	res, err := s.bridgeContract.GetExpiry()
+	s.Equal(
+		uint64(100),
+		res,
+	)
+	s.Nil(err)
+}
+
+func (s *ProposalStatusTestSuite) TestBridge_GetRelayers_Success() {
+	role := common.LeftPadBytes([]byte{7}, 32)
+	count := common.LeftPadBytes([]byte{2}, 32)
+	s.mockContractCaller.EXPECT().From().Return(common.HexToAddress(testInteractorAddress)).Times(4)
+	gomock.InOrder(
+		s.mockContractCaller.EXPECT().CallContract(gomock.Any(), gomock.Any(), nil).Return(role, nil),
+		s.mockContractCaller.EXPECT().CallContract(gomock.Any(), gomock.Any(), nil).Return(count, nil),
+		s.mockContractCaller.EXPECT().CallContract(gomock.Any(), gomock.Any(), nil).Return(common.LeftPadBytes(common.HexToAddress(testRelayerAddress).Bytes(), 32), nil),
+		s.mockContractCaller.EXPECT().CallContract(gomock.Any(), gomock.Any(), nil).Return(common.LeftPadBytes(common.HexToAddress(testHandlerAddress).Bytes(), 32), nil),
+	)
+	res, err := s.bridgeContract.GetRelayers()
+	s.Equal(
+		[]common.Address{common.HexToAddress(testRelayerAddress), common.HexToAddress(testHandlerAddress)},
+		res,
+	)
+	s.Nil(err)
+}
+
+func (s *ProposalStatusTestSuite) TestBridge_GetRelayers_Failed() {
+	s.mockContractCaller.EXPECT().From().Return(common.HexToAddress(testInteractorAddress))
+	s.mockContractCaller.EXPECT().CallContract(gomock.Any(), gomock.Any(), nil).Return(nil, errors.New("error"))
+	_, err := s.bridgeContract.GetRelayers()
+	s.NotNil(err)
+}
+
+func (s *ProposalStatusTestSuite) TestBridge_IsAdmin_Success() {
+	s.mockContractCaller.EXPECT().From().Return(common.HexToAddress(testInteractorAddress))
+	s.mockContractCaller.EXPECT().CallContract(
+		gomock.Any(),
+		gomock.Any(),
+		nil,
+	).Return([]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, nil)
+	res, err := s.bridgeContract.IsAdmin(common.HexToAddress(testInteractorAddress))
+	s.Equal(
+		false,
+		res,
+	)
+	s.Nil(err)
+}
+
+func (s *ProposalStatusTestSuite) TestBridge_CancelProposal_Success() {
+	s.mockTransactor.EXPECT().Transact(
+		gomock.Any(),
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&common.Hash{1, 2, 3}, nil)
+	res, err := s.bridgeContract.CancelProposal(1, 2, common.Hash{4}, signAndSend.DefaultTransactionOptions)
+	s.Equal(
+		&common.Hash{1, 2, 3},
+		res,
+	)
+	s.Nil(err)
+}
+
 func (s *ProposalStatusTestSuite) TestBridge_IsRelayer_Success() {
 	s.mockContractCaller.EXPECT().From().Return(common.HexToAddress(testInteractorAddress))
 	s.mockContractCaller.EXPECT().CallContract(
@@ -381,6 +456,23 @@ func (s *ProposalStatusTestSuite) TestBridge_ProposalStatus_Success() {
 	s.Nil(err)
 }
 
+func (s *ProposalStatusTestSuite) TestBridge_ProposalStatus_UsesDataHash() {
+	input, _ := s.bridgeContract.ABI.Pack("getProposal", s.proposal.Source, s.proposal.DepositNonce, s.proposal.GetDataHash())
+	proposalStatus, _ := hex.DecodeString("0000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000001c0000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000001f")
+	s.mockContractCaller.EXPECT().From().Return(common.HexToAddress(testInteractorAddress))
+	s.mockContractCaller.EXPECT().CallContract(
+		gomock.Any(),
+		gomock.Any(),
+		nil,
+	).DoAndReturn(func(ctx context.Context, callArgs map[string]interface{}, blockNumber *big.Int) ([]byte, error) {
+		s.Equal(hexutil.Bytes(input), callArgs["data"])
+		return proposalStatus, nil
+	})
+	res, err := s.bridgeContract.ProposalStatus(&s.proposal)
+	s.Nil(err)
+	s.Equal(message.ProposalStatusActive, res.Status)
+}
+
 func (s *ProposalStatusTestSuite) TestBridge_IsProposalVotedBy_Success() {
 	s.mockContractCaller.EXPECT().From().Return(common.HexToAddress(testInteractorAddress))
 	s.mockContractCaller.EXPECT().CallContract(
@@ -396,6 +488,103 @@ func (s *ProposalStatusTestSuite) TestBridge_IsProposalVotedBy_Success() {
 	s.Nil(err)
 }
 
+func (s *ProposalStatusTestSuite) TestBridge_ProposalState_Success() {
+	proposalStatus, _ := hex.DecodeString("0000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000001c0000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000001f")
+	hasVotedInput, _ := s.bridgeContract.ABI.Pack("_hasVotedOnProposal", big.NewInt(257), s.proposal.GetDataHash(), common.HexToAddress(testRelayerAddress))
+	s.mockContractCaller.EXPECT().From().Times(3).Return(common.HexToAddress(testInteractorAddress))
+	s.mockContractCaller.EXPECT().BatchCallContract(
+		gomock.Any(),
+		gomock.Any(),
+		nil,
+	).DoAndReturn(func(ctx context.Context, callArgs []map[string]interface{}, blockNumber *big.Int) ([][]byte, error) {
+		s.Len(callArgs, 3)
+		s.Equal(hexutil.Bytes(hasVotedInput), callArgs[2]["data"])
+		return [][]byte{
+			proposalStatus,
+			common.LeftPadBytes([]byte{2}, 32),
+			common.LeftPadBytes([]byte{1}, 32),
+		}, nil
+	})
+
+	res, err := s.bridgeContract.ProposalState([]common.Address{common.HexToAddress(testRelayerAddress)}, &s.proposal)
+
+	s.Nil(err)
+	s.Equal(&bridge.ProposalState{
+		Status:    message.ProposalStatus{Status: message.ProposalStatusActive, YesVotes: big.NewInt(28), YesVotesTotal: 1, ProposedBlock: big.NewInt(31)},
+		Threshold: 2,
+		HasVoted:  true,
+	}, res)
+}
+
+func (s *ProposalStatusTestSuite) TestBridge_ProposalState_VotedByPoolKey() {
+	proposalStatus, _ := hex.DecodeString("0000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000001c0000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000001f")
+	poolKeyAddress := common.HexToAddress("0x1")
+	hasVotedInput, _ := s.bridgeContract.ABI.Pack("_hasVotedOnProposal", big.NewInt(257), s.proposal.GetDataHash(), poolKeyAddress)
+	s.mockContractCaller.EXPECT().From().Times(4).Return(common.HexToAddress(testInteractorAddress))
+	s.mockContractCaller.EXPECT().BatchCallContract(
+		gomock.Any(),
+		gomock.Any(),
+		nil,
+	).DoAndReturn(func(ctx context.Context, callArgs []map[string]interface{}, blockNumber *big.Int) ([][]byte, error) {
+		s.Len(callArgs, 4)
+		s.Equal(hexutil.Bytes(hasVotedInput), callArgs[3]["data"])
+		return [][]byte{
+			proposalStatus,
+			common.LeftPadBytes([]byte{2}, 32),
+			common.LeftPadBytes([]byte{0}, 32),
+			common.LeftPadBytes([]byte{1}, 32),
+		}, nil
+	})
+
+	res, err := s.bridgeContract.ProposalState([]common.Address{common.HexToAddress(testRelayerAddress), poolKeyAddress}, &s.proposal)
+
+	s.Nil(err)
+	s.True(res.HasVoted)
+}
+
+func (s *ProposalStatusTestSuite) TestBridge_ProposalState_NotVoted() {
+	proposalStatus, _ := hex.DecodeString("0000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000001c0000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000001f")
+	s.mockContractCaller.EXPECT().From().Times(4).Return(common.HexToAddress(testInteractorAddress))
+	s.mockContractCaller.EXPECT().BatchCallContract(gomock.Any(), gomock.Any(), nil).Return([][]byte{
+		proposalStatus,
+		common.LeftPadBytes([]byte{2}, 32),
+		common.LeftPadBytes([]byte{0}, 32),
+		common.LeftPadBytes([]byte{0}, 32),
+	}, nil)
+
+	res, err := s.bridgeContract.ProposalState([]common.Address{common.HexToAddress(testRelayerAddress), common.HexToAddress("0x1")}, &s.proposal)
+
+	s.Nil(err)
+	s.False(res.HasVoted)
+}
+
+func (s *ProposalStatusTestSuite) TestBridge_ProposalState_Failed() {
+	s.mockContractCaller.EXPECT().From().Times(3).Return(common.HexToAddress(testInteractorAddress))
+	s.mockContractCaller.EXPECT().BatchCallContract(gomock.Any(), gomock.Any(), nil).Return(nil, errors.New("error"))
+
+	res, err := s.bridgeContract.ProposalState([]common.Address{common.HexToAddress(testRelayerAddress)}, &s.proposal)
+
+	s.Nil(res)
+	s.NotNil(err)
+}
+
+func (s *ProposalStatusTestSuite) TestBridge_GetHandlerAddressesForResourceIDs_Success() {
+	s.mockContractCaller.EXPECT().From().Times(2).Return(common.HexToAddress(testInteractorAddress))
+	s.mockContractCaller.EXPECT().BatchCallContract(
+		gomock.Any(),
+		gomock.Any(),
+		nil,
+	).Return([][]byte{
+		common.LeftPadBytes([]byte{1}, 32),
+		common.LeftPadBytes([]byte{2}, 32),
+	}, nil)
+
+	res, err := s.bridgeContract.GetHandlerAddressesForResourceIDs([]types.ResourceID{{1}, {2}})
+
+	s.Nil(err)
+	s.Equal([]common.Address{common.HexToAddress("0x1"), common.HexToAddress("0x2")}, res)
+}
+
 func (s *ProposalStatusTestSuite) TestBridge_GetHandlerAddressForResourceID_Success() {
 	s.mockContractCaller.EXPECT().From().Return(common.HexToAddress(testInteractorAddress))
 	s.mockContractCaller.EXPECT().CallContract(
@@ -0,0 +1,134 @@
+package bridge
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	"github.com/ChainSafe/chainbridge-core/util"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Events contains signatures of all events emitted by the bridge contract
+var Events = []util.EventSig{
+	util.Deposit,
+	util.ProposalEvent,
+	util.ProposalVote,
+	util.RelayerAdded,
+	util.RelayerRemoved,
+	util.RelayerThresholdChanged,
+	util.Paused,
+	util.Unpaused,
+	util.FailedHandlerExecution,
+}
+
+// ProposalEvent is emitted each time proposal status changes
+type ProposalEvent struct {
+	OriginDomainID uint8
+	DepositNonce   uint64
+	Status         uint8
+	DataHash       [32]byte
+}
+
+// ProposalVote is emitted each time relayer votes on proposal
+type ProposalVote struct {
+	OriginDomainID uint8
+	DepositNonce   uint64
+	Status         uint8
+	DataHash       [32]byte
+}
+
+type RelayerAdded struct {
+	Relayer common.Address
+}
+
+type RelayerRemoved struct {
+	Relayer common.Address
+}
+
+type RelayerThresholdChanged struct {
+	NewThreshold *big.Int
+}
+
+// Paused is emitted when bridge transfers are paused by admin
+type Paused struct {
+	Account common.Address
+}
+
+// Unpaused is emitted when bridge transfers are unpaused by admin
+type Unpaused struct {
+	Account common.Address
+}
+
+// FailedHandlerExecution is emitted when proposal execution reverted inside handler
+// and bridge was not asked to revert on failure
+type FailedHandlerExecution struct {
+	LowLevelData []byte
+}
+
+// Event is a decoded bridge contract event together with the log it was decoded from.
+// Data holds pointer to one of the event structs from this package
+// or *evmclient.DepositLogs for Deposit events.
+type Event struct {
+	Sig  util.EventSig
+	Log  types.Log
+	Data interface{}
+}
+
+// eventData creates a new struct that data of the bridge event is unpacked into
+var eventData = map[util.EventSig]func() interface{}{
+	util.Deposit:                 func() interface{} { return new(evmclient.DepositLogs) },
+	util.ProposalEvent:           func() interface{} { return new(ProposalEvent) },
+	util.ProposalVote:            func() interface{} { return new(ProposalVote) },
+	util.RelayerAdded:            func() interface{} { return new(RelayerAdded) },
+	util.RelayerRemoved:          func() interface{} { return new(RelayerRemoved) },
+	util.RelayerThresholdChanged: func() interface{} { return new(RelayerThresholdChanged) },
+	util.Paused:                  func() interface{} { return new(Paused) },
+	util.Unpaused:                func() interface{} { return new(Unpaused) },
+	util.FailedHandlerExecution:  func() interface{} { return new(FailedHandlerExecution) },
+}
+
+// eventName returns name of the event without its arguments
+func eventName(sig util.EventSig) string {
+	return strings.SplitN(string(sig), "(", 2)[0]
+}
+
+// UnpackEventLog matches log topic with one of the bridge events declared in the ABI and
+// decodes log data into the corresponding event struct. Returned event is identified by
+// its signature in Events regardless of the signature it has in the ABI.
+func UnpackEventLog(a abi.ABI, l types.Log) (*Event, error) {
+	if len(l.Topics) == 0 {
+		return nil, fmt.Errorf("log %s:%d has no topics", l.TxHash.Hex(), l.Index)
+	}
+
+	var sig util.EventSig
+	for _, e := range Events {
+		abiEvent, ok := a.Events[eventName(e)]
+		if ok && abiEvent.ID == l.Topics[0] {
+			sig = e
+			break
+		}
+	}
+
+	newData, ok := eventData[sig]
+	if !ok {
+		return nil, fmt.Errorf("unknown bridge event topic %s", l.Topics[0].Hex())
+	}
+	data := newData()
+	err := a.UnpackIntoInterface(data, eventName(sig), l.Data)
+	if err != nil {
+		return nil, err
+	}
+	if dl, ok := data.(*evmclient.DepositLogs); ok && len(l.Topics) > 1 {
+		dl.SenderAddress = common.BytesToAddress(l.Topics[1].Bytes())
+	}
+
+	return &Event{
+		Sig:  sig,
+		Log:  l,
+		Data: data,
+	}, nil
+}
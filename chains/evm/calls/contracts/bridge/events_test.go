@@ -0,0 +1,147 @@
+package bridge_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/consts"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/util"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type EventsTestSuite struct {
+	suite.Suite
+	bridgeABI abi.ABI
+}
+
+func TestRunEventsTestSuite(t *testing.T) {
+	suite.Run(t, new(EventsTestSuite))
+}
+
+func (s *EventsTestSuite) SetupSuite() {
+	s.bridgeABI, _ = abi.JSON(strings.NewReader(consts.BridgeABI))
+}
+func (s *EventsTestSuite) TearDownSuite() {}
+func (s *EventsTestSuite) SetupTest()     {}
+func (s *EventsTestSuite) TearDownTest()  {}
+
+func (s *EventsTestSuite) eventLog(sig util.EventSig, name string, args ...interface{}) types.Log {
+	data, err := s.bridgeABI.Events[name].Inputs.NonIndexed().Pack(args...)
+	s.Nil(err)
+	return types.Log{
+		Topics: []common.Hash{sig.GetTopic()},
+		Data:   data,
+	}
+}
+
+func (s *EventsTestSuite) TestUnpackEventLog_NoTopics() {
+	_, err := bridge.UnpackEventLog(s.bridgeABI, types.Log{})
+
+	s.NotNil(err)
+}
+
+func (s *EventsTestSuite) TestUnpackEventLog_UnknownTopic() {
+	_, err := bridge.UnpackEventLog(s.bridgeABI, types.Log{Topics: []common.Hash{{1}}})
+
+	s.NotNil(err)
+}
+
+func (s *EventsTestSuite) TestUnpackEventLog_InvalidData() {
+	_, err := bridge.UnpackEventLog(s.bridgeABI, types.Log{
+		Topics: []common.Hash{util.ProposalEvent.GetTopic()},
+		Data:   []byte("invalid"),
+	})
+
+	s.NotNil(err)
+}
+
+func (s *EventsTestSuite) TestUnpackEventLog_ProposalEvent() {
+	l := s.eventLog(util.ProposalEvent, "ProposalEvent", uint8(1), uint64(257), message.ProposalStatusPassed, [32]byte{2})
+
+	evt, err := bridge.UnpackEventLog(s.bridgeABI, l)
+
+	s.Nil(err)
+	s.Equal(util.ProposalEvent, evt.Sig)
+	s.Equal(&bridge.ProposalEvent{
+		OriginDomainID: 1,
+		DepositNonce:   257,
+		Status:         message.ProposalStatusPassed,
+		DataHash:       [32]byte{2},
+	}, evt.Data)
+}
+
+func (s *EventsTestSuite) TestUnpackEventLog_ProposalVote() {
+	l := s.eventLog(util.ProposalVote, "ProposalVote", uint8(1), uint64(2), message.ProposalStatusActive, [32]byte{3})
+
+	evt, err := bridge.UnpackEventLog(s.bridgeABI, l)
+
+	s.Nil(err)
+	s.Equal(util.ProposalVote, evt.Sig)
+	s.Equal(&bridge.ProposalVote{
+		OriginDomainID: 1,
+		DepositNonce:   2,
+		Status:         message.ProposalStatusActive,
+		DataHash:       [32]byte{3},
+	}, evt.Data)
+}
+
+func (s *EventsTestSuite) TestUnpackEventLog_RelayerEvents() {
+	relayer := common.HexToAddress(testRelayerAddress)
+
+	added, err := bridge.UnpackEventLog(s.bridgeABI, s.eventLog(util.RelayerAdded, "RelayerAdded", relayer))
+	s.Nil(err)
+	s.Equal(&bridge.RelayerAdded{Relayer: relayer}, added.Data)
+
+	removed, err := bridge.UnpackEventLog(s.bridgeABI, s.eventLog(util.RelayerRemoved, "RelayerRemoved", relayer))
+	s.Nil(err)
+	s.Equal(&bridge.RelayerRemoved{Relayer: relayer}, removed.Data)
+
+	thresholdChanged, err := bridge.UnpackEventLog(s.bridgeABI, s.eventLog(util.RelayerThresholdChanged, "RelayerThresholdChanged", big.NewInt(3)))
+	s.Nil(err)
+	s.Equal(&bridge.RelayerThresholdChanged{NewThreshold: big.NewInt(3)}, thresholdChanged.Data)
+}
+
+func (s *EventsTestSuite) TestUnpackEventLog_PauseEvents() {
+	admin := common.HexToAddress(testInteractorAddress)
+
+	paused, err := bridge.UnpackEventLog(s.bridgeABI, s.eventLog(util.Paused, "Paused", admin))
+	s.Nil(err)
+	s.Equal(util.Paused, paused.Sig)
+	s.Equal(&bridge.Paused{Account: admin}, paused.Data)
+
+	unpaused, err := bridge.UnpackEventLog(s.bridgeABI, s.eventLog(util.Unpaused, "Unpaused", admin))
+	s.Nil(err)
+	s.Equal(util.Unpaused, unpaused.Sig)
+	s.Equal(&bridge.Unpaused{Account: admin}, unpaused.Data)
+}
+
+func (s *EventsTestSuite) TestUnpackEventLog_FailedHandlerExecution() {
+	l := s.eventLog(util.FailedHandlerExecution, "FailedHandlerExecution", []byte("revert reason"))
+
+	evt, err := bridge.UnpackEventLog(s.bridgeABI, l)
+
+	s.Nil(err)
+	s.Equal(&bridge.FailedHandlerExecution{LowLevelData: []byte("revert reason")}, evt.Data)
+}
+
+func (s *EventsTestSuite) TestUnpackEventLog_Deposit() {
+	sender := common.HexToAddress(testInteractorAddress)
+	l := s.eventLog(util.Deposit, "Deposit", uint8(2), [32]byte{1}, uint64(5), []byte{1, 2}, []byte{})
+	l.Topics = append(l.Topics, common.BytesToHash(sender.Bytes()))
+
+	evt, err := bridge.UnpackEventLog(s.bridgeABI, l)
+
+	s.Nil(err)
+	dl := evt.Data.(*evmclient.DepositLogs)
+	s.Equal(uint8(2), dl.DestinationDomainID)
+	s.Equal(uint64(5), dl.DepositNonce)
+	s.Equal(sender, dl.SenderAddress)
+	s.Equal([]byte{1, 2}, dl.Data)
+}
@@ -64,6 +64,11 @@ func (c *Contract) ExecuteTransaction(method string, opts transactor.TransactOpt
 	if err != nil {
 		return nil, err
 	}
+	return c.ExecuteInput(method, input, opts)
+}
+
+// ExecuteInput sends transaction with already packed input of method to the contract
+func (c *Contract) ExecuteInput(method string, input []byte, opts transactor.TransactOptions) (*common.Hash, error) {
 	h, err := c.Transact(&c.contractAddress, input, opts)
 	if err != nil {
 		log.Error().
@@ -84,6 +89,11 @@ func (c *Contract) CallContract(method string, args ...interface{}) ([]interface
 	if err != nil {
 		return nil, err
 	}
+	return c.CallInput(method, input)
+}
+
+// CallInput calls contract with already packed input of method and unpacks the result
+func (c *Contract) CallInput(method string, input []byte) ([]interface{}, error) {
 	msg := ethereum.CallMsg{From: c.client.From(), To: &c.contractAddress, Data: input}
 	out, err := c.client.CallContract(context.TODO(), calls.ToCallArg(msg), nil)
 	if err != nil {
@@ -107,6 +117,55 @@ func (c *Contract) CallContract(method string, args ...interface{}) ([]interface
 	return c.UnpackResult(method, out)
 }
 
+// Call is a call of contract method read with BatchCall
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// BatchCall calls contract methods in a single batch request and unpacks
+// their results in the order of provided calls
+func (c *Contract) BatchCall(methodCalls ...Call) ([][]interface{}, error) {
+	callArgs := make([]map[string]interface{}, len(methodCalls))
+	for i, call := range methodCalls {
+		input, err := c.PackMethod(call.Method, call.Args...)
+		if err != nil {
+			return nil, err
+		}
+		msg := ethereum.CallMsg{From: c.client.From(), To: &c.contractAddress, Data: input}
+		callArgs[i] = calls.ToCallArg(msg)
+	}
+
+	outs, err := c.client.BatchCallContract(context.TODO(), callArgs, nil)
+	if err != nil {
+		log.Error().
+			Str("contract", c.contractAddress.String()).
+			Err(err).
+			Msgf("error on batch calling %d methods", len(methodCalls))
+		return nil, err
+	}
+
+	results := make([][]interface{}, len(methodCalls))
+	for i, out := range outs {
+		if len(out) == 0 {
+			// Make sure we have a contract to operate on, and bail out otherwise.
+			if code, err := c.client.CodeAt(context.Background(), c.contractAddress, nil); err != nil {
+				return nil, err
+			} else if len(code) == 0 {
+				return nil, fmt.Errorf("no code at provided address %s", c.contractAddress.String())
+			}
+		}
+		results[i], err = c.UnpackResult(methodCalls[i].Method, out)
+		if err != nil {
+			return nil, err
+		}
+	}
+	log.Debug().
+		Str("contract", c.contractAddress.String()).
+		Msgf("%d methods batch called", len(methodCalls))
+	return results, nil
+}
+
 func (c *Contract) DeployContract(params ...interface{}) (common.Address, error) {
 	input, err := c.PackMethod("", params...)
 	if err != nil {
@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity 0.8.11;
+pragma experimental ABIEncoderV2;
+
+/**
+    @title Executes multiple calls in a single transaction on behalf of the transaction sender.
+    @notice Each call is forwarded with the transaction sender appended to its calldata, so target
+    contracts have to trust this contract as a forwarder (e.g. with Bridge.adminSetForwarder) and
+    read the original sender from the last 20 bytes of calldata. Reverts if any of the calls fails.
+ */
+contract MulticallForwarder {
+    struct Call {
+        address target;
+        bytes callData;
+    }
+
+    /**
+        @notice Executes all calls, appending msg.sender to calldata of each.
+        @param calls Calls to execute in order.
+        @return blockNumber Number of the block calls were executed in.
+        @return returnData Data returned by each call.
+     */
+    function aggregate(Call[] calldata calls) external returns (uint256 blockNumber, bytes[] memory returnData) {
+        blockNumber = block.number;
+        returnData = new bytes[](calls.length);
+        for (uint256 i = 0; i < calls.length; i++) {
+            (bool success, bytes memory ret) = calls[i].target.call(abi.encodePacked(calls[i].callData, msg.sender));
+            if (!success) {
+                assembly {
+                    revert(add(ret, 32), mload(ret))
+                }
+            }
+            returnData[i] = ret;
+        }
+    }
+}
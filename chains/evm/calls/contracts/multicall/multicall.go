@@ -0,0 +1,65 @@
+package multicall
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/consts"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Call is a single call aggregated into multicall transaction
+type Call struct {
+	Target   common.Address
+	CallData []byte
+}
+
+// MulticallContract matches a multicall contract that executes calls in a single transaction
+// and reverts if any of them fails. Each call is forwarded with the transaction sender appended
+// to calldata so the contract has to be registered as a trusted forwarder on target contracts.
+type MulticallContract struct {
+	contracts.Contract
+}
+
+var multicallABI, _ = abi.JSON(strings.NewReader(consts.MulticallForwarderABI))
+
+func NewMulticallContract(
+	client calls.ContractCallerDispatcher,
+	contractAddress common.Address,
+	transactor transactor.Transactor,
+) *MulticallContract {
+	return &MulticallContract{
+		contracts.NewContract(contractAddress, multicallABI, nil, client, transactor),
+	}
+}
+
+// Aggregate sends transaction that executes all calls
+func (c *MulticallContract) Aggregate(
+	calls []Call,
+	opts transactor.TransactOptions,
+) (*common.Hash, error) {
+	return c.ExecuteTransaction("aggregate", opts, calls)
+}
+
+// UnpackAggregate returns calls aggregated by aggregate transaction calldata
+func UnpackAggregate(calldata []byte) ([]Call, error) {
+	if len(calldata) < 4 {
+		return nil, errors.New("calldata too short")
+	}
+	m, err := multicallABI.MethodById(calldata[:4])
+	if err != nil {
+		return nil, err
+	}
+	if m.Name != "aggregate" {
+		return nil, errors.New("calldata is not an aggregate call")
+	}
+	values, err := m.Inputs.Unpack(calldata[4:])
+	if err != nil {
+		return nil, err
+	}
+	return *abi.ConvertType(values[0], new([]Call)).(*[]Call), nil
+}
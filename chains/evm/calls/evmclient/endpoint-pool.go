@@ -0,0 +1,428 @@
+package evmclient
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/time/rate"
+)
+
+var (
+	// HealthCheckPeriod is the interval at which heads of all endpoints are read
+	HealthCheckPeriod = 15 * time.Second
+	// MaxHeadLag is the number of blocks by which endpoint can lag behind the highest known head before it is treated as stale
+	MaxHeadLag uint64 = 5
+	// MaxResubscribeBackoff is the longest time between attempts to make failed subscription again
+	MaxResubscribeBackoff = time.Minute
+)
+
+const (
+	healthCheckTimeout = 10 * time.Second
+	dialTimeout        = 10 * time.Second
+	// errorRateDecay is the weight of the latest request in error rate of the endpoint
+	errorRateDecay = 0.1
+)
+
+var ErrNoEndpoints = errors.New("no available endpoints")
+
+type EndpointMetrics interface {
+	TrackEndpointRequest(domainID uint8, endpoint string, method string, latency time.Duration, failed bool)
+	TrackEndpointErrorRate(domainID uint8, endpoint string, errorRate float64)
+}
+
+type endpoint struct {
+	index         int
+	url           string
+	name          string // name is the endpoint URL without path and credentials used in logs and metrics
+	client        *rpc.Client
+	limiter       *rate.Limiter
+	subscriptions bool
+	head          uint64
+	errorRate     float64
+	healthy       bool
+}
+
+// EndpointPool routes RPC calls to the healthiest of configured endpoints and fails over to other
+// endpoints on connection errors. Endpoints are health checked by reading their heads and endpoints
+// lagging behind the highest known head are treated as unhealthy.
+// Calls are rate limited per endpoint, time out after configured timeout and are retried on transient errors.
+type EndpointPool struct {
+	endpoints []*endpoint
+	domainID  uint8
+	opts      MiddlewareOpts
+	metrics   EndpointMetrics
+	lock      sync.RWMutex
+}
+
+// NewEndpointPool dials all provided endpoints. Endpoints are preferred in provided order when they are equally healthy.
+// Endpoints that cannot be dialed are dialed again on health check as long as at least one endpoint is available.
+func NewEndpointPool(ctx context.Context, urls []string, domainID uint8, opts MiddlewareOpts) (*EndpointPool, error) {
+	p := &EndpointPool{domainID: domainID, opts: opts}
+
+	var dialErr error
+	for i, rawURL := range urls {
+		e := &endpoint{
+			index:         i,
+			url:           rawURL,
+			name:          endpointName(rawURL),
+			subscriptions: supportsSubscriptions(rawURL),
+		}
+		if opts.RateLimit > 0 {
+			burst := opts.RateBurst
+			if burst < 1 {
+				burst = int(math.Ceil(opts.RateLimit))
+			}
+			e.limiter = rate.NewLimiter(rate.Limit(opts.RateLimit), burst)
+		}
+		p.endpoints = append(p.endpoints, e)
+
+		dialCtx, cancel := context.WithTimeout(ctx, dialTimeout)
+		client, err := rpc.DialContext(dialCtx, rawURL)
+		cancel()
+		if err != nil {
+			log.Warn().Err(err).Uint8("domainID", domainID).Str("endpoint", e.name).Msg("Failed dialing endpoint")
+			dialErr = err
+			continue
+		}
+		e.client = client
+		e.healthy = true
+	}
+
+	if len(p.available()) == 0 {
+		if dialErr == nil {
+			dialErr = ErrNoEndpoints
+		}
+		return nil, dialErr
+	}
+	return p, nil
+}
+
+// SetMetrics makes pool report requests and error rates of endpoints to metrics
+func (p *EndpointPool) SetMetrics(metrics EndpointMetrics) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.metrics = metrics
+}
+
+// RPCClient returns RPC client that sends all calls through the pool.
+// Subscriptions are not supported by returned client and should be made through SubscriptionClient.
+func (p *EndpointPool) RPCClient() (*rpc.Client, error) {
+	return rpc.DialHTTPWithClient("http://endpoint-pool", &http.Client{Transport: p})
+}
+
+// SubscriptionClient returns RPC client of the healthiest endpoint that supports subscriptions
+func (p *EndpointPool) SubscriptionClient() (*rpc.Client, error) {
+	for _, e := range p.ordered() {
+		if e.subscriptions {
+			return e.client, nil
+		}
+	}
+	return nil, rpc.ErrNotificationsUnsupported
+}
+
+// SubscribeFunc makes subscription with RPC client of an endpoint
+type SubscribeFunc func(ctx context.Context, client *rpc.Client) (ethereum.Subscription, error)
+
+// Subscribe makes subscription on the healthiest endpoint that supports subscriptions. If the
+// subscription fails, for example when endpoint connection is lost, it is made again on the healthiest
+// endpoint that supports subscriptions at the time. Notifications sent while resubscribing are missed.
+// Returned subscription fails only if the first subscription can not be made.
+func (p *EndpointPool) Subscribe(ctx context.Context, subscribe SubscribeFunc) (ethereum.Subscription, error) {
+	client, err := p.SubscriptionClient()
+	if err != nil {
+		return nil, err
+	}
+	// first subscription is made directly so that unsupported subscriptions are reported to the caller
+	first, err := subscribe(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	return event.ResubscribeErr(MaxResubscribeBackoff, func(ctx context.Context, lastErr error) (event.Subscription, error) {
+		if first != nil {
+			sub := first
+			first = nil
+			return sub, nil
+		}
+
+		log.Warn().Err(lastErr).Uint8("domainID", p.domainID).Msg("Subscription failed, resubscribing")
+		client, err := p.SubscriptionClient()
+		if err != nil {
+			return nil, err
+		}
+		return subscribe(ctx, client)
+	}), nil
+}
+
+// CallContext performs JSON-RPC call on the healthiest endpoint and sends it to other endpoints if the endpoint fails.
+// Calls that failed on all endpoints are retried with increasing delay. Errors returned by the node for the call are not retried.
+// Transactions are sent only once and are never sent to other endpoints or retried.
+func (p *EndpointPool) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	return p.do(ctx, method, isRetryable(method), func(ctx context.Context, client *rpc.Client) error {
+		return client.CallContext(ctx, result, method, args...)
+	})
+}
+
+// BatchCallContext sends all calls in a single batch request to the healthiest endpoint and
+// sends the batch to other endpoints or retries it the same way as CallContext
+func (p *EndpointPool) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	retryable := true
+	for _, elem := range b {
+		retryable = retryable && isRetryable(elem.Method)
+	}
+	return p.do(ctx, "batch", retryable, func(ctx context.Context, client *rpc.Client) error {
+		return client.BatchCallContext(ctx, b)
+	})
+}
+
+// Start checks health of endpoints immediately and then every HealthCheckPeriod until stop channel is closed
+func (p *EndpointPool) Start(stop <-chan struct{}) {
+	for {
+		p.Check()
+
+		select {
+		case <-stop:
+			return
+		case <-time.After(HealthCheckPeriod):
+		}
+	}
+}
+
+// Check reads heads of all endpoints and marks endpoints that failed or lag
+// behind the highest head by more than MaxHeadLag blocks as unhealthy
+func (p *EndpointPool) Check() {
+	p.lock.RLock()
+	endpoints := make([]endpoint, len(p.endpoints))
+	for i, e := range p.endpoints {
+		endpoints[i] = *e
+	}
+	p.lock.RUnlock()
+
+	heads := make([]uint64, len(endpoints))
+	errs := make([]error, len(endpoints))
+	wg := sync.WaitGroup{}
+	for i := range endpoints {
+		wg.Add(1)
+		go func(e *endpoint) {
+			defer wg.Done()
+			heads[e.index], errs[e.index] = p.readHead(e)
+		}(&endpoints[i])
+	}
+	wg.Wait()
+
+	var best uint64
+	for i, head := range heads {
+		if errs[i] == nil && head > best {
+			best = head
+		}
+	}
+
+	p.lock.Lock()
+	metrics := p.metrics
+	errorRates := make([]float64, len(p.endpoints))
+	for i, e := range p.endpoints {
+		if e.client == nil {
+			e.client = endpoints[i].client
+		}
+		healthy := errs[i] == nil && heads[i]+MaxHeadLag >= best
+		if errs[i] == nil {
+			e.head = heads[i]
+		}
+
+		switch {
+		case !healthy && e.healthy && errs[i] != nil:
+			log.Warn().Err(errs[i]).Uint8("domainID", p.domainID).Str("endpoint", e.name).Msg("Endpoint is unhealthy")
+		case !healthy && e.healthy:
+			log.Warn().Uint8("domainID", p.domainID).Str("endpoint", e.name).Uint64("head", heads[i]).Uint64("bestHead", best).Msg("Endpoint head is stale")
+		case healthy && !e.healthy:
+			log.Info().Uint8("domainID", p.domainID).Str("endpoint", e.name).Msg("Endpoint recovered")
+		}
+		e.healthy = healthy
+		errorRates[i] = e.errorRate
+	}
+	p.lock.Unlock()
+
+	if metrics != nil {
+		for i, e := range endpoints {
+			metrics.TrackEndpointErrorRate(p.domainID, e.name, errorRates[i])
+		}
+	}
+}
+
+// Close closes connections to all endpoints
+func (p *EndpointPool) Close() {
+	for _, e := range p.available() {
+		e.client.Close()
+	}
+}
+
+func (p *EndpointPool) readHead(e *endpoint) (uint64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), healthCheckTimeout)
+	defer cancel()
+
+	if e.client == nil {
+		client, err := rpc.DialContext(ctx, e.url)
+		if err != nil {
+			return 0, err
+		}
+		e.client = client
+	}
+
+	var head hexutil.Uint64
+	err := p.send(ctx, *e, "eth_blockNumber", func(ctx context.Context, client *rpc.Client) error {
+		return client.CallContext(ctx, &head, "eth_blockNumber")
+	})
+	if err != nil {
+		return 0, err
+	}
+	return uint64(head), nil
+}
+
+func (p *EndpointPool) do(ctx context.Context, method string, retryable bool, call func(ctx context.Context, client *rpc.Client) error) error {
+	if !retryable {
+		endpoints := p.ordered()
+		if len(endpoints) == 0 {
+			return ErrNoEndpoints
+		}
+		return p.send(ctx, endpoints[0], method, call)
+	}
+
+	delay := p.opts.RetryDelay
+	for retry := 0; ; retry++ {
+		err := p.tryEndpoints(ctx, method, call)
+		if err == nil || ctx.Err() != nil || classifyError(err) == permanentError || retry >= p.opts.Retries {
+			return err
+		}
+
+		log.Debug().Err(err).Uint8("domainID", p.domainID).Str("method", method).Int("retry", retry+1).Msg("Call failed on all endpoints, retrying")
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+func (p *EndpointPool) tryEndpoints(ctx context.Context, method string, call func(ctx context.Context, client *rpc.Client) error) error {
+	err := ErrNoEndpoints
+	for _, e := range p.ordered() {
+		err = p.send(ctx, e, method, call)
+		if err == nil || ctx.Err() != nil || classifyError(err) == permanentError {
+			return err
+		}
+
+		log.Debug().Err(err).Uint8("domainID", p.domainID).Str("endpoint", e.name).Str("method", method).Msg("Call failed, sending it to next endpoint")
+	}
+	return err
+}
+
+// send makes a single call to the endpoint after waiting for rate limit and records its result
+func (p *EndpointPool) send(ctx context.Context, e endpoint, method string, call func(ctx context.Context, client *rpc.Client) error) error {
+	if e.limiter != nil {
+		err := e.limiter.Wait(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	callCtx := ctx
+	if p.opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		callCtx, cancel = context.WithTimeout(ctx, p.opts.Timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	err := call(callCtx, e.client)
+	if ctx.Err() != nil {
+		return err
+	}
+	p.record(e.index, method, time.Since(start), err)
+	return err
+}
+
+// record updates error rate of the endpoint and reports the call to metrics. Endpoint that failed
+// to respond is treated as unhealthy until it passes next health check.
+func (p *EndpointPool) record(index int, method string, latency time.Duration, err error) {
+	class := permanentError
+	if err != nil {
+		class = classifyError(err)
+	}
+	failed := class != permanentError
+
+	p.lock.Lock()
+	e := p.endpoints[index]
+	e.errorRate = nextErrorRate(e.errorRate, failed)
+	if class == endpointError && e.healthy {
+		e.healthy = false
+		log.Warn().Err(err).Uint8("domainID", p.domainID).Str("endpoint", e.name).Msg("Endpoint failed, failing over to next endpoint")
+	}
+	metrics := p.metrics
+	p.lock.Unlock()
+
+	if metrics != nil {
+		metrics.TrackEndpointRequest(p.domainID, e.name, method, latency, failed)
+	}
+}
+
+// ordered returns dialed endpoints with healthy endpoints first. Endpoints with equal health are
+// ordered by error rate in steps of 25% and then by configured order.
+func (p *EndpointPool) ordered() []endpoint {
+	endpoints := p.available()
+	sort.SliceStable(endpoints, func(i, j int) bool {
+		if endpoints[i].healthy != endpoints[j].healthy {
+			return endpoints[i].healthy
+		}
+		return int(endpoints[i].errorRate*4) < int(endpoints[j].errorRate*4)
+	})
+	return endpoints
+}
+
+func (p *EndpointPool) available() []endpoint {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	endpoints := make([]endpoint, 0, len(p.endpoints))
+	for _, e := range p.endpoints {
+		if e.client != nil {
+			endpoints = append(endpoints, *e)
+		}
+	}
+	return endpoints
+}
+
+func nextErrorRate(errorRate float64, failed bool) float64 {
+	var value float64
+	if failed {
+		value = 1
+	}
+	return errorRate*(1-errorRateDecay) + value*errorRateDecay
+}
+
+func supportsSubscriptions(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+	return u.Scheme != "http" && u.Scheme != "https"
+}
+
+func endpointName(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return rawURL
+	}
+	return u.Scheme + "://" + u.Host
+}
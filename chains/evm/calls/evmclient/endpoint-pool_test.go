@@ -0,0 +1,315 @@
+package evmclient_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	mock_evmclient "github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient/mock"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type limitExceededError struct{}
+
+func (e limitExceededError) Error() string  { return "limit exceeded" }
+func (e limitExceededError) ErrorCode() int { return -32005 }
+
+type testEthService struct {
+	head          int64
+	limitExceeded int32
+}
+
+func (s *testEthService) BlockNumber() hexutil.Uint64 {
+	return hexutil.Uint64(atomic.LoadInt64(&s.head))
+}
+
+// Call returns call data as result and reverts calls without data
+func (s *testEthService) Call(args *struct{ Data hexutil.Bytes }, block *string) (hexutil.Bytes, error) {
+	if args == nil || len(args.Data) == 0 {
+		return nil, errors.New("execution reverted")
+	}
+	return args.Data, nil
+}
+
+// ChainId fails with limit exceeded error until limitExceeded calls are made
+func (s *testEthService) ChainId() (hexutil.Uint64, error) {
+	if atomic.AddInt32(&s.limitExceeded, -1) >= 0 {
+		return 0, limitExceededError{}
+	}
+	return 1, nil
+}
+
+// NewHeads notifies subscriber of the current head once
+func (s *testEthService) NewHeads(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, ok := rpc.NotifierFromContext(ctx)
+	if !ok {
+		return nil, rpc.ErrNotificationsUnsupported
+	}
+	sub := notifier.CreateSubscription()
+	go func() {
+		_ = notifier.Notify(sub.ID, s.BlockNumber())
+	}()
+	return sub, nil
+}
+
+type testEndpoint struct {
+	service   *testEthService
+	rpcServer *rpc.Server
+	server    *httptest.Server
+	status    int32
+	delay     int64
+	requests  int32
+}
+
+func newTestEndpoint(head int64) *testEndpoint {
+	e := &testEndpoint{service: &testEthService{head: head}, rpcServer: rpc.NewServer()}
+	_ = e.rpcServer.RegisterName("eth", e.service)
+	wsHandler := e.rpcServer.WebsocketHandler(nil)
+	e.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&e.requests, 1)
+		time.Sleep(time.Duration(atomic.LoadInt64(&e.delay)))
+		if status := atomic.LoadInt32(&e.status); status != 0 {
+			w.WriteHeader(int(status))
+			return
+		}
+		if r.Header.Get("Upgrade") == "websocket" {
+			wsHandler.ServeHTTP(w, r)
+			return
+		}
+		e.rpcServer.ServeHTTP(w, r)
+	}))
+	return e
+}
+
+func (e *testEndpoint) wsURL() string {
+	return "ws" + strings.TrimPrefix(e.server.URL, "http")
+}
+
+// close stops the endpoint and drops its websocket connections
+func (e *testEndpoint) close() {
+	e.rpcServer.Stop()
+	e.server.Close()
+}
+
+func (e *testEndpoint) setDown(down bool) {
+	var status int32
+	if down {
+		status = http.StatusServiceUnavailable
+	}
+	atomic.StoreInt32(&e.status, status)
+}
+
+type EndpointPoolTestSuite struct {
+	suite.Suite
+	primary     *testEndpoint
+	backup      *testEndpoint
+	pool        *evmclient.EndpointPool
+	metricsMock *mock_evmclient.MockEndpointMetrics
+}
+
+func TestRunEndpointPoolTestSuite(t *testing.T) {
+	suite.Run(t, new(EndpointPoolTestSuite))
+}
+
+func (s *EndpointPoolTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.metricsMock = mock_evmclient.NewMockEndpointMetrics(gomockController)
+	s.primary = newTestEndpoint(100)
+	s.backup = newTestEndpoint(100)
+
+	s.pool = s.newPool(evmclient.MiddlewareOpts{})
+}
+
+func (s *EndpointPoolTestSuite) newPool(opts evmclient.MiddlewareOpts) *evmclient.EndpointPool {
+	pool, err := evmclient.NewEndpointPool(context.Background(), []string{s.primary.server.URL, s.backup.server.URL}, 1, opts)
+	s.Nil(err)
+	return pool
+}
+
+func (s *EndpointPoolTestSuite) TearDownTest() {
+	s.pool.Close()
+	s.primary.server.Close()
+	s.backup.server.Close()
+}
+
+func (s *EndpointPoolTestSuite) head() (uint64, error) {
+	var head hexutil.Uint64
+	err := s.pool.CallContext(context.Background(), &head, "eth_blockNumber")
+	return uint64(head), err
+}
+
+func (s *EndpointPoolTestSuite) TestCallContext_UsesPreferredEndpoint() {
+	head, err := s.head()
+
+	s.Nil(err)
+	s.Equal(uint64(100), head)
+	s.Equal(int32(1), atomic.LoadInt32(&s.primary.requests))
+	s.Equal(int32(0), atomic.LoadInt32(&s.backup.requests))
+}
+
+func (s *EndpointPoolTestSuite) TestCallContext_FailsOverOnEndpointError() {
+	s.primary.setDown(true)
+
+	_, err := s.head()
+	s.Nil(err)
+	_, err = s.head()
+	s.Nil(err)
+
+	s.Equal(int32(1), atomic.LoadInt32(&s.primary.requests))
+	s.Equal(int32(2), atomic.LoadInt32(&s.backup.requests))
+}
+
+func (s *EndpointPoolTestSuite) TestCallContext_AllEndpointsFail() {
+	s.primary.setDown(true)
+	s.backup.setDown(true)
+
+	_, err := s.head()
+
+	s.NotNil(err)
+}
+
+func (s *EndpointPoolTestSuite) TestCallContext_NodeErrorNotRetried() {
+	err := s.pool.CallContext(context.Background(), nil, "eth_call")
+
+	s.NotNil(err)
+	s.Equal("execution reverted", err.Error())
+	s.Equal(int32(0), atomic.LoadInt32(&s.backup.requests))
+}
+
+func (s *EndpointPoolTestSuite) TestCheck_StaleHead() {
+	atomic.StoreInt64(&s.primary.service.head, 90)
+
+	s.pool.Check()
+	head, err := s.head()
+
+	s.Nil(err)
+	s.Equal(uint64(100), head)
+}
+
+func (s *EndpointPoolTestSuite) TestCheck_RecoveredEndpointPreferred() {
+	s.primary.setDown(true)
+	_, err := s.head()
+	s.Nil(err)
+
+	s.primary.setDown(false)
+	s.pool.Check()
+	atomic.StoreInt32(&s.primary.requests, 0)
+	_, err = s.head()
+
+	s.Nil(err)
+	s.Equal(int32(1), atomic.LoadInt32(&s.primary.requests))
+}
+
+func (s *EndpointPoolTestSuite) TestCheck_TracksErrorRates() {
+	s.pool.SetMetrics(s.metricsMock)
+	s.backup.setDown(true)
+	s.metricsMock.EXPECT().TrackEndpointRequest(uint8(1), endpointName(s.primary), "eth_blockNumber", gomock.Any(), false)
+	s.metricsMock.EXPECT().TrackEndpointRequest(uint8(1), endpointName(s.backup), "eth_blockNumber", gomock.Any(), true)
+	s.metricsMock.EXPECT().TrackEndpointErrorRate(uint8(1), endpointName(s.primary), 0.0)
+	s.metricsMock.EXPECT().TrackEndpointErrorRate(uint8(1), endpointName(s.backup), 0.1)
+
+	s.pool.Check()
+}
+
+func (s *EndpointPoolTestSuite) TestSubscriptionClient_NoWebsocketEndpoint() {
+	_, err := s.pool.SubscriptionClient()
+
+	s.Equal(rpc.ErrNotificationsUnsupported, err)
+}
+
+func (s *EndpointPoolTestSuite) TestRPCClient_RoutesCallsThroughPool() {
+	s.primary.setDown(true)
+	rpcClient, err := s.pool.RPCClient()
+	s.Nil(err)
+	client := ethclient.NewClient(rpcClient)
+
+	head, err := client.BlockNumber(context.Background())
+
+	s.Nil(err)
+	s.Equal(uint64(100), head)
+	s.Equal(int32(1), atomic.LoadInt32(&s.backup.requests))
+}
+
+func (s *EndpointPoolTestSuite) TestRPCClient_PassesNodeErrors() {
+	rpcClient, err := s.pool.RPCClient()
+	s.Nil(err)
+
+	err = rpcClient.CallContext(context.Background(), nil, "eth_call")
+
+	var rpcErr rpc.Error
+	s.True(errors.As(err, &rpcErr))
+	s.Equal("execution reverted", err.Error())
+}
+
+func (s *EndpointPoolTestSuite) TestRPCClient_Batch() {
+	s.primary.setDown(true)
+	rpcClient, err := s.pool.RPCClient()
+	s.Nil(err)
+	var head hexutil.Uint64
+	batch := []rpc.BatchElem{
+		{Method: "eth_blockNumber", Result: &head},
+		{Method: "eth_call"},
+	}
+
+	err = rpcClient.BatchCallContext(context.Background(), batch)
+
+	s.Nil(err)
+	s.Equal(hexutil.Uint64(100), head)
+	s.Nil(batch[0].Error)
+	s.NotNil(batch[1].Error)
+}
+
+func (s *EndpointPoolTestSuite) TestSubscribe_ResubscribesOnHealthyEndpoint() {
+	evmclient.MaxResubscribeBackoff = 100 * time.Millisecond
+	defer func() { evmclient.MaxResubscribeBackoff = time.Minute }()
+	atomic.StoreInt64(&s.backup.service.head, 101)
+	pool, err := evmclient.NewEndpointPool(context.Background(), []string{s.primary.wsURL(), s.backup.wsURL()}, 1, evmclient.MiddlewareOpts{})
+	s.Nil(err)
+	defer pool.Close()
+	heads := make(chan hexutil.Uint64, 10)
+
+	sub, err := pool.Subscribe(context.Background(), func(ctx context.Context, client *rpc.Client) (ethereum.Subscription, error) {
+		return client.EthSubscribe(ctx, heads, "newHeads")
+	})
+	s.Nil(err)
+	defer sub.Unsubscribe()
+	s.Equal(hexutil.Uint64(100), s.receiveHead(heads))
+
+	s.primary.close()
+	pool.Check()
+
+	s.Equal(hexutil.Uint64(101), s.receiveHead(heads))
+}
+
+func (s *EndpointPoolTestSuite) TestSubscribe_NoWebsocketEndpoint() {
+	_, err := s.pool.Subscribe(context.Background(), func(ctx context.Context, client *rpc.Client) (ethereum.Subscription, error) {
+		return client.EthSubscribe(ctx, make(chan hexutil.Uint64), "newHeads")
+	})
+
+	s.Equal(rpc.ErrNotificationsUnsupported, err)
+}
+
+func (s *EndpointPoolTestSuite) receiveHead(heads chan hexutil.Uint64) hexutil.Uint64 {
+	select {
+	case head := <-heads:
+		return head
+	case <-time.After(5 * time.Second):
+		s.Fail("no head received")
+		return 0
+	}
+}
+
+func endpointName(e *testEndpoint) string {
+	return e.server.URL
+}
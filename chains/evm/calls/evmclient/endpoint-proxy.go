@@ -0,0 +1,160 @@
+package evmclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const internalErrorCode = -32603
+
+type jsonrpcMessage struct {
+	Version string          `json:"jsonrpc,omitempty"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method,omitempty"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	Error   *jsonError      `json:"error,omitempty"`
+	Result  json.RawMessage `json:"result,omitempty"`
+}
+
+type jsonError struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+// RoundTrip serves JSON-RPC requests of the client returned by RPCClient by sending them through the pool.
+// Errors returned by the node are passed to the client as JSON-RPC errors.
+func (p *EndpointPool) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	var res interface{}
+	body = bytes.TrimSpace(body)
+	if len(body) > 0 && body[0] == '[' {
+		var msgs []*jsonrpcMessage
+		err = json.Unmarshal(body, &msgs)
+		if err != nil {
+			return nil, err
+		}
+		res, err = p.proxyBatch(req, msgs)
+	} else {
+		var msg jsonrpcMessage
+		err = json.Unmarshal(body, &msg)
+		if err != nil {
+			return nil, err
+		}
+		res, err = p.proxyCall(req, &msg)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := json.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(out)),
+		ContentLength: int64(len(out)),
+		Request:       req,
+	}, nil
+}
+
+func (p *EndpointPool) proxyCall(req *http.Request, msg *jsonrpcMessage) (*jsonrpcMessage, error) {
+	args, err := msg.args()
+	if err != nil {
+		return nil, err
+	}
+
+	var result json.RawMessage
+	err = p.CallContext(req.Context(), &result, msg.Method, args...)
+	var rpcErr rpc.Error
+	if err != nil && !errors.As(err, &rpcErr) {
+		return nil, err
+	}
+	return msg.response(result, err), nil
+}
+
+func (p *EndpointPool) proxyBatch(req *http.Request, msgs []*jsonrpcMessage) ([]*jsonrpcMessage, error) {
+	batch := make([]rpc.BatchElem, len(msgs))
+	for i, msg := range msgs {
+		args, err := msg.args()
+		if err != nil {
+			return nil, err
+		}
+		batch[i] = rpc.BatchElem{
+			Method: msg.Method,
+			Args:   args,
+			Result: new(json.RawMessage),
+		}
+	}
+
+	err := p.BatchCallContext(req.Context(), batch)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*jsonrpcMessage, len(msgs))
+	for i, msg := range msgs {
+		res[i] = msg.response(*batch[i].Result.(*json.RawMessage), batch[i].Error)
+	}
+	return res, nil
+}
+
+func (m *jsonrpcMessage) args() ([]interface{}, error) {
+	if len(m.Params) == 0 {
+		return nil, nil
+	}
+
+	var params []json.RawMessage
+	err := json.Unmarshal(m.Params, &params)
+	if err != nil {
+		return nil, err
+	}
+	args := make([]interface{}, len(params))
+	for i, param := range params {
+		args[i] = param
+	}
+	return args, nil
+}
+
+func (m *jsonrpcMessage) response(result json.RawMessage, err error) *jsonrpcMessage {
+	res := &jsonrpcMessage{Version: "2.0", ID: m.ID}
+	if err != nil {
+		res.Error = toJSONError(err)
+		return res
+	}
+
+	res.Result = result
+	if len(result) == 0 {
+		res.Result = json.RawMessage("null")
+	}
+	return res
+}
+
+func toJSONError(err error) *jsonError {
+	jsonErr := &jsonError{Code: internalErrorCode, Message: err.Error()}
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		jsonErr.Code = rpcErr.ErrorCode()
+	}
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		jsonErr.Data = dataErr.ErrorData()
+	}
+	return jsonErr
+}
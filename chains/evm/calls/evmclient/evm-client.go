@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/consts"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/signer"
 	"github.com/ChainSafe/chainbridge-core/config/chain"
 	"github.com/ChainSafe/chainbridge-core/crypto/secp256k1"
 	"github.com/ChainSafe/chainbridge-core/keystore"
@@ -32,11 +33,11 @@ import (
 
 type EVMClient struct {
 	*ethclient.Client
-	kp         *secp256k1.Keypair
-	gethClient *gethclient.Client
-	rpClient   *rpc.Client
-	nonce      *big.Int
-	nonceLock  sync.Mutex
+	signer    signer.Signer
+	endpoints *EndpointPool
+	nonce     *big.Int
+	nonceLock sync.Mutex
+	pool      []*EVMClient
 }
 
 // DepositLogs struct holds event data with all necessary parameters and a handler response
@@ -71,57 +72,154 @@ type CommonTransaction interface {
 	// Hash returns the transaction hash.
 	Hash() common.Hash
 
-	// RawWithSignature Returns signed transaction by provided private key
-	RawWithSignature(key *ecdsa.PrivateKey, domainID *big.Int) ([]byte, error)
+	// RawWithSignature Returns transaction signed by provided signer
+	RawWithSignature(ctx context.Context, txSigner signer.Signer, domainID *big.Int) ([]byte, error)
 }
 
 // NewEVMClientFromParams creates a client for EVMChain with provided
 // private key.
 func NewEVMClientFromParams(url string, privateKey *ecdsa.PrivateKey) (*EVMClient, error) {
-	rpcClient, err := rpc.DialContext(context.TODO(), url)
+	c := &EVMClient{}
+	err := c.dial([]string{url}, 0, DefaultMiddlewareOpts())
 	if err != nil {
 		return nil, err
 	}
-	c := &EVMClient{}
-	c.Client = ethclient.NewClient(rpcClient)
-	c.gethClient = gethclient.New(rpcClient)
-	c.rpClient = rpcClient
-	c.kp = secp256k1.NewKeypair(*privateKey)
+	c.signer = signer.NewLocalSigner(secp256k1.NewKeypair(*privateKey))
 	return c, nil
 }
 
 // NewEVMClient creates a client for EVM chain configured with specified config.
 // Private key is chosen by 'from' param in chain config that matches filename inside keystore path.
+// If remote signer is configured transactions are signed by the signer with key of 'from' address instead.
+// Keys of key pool addresses are loaded the same way and each of them gets its own client.
 func NewEVMClient(cfg *chain.EVMConfig) (*EVMClient, error) {
 	c := &EVMClient{}
 	generalConfig := cfg.GeneralChainConfig
 
-	kp, err := keystore.KeypairFromAddress(generalConfig.From, keystore.EthChain, generalConfig.KeystorePath, generalConfig.Insecure)
+	s, err := newSigner(cfg, generalConfig.From)
 	if err != nil {
 		return c, err
 	}
-	krp := kp.(*secp256k1.Keypair)
-	c.kp = krp
-
-	rpcClient, err := rpc.DialContext(context.TODO(), generalConfig.Endpoint)
+	c.signer = s
+
+	err = c.dial(generalConfig.EndpointURLs(), *generalConfig.Id, MiddlewareOpts{
+		Timeout:    cfg.RPCTimeout,
+		RateLimit:  cfg.RPCRateLimit,
+		Retries:    cfg.RPCRetries,
+		RetryDelay: consts.DefaultRPCRetryDelay,
+	})
 	if err != nil {
 		return c, err
 	}
-	c.Client = ethclient.NewClient(rpcClient)
-	c.rpClient = rpcClient
-	c.gethClient = gethclient.New(rpcClient)
+
+	for _, address := range cfg.KeyPool {
+		s, err := newSigner(cfg, address)
+		if err != nil {
+			return c, err
+		}
+		c.pool = append(c.pool, c.withSigner(s))
+	}
 
 	return c, nil
 }
 
-func (c *EVMClient) SubscribePendingTransactions(ctx context.Context, ch chan<- common.Hash) (*rpc.ClientSubscription, error) {
-	return c.gethClient.SubscribePendingTransactions(ctx, ch)
+// newSigner creates signer of address that uses remote signer if configured or key from keystore
+func newSigner(cfg *chain.EVMConfig, address string) (signer.Signer, error) {
+	if cfg.Signer != "" {
+		return signer.NewRemoteSigner(context.TODO(), cfg.Signer, common.HexToAddress(address))
+	}
+
+	kp, err := keystore.KeypairFromAddress(address, keystore.EthChain, cfg.GeneralChainConfig.KeystorePath, cfg.GeneralChainConfig.Insecure)
+	if err != nil {
+		return nil, err
+	}
+	return signer.NewLocalSigner(kp.(*secp256k1.Keypair)), nil
+}
+
+// withSigner creates client that shares connections with c
+// but signs transactions with s and tracks its own nonce
+func (c *EVMClient) withSigner(s signer.Signer) *EVMClient {
+	return &EVMClient{
+		Client:    c.Client,
+		signer:    s,
+		endpoints: c.endpoints,
+	}
+}
+
+// dial connects to all endpoints and makes client route calls through endpoint pool
+func (c *EVMClient) dial(urls []string, domainID uint8, opts MiddlewareOpts) error {
+	endpoints, err := NewEndpointPool(context.TODO(), urls, domainID, opts)
+	if err != nil {
+		return err
+	}
+	rpcClient, err := endpoints.RPCClient()
+	if err != nil {
+		return err
+	}
+	c.Client = ethclient.NewClient(rpcClient)
+	c.endpoints = endpoints
+	return nil
+}
+
+// Signer returns signer of relayer transactions
+func (c *EVMClient) Signer() signer.Signer {
+	return c.signer
+}
+
+// KeyClients returns clients of all relayer keys starting with c followed by clients of key pool keys.
+// Key clients share connections with c so only c should be closed.
+func (c *EVMClient) KeyClients() []*EVMClient {
+	return append([]*EVMClient{c}, c.pool...)
+}
+
+// RelayerAddresses returns addresses of all relayer keys the relayer sends transactions with
+func (c *EVMClient) RelayerAddresses() []common.Address {
+	addresses := []common.Address{c.signer.Address()}
+	for _, k := range c.pool {
+		addresses = append(addresses, k.signer.Address())
+	}
+	return addresses
+}
+
+// Endpoints returns pool of endpoints the client sends calls to
+func (c *EVMClient) Endpoints() *EndpointPool {
+	return c.endpoints
+}
+
+// Close closes connections to all endpoints
+func (c *EVMClient) Close() {
+	c.Client.Close()
+	c.endpoints.Close()
+}
+
+// SubscribePendingTransactions subscribes to hashes of pending transactions on the healthiest endpoint
+// that supports subscriptions. Subscription is moved to another endpoint if it fails.
+func (c *EVMClient) SubscribePendingTransactions(ctx context.Context, ch chan<- common.Hash) (ethereum.Subscription, error) {
+	return c.endpoints.Subscribe(ctx, func(ctx context.Context, client *rpc.Client) (ethereum.Subscription, error) {
+		return gethclient.New(client).SubscribePendingTransactions(ctx, ch)
+	})
+}
+
+// SubscribeFilterLogs subscribes to logs matching the query on the healthiest endpoint that supports subscriptions.
+// Subscription is moved to another endpoint if it fails.
+func (c *EVMClient) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return c.endpoints.Subscribe(ctx, func(ctx context.Context, client *rpc.Client) (ethereum.Subscription, error) {
+		return ethclient.NewClient(client).SubscribeFilterLogs(ctx, q, ch)
+	})
+}
+
+// SubscribeNewHead subscribes to new block headers on the healthiest endpoint that supports subscriptions.
+// Subscription is moved to another endpoint if it fails.
+func (c *EVMClient) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	return c.endpoints.Subscribe(ctx, func(ctx context.Context, client *rpc.Client) (ethereum.Subscription, error) {
+		return ethclient.NewClient(client).SubscribeNewHead(ctx, ch)
+	})
 }
 
 // LatestBlock returns the latest block from the current chain
 func (c *EVMClient) LatestBlock() (*big.Int, error) {
 	var head *headerNumber
-	err := c.rpClient.CallContext(context.Background(), &head, "eth_getBlockByNumber", toBlockNumArg(nil), false)
+	err := c.endpoints.CallContext(context.Background(), &head, "eth_getBlockByNumber", toBlockNumArg(nil), false)
 	if err == nil && head == nil {
 		err = ethereum.NotFound
 	}
@@ -217,22 +315,61 @@ func (c *EVMClient) FetchEventLogs(ctx context.Context, contractAddress common.A
 	return c.FilterLogs(ctx, buildQuery(contractAddress, event, startBlock, endBlock))
 }
 
+// FetchMultipleEventLogs fetches logs of any of provided events emitted by contract in a single query
+func (c *EVMClient) FetchMultipleEventLogs(ctx context.Context, contractAddress common.Address, events []string, startBlock *big.Int, endBlock *big.Int) ([]types.Log, error) {
+	return c.FilterLogs(ctx, buildMultipleEventsQuery(contractAddress, events, startBlock, endBlock))
+}
+
 // SendRawTransaction accepts rlp-encode of signed transaction and sends it via RPC call
 func (c *EVMClient) SendRawTransaction(ctx context.Context, tx []byte) error {
-	return c.rpClient.CallContext(ctx, nil, "eth_sendRawTransaction", hexutil.Encode(tx))
+	return c.endpoints.CallContext(ctx, nil, "eth_sendRawTransaction", hexutil.Encode(tx))
 }
 
 func (c *EVMClient) CallContract(ctx context.Context, callArgs map[string]interface{}, blockNumber *big.Int) ([]byte, error) {
 	var hex hexutil.Bytes
-	err := c.rpClient.CallContext(ctx, &hex, "eth_call", callArgs, toBlockNumArg(blockNumber))
+	err := c.endpoints.CallContext(ctx, &hex, "eth_call", callArgs, toBlockNumArg(blockNumber))
 	if err != nil {
 		return nil, err
 	}
 	return hex, nil
 }
 
+// BatchCallContract executes message calls with provided call args against provided block in a single batch request.
+// Results are returned in the order of call args and error is returned if any of the calls failed.
+func (c *EVMClient) BatchCallContract(ctx context.Context, callArgs []map[string]interface{}, blockNumber *big.Int) ([][]byte, error) {
+	results := make([]hexutil.Bytes, len(callArgs))
+	batch := make([]rpc.BatchElem, len(callArgs))
+	for i, args := range callArgs {
+		batch[i] = rpc.BatchElem{
+			Method: "eth_call",
+			Args:   []interface{}{args, toBlockNumArg(blockNumber)},
+			Result: &results[i],
+		}
+	}
+
+	err := c.BatchCallContext(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([][]byte, len(batch))
+	for i, elem := range batch {
+		if elem.Error != nil {
+			return nil, elem.Error
+		}
+		out[i] = results[i]
+	}
+	return out, nil
+}
+
+// BatchCallContext sends all calls of the batch in a single request. Error is returned
+// only if the request failed as a whole, errors of single calls are set on batch elements.
+func (c *EVMClient) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	return c.endpoints.BatchCallContext(ctx, b)
+}
+
 func (c *EVMClient) CallContext(ctx context.Context, target interface{}, rpcMethod string, args ...interface{}) error {
-	err := c.rpClient.CallContext(ctx, target, rpcMethod, args...)
+	err := c.endpoints.CallContext(ctx, target, rpcMethod, args...)
 	if err != nil {
 		return err
 	}
@@ -241,7 +378,7 @@ func (c *EVMClient) CallContext(ctx context.Context, target interface{}, rpcMeth
 
 func (c *EVMClient) PendingCallContract(ctx context.Context, callArgs map[string]interface{}) ([]byte, error) {
 	var hex hexutil.Bytes
-	err := c.rpClient.CallContext(ctx, &hex, "eth_call", callArgs, "pending")
+	err := c.endpoints.CallContext(ctx, &hex, "eth_call", callArgs, "pending")
 	if err != nil {
 		return nil, err
 	}
@@ -249,17 +386,11 @@ func (c *EVMClient) PendingCallContract(ctx context.Context, callArgs map[string
 }
 
 func (c *EVMClient) From() common.Address {
-	return c.kp.CommonAddress()
+	return c.signer.Address()
 }
 
 func (c *EVMClient) SignAndSendTransaction(ctx context.Context, tx CommonTransaction) (common.Hash, error) {
-	id, err := c.ChainID(ctx)
-	if err != nil {
-		//panic(err)
-		// Probably chain does not support chainID eg. CELO
-		id = nil
-	}
-	rawTx, err := tx.RawWithSignature(c.kp.PrivateKey(), id)
+	rawTx, err := c.SignTransaction(ctx, tx)
 	if err != nil {
 		return common.Hash{}, err
 	}
@@ -270,8 +401,20 @@ func (c *EVMClient) SignAndSendTransaction(ctx context.Context, tx CommonTransac
 	return tx.Hash(), nil
 }
 
+// SignTransaction signs transaction with relayer key and returns raw signed transaction.
+// Hash of the transaction is the hash of the signed transaction afterwards.
+func (c *EVMClient) SignTransaction(ctx context.Context, tx CommonTransaction) ([]byte, error) {
+	id, err := c.ChainID(ctx)
+	if err != nil {
+		//panic(err)
+		// Probably chain does not support chainID eg. CELO
+		id = nil
+	}
+	return tx.RawWithSignature(ctx, c.signer, id)
+}
+
 func (c *EVMClient) RelayerAddress() common.Address {
-	return c.kp.CommonAddress()
+	return c.signer.Address()
 }
 
 func (c *EVMClient) LockNonce() {
@@ -282,22 +425,37 @@ func (c *EVMClient) UnlockNonce() {
 	c.nonceLock.Unlock()
 }
 
+// UnsafeNonce returns nonce of the next relayer transaction. Nonce is read from the node
+// on first use or after reset and is assigned locally afterwards so that multiple
+// transactions can be in flight at once. Should be called while holding nonce lock.
 func (c *EVMClient) UnsafeNonce() (*big.Int, error) {
-	var err error
-	for i := 0; i <= 10; i++ {
-		nonce, err := c.PendingNonceAt(context.Background(), c.kp.CommonAddress())
-		if err != nil {
-			time.Sleep(1 * time.Second)
-			continue
-		}
-		c.nonce = big.NewInt(0).SetUint64(nonce)
-		return c.nonce, nil
+	if c.nonce != nil {
+		return new(big.Int).Set(c.nonce), nil
+	}
+
+	nonce, err := c.PendingNonceAt(context.Background(), c.signer.Address())
+	if err != nil {
+		return nil, err
 	}
-	return nil, err
+	c.nonce = big.NewInt(0).SetUint64(nonce)
+	return new(big.Int).Set(c.nonce), nil
 }
 
+// UnsafeIncreaseNonce moves locally assigned nonce to the next one after transaction is sent.
+// Should be called while holding nonce lock.
 func (c *EVMClient) UnsafeIncreaseNonce() error {
-	return errors.New("nonce not incremented manually anymore")
+	nonce, err := c.UnsafeNonce()
+	if err != nil {
+		return err
+	}
+	c.nonce = nonce.Add(nonce, big.NewInt(1))
+	return nil
+}
+
+// UnsafeResetNonce discards locally assigned nonce so that it is read from the node again.
+// Should be called while holding nonce lock.
+func (c *EVMClient) UnsafeResetNonce() {
+	c.nonce = nil
 }
 
 func (c *EVMClient) BaseFee() (*big.Int, error) {
@@ -308,6 +466,69 @@ func (c *EVMClient) BaseFee() (*big.Int, error) {
 	return head.BaseFee, nil
 }
 
+// EffectiveGasPrice returns effective gas price reported in receipt of mined transaction.
+// Receipt is read with a raw call as receipts of the go-ethereum version in use do not decode it.
+func (c *EVMClient) EffectiveGasPrice(ctx context.Context, txHash common.Hash) (*big.Int, error) {
+	var receipt *struct {
+		EffectiveGasPrice *hexutil.Big `json:"effectiveGasPrice"`
+	}
+	err := c.endpoints.CallContext(ctx, &receipt, "eth_getTransactionReceipt", txHash)
+	if err != nil {
+		return nil, err
+	}
+	if receipt == nil {
+		return nil, ethereum.NotFound
+	}
+	if receipt.EffectiveGasPrice == nil {
+		return nil, fmt.Errorf("receipt of %s does not contain effective gas price", txHash.Hex())
+	}
+	return receipt.EffectiveGasPrice.ToInt(), nil
+}
+
+// FeeHistory is the result of eth_feeHistory call
+type FeeHistory struct {
+	OldestBlock  *big.Int
+	Reward       [][]*big.Int // Reward holds priority fees at requested percentiles for each block
+	BaseFee      []*big.Int   // BaseFee holds base fees of each block including the next block after the newest one
+	GasUsedRatio []float64
+}
+
+type feeHistoryResult struct {
+	OldestBlock  *hexutil.Big     `json:"oldestBlock"`
+	Reward       [][]*hexutil.Big `json:"reward,omitempty"`
+	BaseFee      []*hexutil.Big   `json:"baseFeePerGas,omitempty"`
+	GasUsedRatio []float64        `json:"gasUsedRatio"`
+}
+
+// FeeHistory returns base fees and priority fees at given reward percentiles for blockCount blocks ending with lastBlock
+func (c *EVMClient) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*FeeHistory, error) {
+	var res feeHistoryResult
+	err := c.endpoints.CallContext(ctx, &res, "eth_feeHistory", hexutil.Uint(blockCount), toBlockNumArg(lastBlock), rewardPercentiles)
+	if err != nil {
+		return nil, err
+	}
+	if res.OldestBlock == nil {
+		return nil, errors.New("missing required field 'oldestBlock' for fee history")
+	}
+
+	history := &FeeHistory{
+		OldestBlock:  (*big.Int)(res.OldestBlock),
+		Reward:       make([][]*big.Int, len(res.Reward)),
+		BaseFee:      make([]*big.Int, len(res.BaseFee)),
+		GasUsedRatio: res.GasUsedRatio,
+	}
+	for i, blockRewards := range res.Reward {
+		history.Reward[i] = make([]*big.Int, len(blockRewards))
+		for j, reward := range blockRewards {
+			history.Reward[i][j] = (*big.Int)(reward)
+		}
+	}
+	for i, baseFee := range res.BaseFee {
+		history.BaseFee[i] = (*big.Int)(baseFee)
+	}
+	return history, nil
+}
+
 func toBlockNumArg(number *big.Int) string {
 	if number == nil {
 		return "latest"
@@ -327,3 +548,18 @@ func buildQuery(contract common.Address, sig string, startBlock *big.Int, endBlo
 	}
 	return query
 }
+
+// buildMultipleEventsQuery constructs a query that matches any of provided event signatures
+func buildMultipleEventsQuery(contract common.Address, sigs []string, startBlock *big.Int, endBlock *big.Int) ethereum.FilterQuery {
+	topics := make([]common.Hash, len(sigs))
+	for i, sig := range sigs {
+		topics[i] = crypto.Keccak256Hash([]byte(sig))
+	}
+	query := ethereum.FilterQuery{
+		FromBlock: startBlock,
+		ToBlock:   endBlock,
+		Addresses: []common.Address{contract},
+		Topics:    [][]common.Hash{topics},
+	}
+	return query
+}
@@ -1,14 +1,18 @@
 package evmclient_test
 
 import (
+	"context"
 	"encoding/hex"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/consts"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
 	"strings"
+	"sync/atomic"
 	"testing"
 
 	"github.com/ChainSafe/chainbridge-core/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 )
@@ -58,3 +62,46 @@ func (s *EvmClientTestSuite) TestUnpackDepositEventLogValidData() {
 	s.Equal(dl.ResourceID, expectedRID)
 	s.Equal(dl.HandlerResponse, []byte{})
 }
+
+type BatchCallTestSuite struct {
+	suite.Suite
+	endpoint *testEndpoint
+	client   *evmclient.EVMClient
+}
+
+func TestRunBatchCallTestSuite(t *testing.T) {
+	suite.Run(t, new(BatchCallTestSuite))
+}
+
+func (s *BatchCallTestSuite) SetupTest() {
+	s.endpoint = newTestEndpoint(100)
+	key, _ := crypto.GenerateKey()
+	client, err := evmclient.NewEVMClientFromParams(s.endpoint.server.URL, key)
+	s.Nil(err)
+	s.client = client
+}
+func (s *BatchCallTestSuite) TearDownTest() {
+	s.client.Close()
+	s.endpoint.server.Close()
+}
+
+func (s *BatchCallTestSuite) TestBatchCallContract_SingleRequest() {
+	res, err := s.client.BatchCallContract(context.Background(), []map[string]interface{}{
+		{"data": hexutil.Bytes{1}},
+		{"data": hexutil.Bytes{2}},
+	}, nil)
+
+	s.Nil(err)
+	s.Equal([][]byte{{1}, {2}}, res)
+	s.Equal(int32(1), atomic.LoadInt32(&s.endpoint.requests))
+}
+
+func (s *BatchCallTestSuite) TestBatchCallContract_CallFailed() {
+	_, err := s.client.BatchCallContract(context.Background(), []map[string]interface{}{
+		{"data": hexutil.Bytes{1}},
+		{},
+	}, nil)
+
+	s.NotNil(err)
+	s.Equal("execution reverted", err.Error())
+}
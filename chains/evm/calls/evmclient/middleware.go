@@ -0,0 +1,79 @@
+package evmclient
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/consts"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// limitExceededErrorCode is returned by nodes that reject calls over their rate limit
+const limitExceededErrorCode = -32005
+
+// MiddlewareOpts configure timeouts, rate limits and retries of calls sent through EndpointPool
+type MiddlewareOpts struct {
+	Timeout    time.Duration // Timeout of a single call to an endpoint, shortened if caller context expires earlier
+	RateLimit  float64       // RateLimit is the number of calls per second sent to each endpoint, unlimited if zero
+	RateBurst  int           // RateBurst is the number of calls that can be sent at once before rate limit applies, rate limit rounded up if zero
+	Retries    int           // Retries is the number of times calls that failed on all endpoints with transient errors are retried
+	RetryDelay time.Duration // RetryDelay is the delay before the first retry and is doubled for each subsequent retry
+}
+
+// DefaultMiddlewareOpts returns middleware options used if none are configured
+func DefaultMiddlewareOpts() MiddlewareOpts {
+	return MiddlewareOpts{
+		Timeout:    consts.DefaultRPCTimeout,
+		Retries:    consts.DefaultRPCRetries,
+		RetryDelay: consts.DefaultRPCRetryDelay,
+	}
+}
+
+// nonRetryableMethods are sent only once to the healthiest endpoint. Transaction that reached the node before
+// the call failed would be broadcast again if the call was retried, so the error is returned to the transactor
+// that reconciles the nonce instead.
+var nonRetryableMethods = map[string]bool{
+	"eth_sendRawTransaction": true,
+	"eth_sendTransaction":    true,
+}
+
+func isRetryable(method string) bool {
+	return !nonRetryableMethods[method]
+}
+
+type errorClass int
+
+const (
+	// permanentError is returned by the node for the call and is returned to the caller without retries
+	permanentError errorClass = iota
+	// transientError is returned by the node that temporarily can not serve the call, e.g. because of rate limit,
+	// call is sent to next endpoint and retried later
+	transientError
+	// endpointError is caused by endpoint that is unreachable or failed to respond in time,
+	// endpoint is treated as unhealthy and call is sent to next endpoint and retried later
+	endpointError
+)
+
+func classifyError(err error) errorClass {
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusTooManyRequests {
+		return transientError
+	}
+
+	var rpcErr rpc.Error
+	if !errors.As(err, &rpcErr) {
+		return endpointError
+	}
+	if rpcErr.ErrorCode() == limitExceededErrorCode {
+		return transientError
+	}
+	msg := strings.ToLower(rpcErr.Error())
+	if strings.Contains(msg, "rate limit") ||
+		strings.Contains(msg, "too many requests") ||
+		strings.Contains(msg, "header not found") {
+		return transientError
+	}
+	return permanentError
+}
@@ -0,0 +1,124 @@
+package evmclient_test
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+func (s *EndpointPoolTestSuite) chainID(pool *evmclient.EndpointPool) (uint64, error) {
+	var chainID hexutil.Uint64
+	err := pool.CallContext(context.Background(), &chainID, "eth_chainId")
+	return uint64(chainID), err
+}
+
+func (s *EndpointPoolTestSuite) TestMiddleware_RetriesTransientErrors() {
+	pool := s.newPool(evmclient.MiddlewareOpts{Retries: 2, RetryDelay: time.Millisecond})
+	defer pool.Close()
+	atomic.StoreInt32(&s.primary.service.limitExceeded, 2)
+	atomic.StoreInt32(&s.backup.service.limitExceeded, 2)
+
+	chainID, err := s.chainID(pool)
+
+	s.Nil(err)
+	s.Equal(uint64(1), chainID)
+	s.Equal(int32(3), atomic.LoadInt32(&s.primary.requests))
+	s.Equal(int32(2), atomic.LoadInt32(&s.backup.requests))
+}
+
+func (s *EndpointPoolTestSuite) TestMiddleware_RetriesExhausted() {
+	pool := s.newPool(evmclient.MiddlewareOpts{Retries: 1, RetryDelay: time.Millisecond})
+	defer pool.Close()
+	atomic.StoreInt32(&s.primary.service.limitExceeded, 5)
+	atomic.StoreInt32(&s.backup.service.limitExceeded, 5)
+
+	_, err := s.chainID(pool)
+
+	s.NotNil(err)
+	s.Equal(int32(2), atomic.LoadInt32(&s.primary.requests))
+	s.Equal(int32(2), atomic.LoadInt32(&s.backup.requests))
+}
+
+func (s *EndpointPoolTestSuite) TestMiddleware_PermanentErrorNotRetried() {
+	pool := s.newPool(evmclient.MiddlewareOpts{Retries: 3, RetryDelay: time.Millisecond})
+	defer pool.Close()
+
+	err := pool.CallContext(context.Background(), nil, "eth_call")
+
+	s.NotNil(err)
+	s.Equal(int32(1), atomic.LoadInt32(&s.primary.requests))
+	s.Equal(int32(0), atomic.LoadInt32(&s.backup.requests))
+}
+
+func (s *EndpointPoolTestSuite) TestMiddleware_TooManyRequestsKeepsEndpointHealthy() {
+	pool := s.newPool(evmclient.MiddlewareOpts{})
+	defer pool.Close()
+	atomic.StoreInt32(&s.primary.status, http.StatusTooManyRequests)
+	_, err := s.chainID(pool)
+	s.Nil(err)
+
+	atomic.StoreInt32(&s.primary.status, 0)
+	_, err = s.chainID(pool)
+
+	s.Nil(err)
+	s.Equal(int32(2), atomic.LoadInt32(&s.primary.requests))
+	s.Equal(int32(1), atomic.LoadInt32(&s.backup.requests))
+}
+
+func (s *EndpointPoolTestSuite) TestMiddleware_TimeoutFailsOver() {
+	pool := s.newPool(evmclient.MiddlewareOpts{Timeout: 50 * time.Millisecond})
+	defer pool.Close()
+	atomic.StoreInt64(&s.primary.delay, int64(time.Second))
+
+	chainID, err := s.chainID(pool)
+
+	s.Nil(err)
+	s.Equal(uint64(1), chainID)
+	s.Equal(int32(1), atomic.LoadInt32(&s.backup.requests))
+}
+
+func (s *EndpointPoolTestSuite) TestMiddleware_RateLimit() {
+	pool := s.newPool(evmclient.MiddlewareOpts{RateLimit: 20, RateBurst: 1})
+	defer pool.Close()
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		_, err := s.chainID(pool)
+		s.Nil(err)
+	}
+
+	s.GreaterOrEqual(time.Since(start), 90*time.Millisecond)
+}
+
+func (s *EndpointPoolTestSuite) TestMiddleware_TransactionNotSentToOtherEndpoint() {
+	pool := s.newPool(evmclient.MiddlewareOpts{Timeout: 50 * time.Millisecond, Retries: 2, RetryDelay: time.Millisecond})
+	defer pool.Close()
+	atomic.StoreInt64(&s.primary.delay, int64(time.Second))
+
+	err := pool.CallContext(context.Background(), nil, "eth_sendRawTransaction", "0x01")
+
+	s.NotNil(err)
+	s.Equal(int32(1), atomic.LoadInt32(&s.primary.requests))
+	s.Equal(int32(0), atomic.LoadInt32(&s.backup.requests))
+}
+
+func (s *EndpointPoolTestSuite) TestMiddleware_BatchWithTransactionNotRetried() {
+	pool := s.newPool(evmclient.MiddlewareOpts{Retries: 2, RetryDelay: time.Millisecond})
+	defer pool.Close()
+	s.primary.setDown(true)
+	batch := []rpc.BatchElem{
+		{Method: "eth_blockNumber", Result: new(hexutil.Uint64)},
+		{Method: "eth_sendRawTransaction", Args: []interface{}{"0x01"}},
+	}
+
+	err := pool.BatchCallContext(context.Background(), batch)
+
+	s.NotNil(err)
+	s.Equal(int32(1), atomic.LoadInt32(&s.primary.requests))
+	s.Equal(int32(0), atomic.LoadInt32(&s.backup.requests))
+}
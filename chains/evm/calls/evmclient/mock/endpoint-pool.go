@@ -0,0 +1,59 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: chains/evm/calls/evmclient/endpoint-pool.go
+
+// Package mock_evmclient is a generated GoMock package.
+package mock_evmclient
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockEndpointMetrics is a mock of EndpointMetrics interface.
+type MockEndpointMetrics struct {
+	ctrl     *gomock.Controller
+	recorder *MockEndpointMetricsMockRecorder
+}
+
+// MockEndpointMetricsMockRecorder is the mock recorder for MockEndpointMetrics.
+type MockEndpointMetricsMockRecorder struct {
+	mock *MockEndpointMetrics
+}
+
+// NewMockEndpointMetrics creates a new mock instance.
+func NewMockEndpointMetrics(ctrl *gomock.Controller) *MockEndpointMetrics {
+	mock := &MockEndpointMetrics{ctrl: ctrl}
+	mock.recorder = &MockEndpointMetricsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEndpointMetrics) EXPECT() *MockEndpointMetricsMockRecorder {
+	return m.recorder
+}
+
+// TrackEndpointErrorRate mocks base method.
+func (m *MockEndpointMetrics) TrackEndpointErrorRate(domainID uint8, endpoint string, errorRate float64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "TrackEndpointErrorRate", domainID, endpoint, errorRate)
+}
+
+// TrackEndpointErrorRate indicates an expected call of TrackEndpointErrorRate.
+func (mr *MockEndpointMetricsMockRecorder) TrackEndpointErrorRate(domainID, endpoint, errorRate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackEndpointErrorRate", reflect.TypeOf((*MockEndpointMetrics)(nil).TrackEndpointErrorRate), domainID, endpoint, errorRate)
+}
+
+// TrackEndpointRequest mocks base method.
+func (m *MockEndpointMetrics) TrackEndpointRequest(domainID uint8, endpoint, method string, latency time.Duration, failed bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "TrackEndpointRequest", domainID, endpoint, method, latency, failed)
+}
+
+// TrackEndpointRequest indicates an expected call of TrackEndpointRequest.
+func (mr *MockEndpointMetricsMockRecorder) TrackEndpointRequest(domainID, endpoint, method, latency, failed interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackEndpointRequest", reflect.TypeOf((*MockEndpointMetrics)(nil).TrackEndpointRequest), domainID, endpoint, method, latency, failed)
+}
@@ -0,0 +1,128 @@
+package evmgaspricer
+
+import (
+	"context"
+	"math/big"
+	"sort"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/consts"
+	"github.com/rs/zerolog/log"
+)
+
+// baseFeeChangeDenominator bounds the amount base fee can change between blocks as defined in EIP-1559
+const baseFeeChangeDenominator = 8
+
+type speedParams struct {
+	rewardPercentile float64
+	inclusionBlocks  uint64
+}
+
+// speeds define priority fee percentile and the number of blocks in which the transaction
+// should remain includable even if every block increases base fee by maximum amount
+var speeds = map[string]speedParams{
+	consts.GasPriceSpeedSlow:   {rewardPercentile: 10, inclusionBlocks: 10},
+	consts.GasPriceSpeedNormal: {rewardPercentile: 50, inclusionBlocks: 5},
+	consts.GasPriceSpeedFast:   {rewardPercentile: 90, inclusionBlocks: 2},
+}
+
+// IsValidSpeed checks if speed can be used by FeeHistory gasPricer
+func IsValidSpeed(speed string) bool {
+	_, ok := speeds[speed]
+	return ok
+}
+
+// FeeHistoryGasPriceDeterminant estimates EIP-1559 fees from priority fees paid in recent blocks.
+//
+// MaxTipCap is the median of priority fees paid at RewardPercentile over last FeeHistoryBlocks non-empty blocks.
+// MaxFeeCap is MaxTipCap + BaseFee of the next block projected to increase by maximum amount for InclusionBlocks blocks.
+type FeeHistoryGasPriceDeterminant struct {
+	client FeeHistoryClient
+	opts   *GasPricerOpts
+}
+
+func NewFeeHistoryGasPriceDeterminant(client FeeHistoryClient, opts *GasPricerOpts) *FeeHistoryGasPriceDeterminant {
+	return &FeeHistoryGasPriceDeterminant{client: client, opts: opts}
+}
+
+func (gasPricer *FeeHistoryGasPriceDeterminant) SetClient(client FeeHistoryClient) {
+	gasPricer.client = client
+}
+func (gasPricer *FeeHistoryGasPriceDeterminant) SetOpts(opts *GasPricerOpts) {
+	gasPricer.opts = opts
+}
+
+func (gasPricer *FeeHistoryGasPriceDeterminant) GasPrice() ([]*big.Int, error) {
+	speed := consts.GasPriceSpeedNormal
+	blocks := uint64(consts.DefaultFeeHistoryBlocks)
+	if gasPricer.opts != nil && IsValidSpeed(gasPricer.opts.Speed) {
+		speed = gasPricer.opts.Speed
+	}
+	params := speeds[speed]
+	if gasPricer.opts != nil {
+		if gasPricer.opts.FeeHistoryBlocks != 0 {
+			blocks = gasPricer.opts.FeeHistoryBlocks
+		}
+		if gasPricer.opts.RewardPercentile != 0 {
+			params.rewardPercentile = gasPricer.opts.RewardPercentile
+		}
+		if gasPricer.opts.InclusionBlocks != 0 {
+			params.inclusionBlocks = gasPricer.opts.InclusionBlocks
+		}
+	}
+
+	history, err := gasPricer.client.FeeHistory(context.TODO(), blocks, nil, []float64{params.rewardPercentile})
+	if err != nil {
+		return nil, err
+	}
+	// BaseFee is missing or zero if eip1559 is not implemented or did not started working on the current chain
+	if len(history.BaseFee) == 0 || history.BaseFee[len(history.BaseFee)-1] == nil || history.BaseFee[len(history.BaseFee)-1].Sign() == 0 {
+		staticGasPricer := NewStaticGasPriceDeterminant(gasPricer.client, gasPricer.opts)
+		return staticGasPricer.GasPrice()
+	}
+	baseFee := history.BaseFee[len(history.BaseFee)-1]
+
+	rewardTipCap := medianReward(history.Reward, history.GasUsedRatio)
+	projectedBaseFee := projectBaseFee(baseFee, params.inclusionBlocks)
+	maxPriorityFeePerGas := adjustTipCap(rewardTipCap, gasPricer.opts)
+	maxFeePerGas := new(big.Int).Add(maxPriorityFeePerGas, projectedBaseFee)
+	maxPriorityFeePerGas, maxFeePerGas = limitFeeCap(baseFee, maxPriorityFeePerGas, maxFeePerGas, gasPricer.opts)
+
+	log.Debug().Str("pricer", "feeHistory").Str("speed", speed).Uint64("blocks", blocks).
+		Float64("percentile", params.rewardPercentile).Uint64("inclusionBlocks", params.inclusionBlocks).
+		Str("baseFee", baseFee.String()).Str("projectedBaseFee", projectedBaseFee.String()).Str("rewardTipCap", rewardTipCap.String()).
+		Str("tipCap", maxPriorityFeePerGas.String()).Str("feeCap", maxFeePerGas.String()).Msg("Estimated fee history gas price")
+	return []*big.Int{maxPriorityFeePerGas, maxFeePerGas}, nil
+}
+
+// projectBaseFee returns base fee after it increases by maximum amount in each of the given number of blocks
+func projectBaseFee(baseFee *big.Int, blocks uint64) *big.Int {
+	projected := new(big.Int).Set(baseFee)
+	for i := uint64(0); i < blocks; i++ {
+		increase := new(big.Int).Div(projected, big.NewInt(baseFeeChangeDenominator))
+		if increase.Sign() == 0 {
+			increase = big.NewInt(1)
+		}
+		projected.Add(projected, increase)
+	}
+	return projected
+}
+
+// medianReward returns median of the first requested percentile rewards skipping empty blocks
+func medianReward(rewards [][]*big.Int, gasUsedRatio []float64) *big.Int {
+	var values []*big.Int
+	for i, blockRewards := range rewards {
+		if len(blockRewards) == 0 || blockRewards[0] == nil {
+			continue
+		}
+		if i < len(gasUsedRatio) && gasUsedRatio[i] == 0 {
+			continue
+		}
+		values = append(values, blockRewards[0])
+	}
+	if len(values) == 0 {
+		return big.NewInt(0)
+	}
+
+	sort.Slice(values, func(i, j int) bool { return values[i].Cmp(values[j]) < 0 })
+	return new(big.Int).Set(values[len(values)/2])
+}
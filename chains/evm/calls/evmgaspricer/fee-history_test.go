@@ -0,0 +1,117 @@
+package evmgaspricer
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmgaspricer/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type FeeHistoryGasPriceTestSuite struct {
+	suite.Suite
+	gasPricerMock *mock_evmgaspricer.MockFeeHistoryClient
+}
+
+func TestRunFeeHistoryTestSuite(t *testing.T) {
+	suite.Run(t, new(FeeHistoryGasPriceTestSuite))
+}
+
+func (s *FeeHistoryGasPriceTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.gasPricerMock = mock_evmgaspricer.NewMockFeeHistoryClient(gomockController)
+}
+
+func gwei(n int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(n), big.NewInt(1000000000))
+}
+
+func (s *FeeHistoryGasPriceTestSuite) TestFeeHistoryGasPricerMedianReward() {
+	gpd := NewFeeHistoryGasPriceDeterminant(s.gasPricerMock, &GasPricerOpts{FeeHistoryBlocks: 4, RewardPercentile: 60, InclusionBlocks: 1})
+	s.gasPricerMock.EXPECT().FeeHistory(gomock.Any(), uint64(4), nil, []float64{60}).Return(&evmclient.FeeHistory{
+		OldestBlock:  big.NewInt(100),
+		Reward:       [][]*big.Int{{gwei(1)}, {gwei(0)}, {gwei(5)}, {gwei(3)}},
+		BaseFee:      []*big.Int{gwei(10), gwei(10), gwei(10), gwei(10), gwei(20)},
+		GasUsedRatio: []float64{0.5, 0, 0.7, 0.4},
+	}, nil)
+
+	res, err := gpd.GasPrice()
+	s.Nil(err)
+	s.Equal(len(res), 2)
+	s.Equal(0, res[0].Cmp(gwei(3)))                 // median of non-empty blocks rewards
+	s.Equal(0, res[1].Cmp(big.NewInt(25500000000))) // next block base fee 20Gwei * 1.125 + maxTipCap
+}
+
+func (s *FeeHistoryGasPriceTestSuite) TestFeeHistoryGasPricerDefaultParams() {
+	gpd := NewFeeHistoryGasPriceDeterminant(s.gasPricerMock, nil)
+	s.gasPricerMock.EXPECT().FeeHistory(gomock.Any(), uint64(20), nil, []float64{50}).Return(&evmclient.FeeHistory{
+		OldestBlock:  big.NewInt(100),
+		Reward:       [][]*big.Int{{gwei(2)}},
+		BaseFee:      []*big.Int{gwei(10), gwei(10)},
+		GasUsedRatio: []float64{0.5},
+	}, nil)
+
+	res, err := gpd.GasPrice()
+	s.Nil(err)
+	s.Equal(0, res[0].Cmp(gwei(2)))
+	s.Equal(0, res[1].Cmp(big.NewInt(20020324706))) // base fee 10Gwei increased by 12.5% in 5 blocks + maxTipCap
+}
+
+func (s *FeeHistoryGasPriceTestSuite) TestFeeHistoryGasPricerFastSpeed() {
+	gpd := NewFeeHistoryGasPriceDeterminant(s.gasPricerMock, &GasPricerOpts{Speed: "fast"})
+	s.gasPricerMock.EXPECT().FeeHistory(gomock.Any(), uint64(20), nil, []float64{90}).Return(&evmclient.FeeHistory{
+		OldestBlock:  big.NewInt(100),
+		Reward:       [][]*big.Int{{gwei(4)}},
+		BaseFee:      []*big.Int{gwei(10), gwei(10)},
+		GasUsedRatio: []float64{0.5},
+	}, nil)
+
+	res, err := gpd.GasPrice()
+	s.Nil(err)
+	s.Equal(0, res[0].Cmp(gwei(4)))
+	s.Equal(0, res[1].Cmp(big.NewInt(16656250000))) // base fee 10Gwei increased by 12.5% in 2 blocks + maxTipCap
+}
+
+func (s *FeeHistoryGasPriceTestSuite) TestFeeHistoryGasPricerMinTipAndUpperLimit() {
+	gpd := NewFeeHistoryGasPriceDeterminant(s.gasPricerMock, &GasPricerOpts{
+		MinTipCap:           gwei(4),
+		UpperLimitFeePerGas: gwei(20),
+	})
+	s.gasPricerMock.EXPECT().FeeHistory(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&evmclient.FeeHistory{
+		OldestBlock:  big.NewInt(100),
+		Reward:       [][]*big.Int{{gwei(1)}},
+		BaseFee:      []*big.Int{gwei(10), gwei(10)},
+		GasUsedRatio: []float64{0.5},
+	}, nil)
+
+	res, err := gpd.GasPrice()
+	s.Nil(err)
+	s.Equal(0, res[0].Cmp(gwei(10))) // UpperLimit - BaseFee
+	s.Equal(0, res[1].Cmp(gwei(20))) // Equals to UpperLimit
+}
+
+func (s *FeeHistoryGasPriceTestSuite) TestFeeHistoryGasPricerNoBaseFee() {
+	gpd := NewFeeHistoryGasPriceDeterminant(s.gasPricerMock, nil)
+	s.gasPricerMock.EXPECT().FeeHistory(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&evmclient.FeeHistory{
+		OldestBlock: big.NewInt(100),
+		BaseFee:     []*big.Int{big.NewInt(0), big.NewInt(0)},
+	}, nil)
+	s.gasPricerMock.EXPECT().SuggestGasPrice(gomock.Any()).Return(gwei(5), nil)
+
+	res, err := gpd.GasPrice()
+	s.Nil(err)
+	s.Equal(len(res), 1)
+	s.Equal(0, res[0].Cmp(gwei(5)))
+}
+
+func (s *FeeHistoryGasPriceTestSuite) TestFeeHistoryGasPricerErr() {
+	gpd := NewFeeHistoryGasPriceDeterminant(s.gasPricerMock, nil)
+	s.gasPricerMock.EXPECT().FeeHistory(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
+
+	res, err := gpd.GasPrice()
+	s.NotNil(err)
+	s.Nil(res)
+}
@@ -3,6 +3,8 @@ package evmgaspricer
 import (
 	"context"
 	"math/big"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
 )
 
 type LondonGasClient interface {
@@ -15,10 +17,20 @@ type GasPriceClient interface {
 	SuggestGasPrice(ctx context.Context) (*big.Int, error)
 }
 
+type FeeHistoryClient interface {
+	GasPriceClient
+	FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*evmclient.FeeHistory, error)
+}
+
 // GasPricerOpts is the structure that holds parameters that could be used to configure different gasPRicer implementation
 type GasPricerOpts struct {
 	UpperLimitFeePerGas *big.Int      // UpperLimitFeePerGas in Static and London gasPricer limits the maximum gas price that could be used. In London gasPricer if BaseFee > UpperLimitFeePerGas, then maxFeeCap will be BaseFee + 2.5 Gwei for MaxTipCap. If nil - not applied
-	GasPriceFactor      *big.Float    // GasPriceFactor In static gasPricer multiplies final gasPrice. Could be for example 0.75 or 5.
+	GasPriceFactor      *big.Float    // GasPriceFactor In static gasPricer multiplies final gasPrice. In London and FeeHistory gasPricer multiplies MaxTipCap. Could be for example 0.75 or 5.
+	MinTipCap           *big.Int      // MinTipCap in London and FeeHistory gasPricer is the lowest MaxTipCap. In static gasPricer it is the lowest gas price. If nil - not applied
+	FeeHistoryBlocks    uint64        // FeeHistoryBlocks is the number of blocks FeeHistory gasPricer reads priority fees from
+	RewardPercentile    float64       // RewardPercentile is the percentile of priority fees paid in a block FeeHistory gasPricer uses. If zero - defined by Speed
+	InclusionBlocks     uint64        // InclusionBlocks is the number of blocks FeeHistory gasPricer projects BaseFee increase for. If zero - defined by Speed
+	Speed               string        // Speed selects RewardPercentile and InclusionBlocks of FeeHistory gasPricer. Normal speed is used if empty
 	Args                []interface{} // Args is the array of dynamic typed args that could be used for other custom GasPricer implementations
 }
 
@@ -29,3 +41,33 @@ func multiplyGasPrice(gasEstimate *big.Int, gasMultiplier *big.Float) *big.Int {
 	result.Int(gasPrice)
 	return gasPrice
 }
+
+// adjustTipCap applies gas price factor and min tip cap to suggested MaxTipCap
+func adjustTipCap(tipCap *big.Int, opts *GasPricerOpts) *big.Int {
+	if opts == nil {
+		return tipCap
+	}
+	if opts.GasPriceFactor != nil {
+		tipCap = multiplyGasPrice(tipCap, opts.GasPriceFactor)
+	}
+	if opts.MinTipCap != nil && tipCap.Cmp(opts.MinTipCap) < 0 {
+		tipCap = new(big.Int).Set(opts.MinTipCap)
+	}
+	return tipCap
+}
+
+// limitFeeCap lowers MaxFeeCap to the upper fee limit reducing MaxTipCap accordingly.
+// If the upper fee limit is lower than BaseFee, MaxFeeCap is set to BaseFee + 2.5 Gwei so the transaction is not stuck indefinitely
+func limitFeeCap(baseFee *big.Int, tipCap *big.Int, feeCap *big.Int, opts *GasPricerOpts) (*big.Int, *big.Int) {
+	if opts == nil || opts.UpperLimitFeePerGas == nil {
+		return tipCap, feeCap
+	}
+	if opts.UpperLimitFeePerGas.Cmp(baseFee) < 0 {
+		tipCap = big.NewInt(TwoAndTheHalfGwei)
+		return tipCap, new(big.Int).Add(baseFee, tipCap)
+	}
+	if feeCap.Cmp(opts.UpperLimitFeePerGas) == 1 {
+		return new(big.Int).Sub(opts.UpperLimitFeePerGas, baseFee), opts.UpperLimitFeePerGas
+	}
+	return tipCap, feeCap
+}
@@ -3,6 +3,8 @@ package evmgaspricer
 import (
 	"context"
 	"math/big"
+
+	"github.com/rs/zerolog/log"
 )
 
 type LondonGasPriceDeterminant struct {
@@ -46,31 +48,29 @@ func (gasPricer *LondonGasPriceDeterminant) SetOpts(opts *GasPricerOpts) {
 const TwoAndTheHalfGwei = 2500000000 // Lowest MaxPriorityFee. Defined by some researches...
 
 func (gasPricer *LondonGasPriceDeterminant) estimateGasLondon(baseFee *big.Int) (*big.Int, *big.Int, error) {
-	var maxPriorityFeePerGas *big.Int
-	var maxFeePerGas *big.Int
-
 	// if gasPriceLimit is set and lower than networks baseFee then
-	// maxPriorityFee is set to 3 GWEI because that was practically and theoretically defined as optimum
+	// maxPriorityFee is set to 2.5 GWEI because that was practically and theoretically defined as optimum
 	// and Max Fee set to baseFee + maxPriorityFeePerGas
 	if gasPricer.opts != nil && gasPricer.opts.UpperLimitFeePerGas != nil && gasPricer.opts.UpperLimitFeePerGas.Cmp(baseFee) < 0 {
-		maxPriorityFeePerGas = big.NewInt(TwoAndTheHalfGwei)
-		maxFeePerGas = new(big.Int).Add(baseFee, maxPriorityFeePerGas)
+		maxPriorityFeePerGas, maxFeePerGas := limitFeeCap(baseFee, nil, nil, gasPricer.opts)
+		log.Debug().Str("pricer", "london").Str("baseFee", baseFee.String()).Str("upperLimit", gasPricer.opts.UpperLimitFeePerGas.String()).
+			Str("tipCap", maxPriorityFeePerGas.String()).Str("feeCap", maxFeePerGas.String()).Msg("Base fee exceeds upper fee limit")
 		return maxPriorityFeePerGas, maxFeePerGas, nil
 	}
 
-	maxPriorityFeePerGas, err := gasPricer.client.SuggestGasTipCap(context.TODO())
+	suggestedTipCap, err := gasPricer.client.SuggestGasTipCap(context.TODO())
 	if err != nil {
 		return nil, nil, err
 	}
-	maxFeePerGas = new(big.Int).Add(
+	maxPriorityFeePerGas := adjustTipCap(suggestedTipCap, gasPricer.opts)
+	maxFeePerGas := new(big.Int).Add(
 		maxPriorityFeePerGas,
 		new(big.Int).Mul(baseFee, big.NewInt(2)),
 	)
 
 	// Check we aren't exceeding our limit if gasPriceLimit set
-	if gasPricer.opts != nil && gasPricer.opts.UpperLimitFeePerGas != nil && maxFeePerGas.Cmp(gasPricer.opts.UpperLimitFeePerGas) == 1 {
-		maxPriorityFeePerGas.Sub(gasPricer.opts.UpperLimitFeePerGas, baseFee)
-		maxFeePerGas = gasPricer.opts.UpperLimitFeePerGas
-	}
+	maxPriorityFeePerGas, maxFeePerGas = limitFeeCap(baseFee, maxPriorityFeePerGas, maxFeePerGas, gasPricer.opts)
+	log.Debug().Str("pricer", "london").Str("baseFee", baseFee.String()).Str("suggestedTipCap", suggestedTipCap.String()).
+		Str("tipCap", maxPriorityFeePerGas.String()).Str("feeCap", maxFeePerGas.String()).Msg("Estimated London gas price")
 	return maxPriorityFeePerGas, maxFeePerGas, nil
 }
@@ -68,3 +68,32 @@ func (s *LondonGasPriceTestSuite) TestLondonGasPricerWithUpperLimitLowerBaseFee(
 	s.Equal(res[0].Cmp(big.NewInt(TwoAndTheHalfGwei)), 0) // Lowest MaxPriorityFee
 	s.Equal(0, res[1].Cmp(big.NewInt(32500000000)))       // Equals to BaseFee  + MaxPriorityFee (22,5 gwei)
 }
+
+func (s *LondonGasPriceTestSuite) TestLondonGasPricerWithFactor() {
+	twentyGwei := big.NewInt(20000000000)
+	twoGwei := big.NewInt(2000000000)
+	gpd := NewLondonGasPriceClient(s.gasPricerMock, &GasPricerOpts{GasPriceFactor: big.NewFloat(1.5)})
+	s.gasPricerMock.EXPECT().BaseFee().Return(twentyGwei, nil)
+	s.gasPricerMock.EXPECT().SuggestGasTipCap(gomock.Any()).Return(twoGwei, nil)
+
+	res, err := gpd.GasPrice()
+	s.Nil(err)
+	s.Equal(len(res), 2)
+	s.Equal(res[0].Cmp(big.NewInt(3000000000)), 0)  // 2 Gwei * 1.5
+	s.Equal(0, res[1].Cmp(big.NewInt(43000000000))) // Base fee 20Gwei * 2 + maxTipCap = 43Gwei
+}
+
+func (s *LondonGasPriceTestSuite) TestLondonGasPricerWithMinTip() {
+	twentyGwei := big.NewInt(20000000000)
+	oneGwei := big.NewInt(1000000000)
+	threeGwei := big.NewInt(3000000000)
+	gpd := NewLondonGasPriceClient(s.gasPricerMock, &GasPricerOpts{MinTipCap: threeGwei})
+	s.gasPricerMock.EXPECT().BaseFee().Return(twentyGwei, nil)
+	s.gasPricerMock.EXPECT().SuggestGasTipCap(gomock.Any()).Return(oneGwei, nil)
+
+	res, err := gpd.GasPrice()
+	s.Nil(err)
+	s.Equal(len(res), 2)
+	s.Equal(res[0].Cmp(threeGwei), 0)               // Suggested tip is lower than MinTipCap
+	s.Equal(0, res[1].Cmp(big.NewInt(43000000000))) // Base fee 20Gwei * 2 + maxTipCap = 43Gwei
+}
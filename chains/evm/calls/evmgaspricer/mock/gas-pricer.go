@@ -9,6 +9,7 @@ import (
 	big "math/big"
 	reflect "reflect"
 
+	evmclient "github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -117,3 +118,56 @@ func (mr *MockGasPriceClientMockRecorder) SuggestGasPrice(ctx interface{}) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestGasPrice", reflect.TypeOf((*MockGasPriceClient)(nil).SuggestGasPrice), ctx)
 }
+
+// MockFeeHistoryClient is a mock of FeeHistoryClient interface.
+type MockFeeHistoryClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeeHistoryClientMockRecorder
+}
+
+// MockFeeHistoryClientMockRecorder is the mock recorder for MockFeeHistoryClient.
+type MockFeeHistoryClientMockRecorder struct {
+	mock *MockFeeHistoryClient
+}
+
+// NewMockFeeHistoryClient creates a new mock instance.
+func NewMockFeeHistoryClient(ctrl *gomock.Controller) *MockFeeHistoryClient {
+	mock := &MockFeeHistoryClient{ctrl: ctrl}
+	mock.recorder = &MockFeeHistoryClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeeHistoryClient) EXPECT() *MockFeeHistoryClientMockRecorder {
+	return m.recorder
+}
+
+// FeeHistory mocks base method.
+func (m *MockFeeHistoryClient) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*evmclient.FeeHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FeeHistory", ctx, blockCount, lastBlock, rewardPercentiles)
+	ret0, _ := ret[0].(*evmclient.FeeHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FeeHistory indicates an expected call of FeeHistory.
+func (mr *MockFeeHistoryClientMockRecorder) FeeHistory(ctx, blockCount, lastBlock, rewardPercentiles interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeeHistory", reflect.TypeOf((*MockFeeHistoryClient)(nil).FeeHistory), ctx, blockCount, lastBlock, rewardPercentiles)
+}
+
+// SuggestGasPrice mocks base method.
+func (m *MockFeeHistoryClient) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuggestGasPrice", ctx)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuggestGasPrice indicates an expected call of SuggestGasPrice.
+func (mr *MockFeeHistoryClientMockRecorder) SuggestGasPrice(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestGasPrice", reflect.TypeOf((*MockFeeHistoryClient)(nil).SuggestGasPrice), ctx)
+}
@@ -0,0 +1,265 @@
+package evmgaspricer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/consts"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	defaultOracleCacheTTL     = 15 * time.Second
+	defaultOracleMaxDeviation = 3
+	oracleRequestTimeout      = 5 * time.Second
+)
+
+// OracleRetryPeriod is the time fallback gasPricer is used for after oracle failed or returned outlier
+var OracleRetryPeriod = 5 * time.Second
+
+// OracleOpts configures HTTP gas oracle queried by OracleGasPriceDeterminant
+type OracleOpts struct {
+	URL          string
+	GasPricePath string        // GasPricePath is the JSON path of legacy gas price in oracle response, e.g. "result.ProposeGasPrice"
+	TipCapPath   string        // TipCapPath is the JSON path of MaxTipCap in oracle response, used together with FeeCapPath instead of GasPricePath
+	FeeCapPath   string        // FeeCapPath is the JSON path of MaxFeeCap in oracle response
+	Unit         string        // Unit of prices returned by oracle, gwei if empty
+	CacheTTL     time.Duration // CacheTTL is the time oracle response is reused for, 15 seconds if zero
+	MaxDeviation float64       // MaxDeviation is the factor by which oracle price can differ from fallback gasPricer price on first query or change from the last accepted price before it is compared to fallback gasPricer, 3 if zero
+}
+
+// OracleGasPriceDeterminant queries gas prices from HTTP gas oracle.
+//
+// Oracle prices are cached for CacheTTL. If oracle is unreachable, its response can not be parsed or
+// the price deviates from fallback gasPricer price by more than MaxDeviation times, fallback gasPricer
+// price is used and oracle is not queried again for OracleRetryPeriod. Oracle price is compared to fallback
+// gasPricer price on first query and when it changed more than MaxDeviation times since the last accepted price.
+type OracleGasPriceDeterminant struct {
+	oracleOpts OracleOpts
+	fallback   calls.GasPricer
+	opts       *GasPricerOpts
+	httpClient *http.Client
+
+	lock      sync.Mutex
+	cached    []*big.Int
+	fetchedAt time.Time
+	accepted  []*big.Int
+	failedAt  time.Time
+}
+
+func NewOracleGasPriceDeterminant(oracleOpts OracleOpts, fallback calls.GasPricer, opts *GasPricerOpts) *OracleGasPriceDeterminant {
+	if oracleOpts.CacheTTL == 0 {
+		oracleOpts.CacheTTL = defaultOracleCacheTTL
+	}
+	if oracleOpts.MaxDeviation == 0 {
+		oracleOpts.MaxDeviation = defaultOracleMaxDeviation
+	}
+	if oracleOpts.Unit == "" {
+		oracleOpts.Unit = consts.GasOracleUnitGwei
+	}
+	return &OracleGasPriceDeterminant{
+		oracleOpts: oracleOpts,
+		fallback:   fallback,
+		opts:       opts,
+		httpClient: &http.Client{Timeout: oracleRequestTimeout},
+	}
+}
+
+func (gasPricer *OracleGasPriceDeterminant) SetOpts(opts *GasPricerOpts) {
+	gasPricer.opts = opts
+}
+
+func (gasPricer *OracleGasPriceDeterminant) GasPrice() ([]*big.Int, error) {
+	gasPricer.lock.Lock()
+	defer gasPricer.lock.Unlock()
+
+	if gasPricer.cached != nil && time.Since(gasPricer.fetchedAt) < gasPricer.oracleOpts.CacheTTL {
+		return copyPrices(gasPricer.cached), nil
+	}
+	if time.Since(gasPricer.failedAt) < OracleRetryPeriod {
+		return gasPricer.fallback.GasPrice()
+	}
+
+	oraclePrices, err := gasPricer.queryOracle()
+	if err != nil {
+		gasPricer.failedAt = time.Now()
+		log.Warn().Err(err).Str("pricer", "oracle").Str("url", gasPricer.oracleOpts.URL).Msg("Gas oracle unavailable, using fallback gas pricer")
+		return gasPricer.fallback.GasPrice()
+	}
+
+	if gasPricer.accepted == nil || !withinDeviation(oraclePrices, gasPricer.accepted, gasPricer.oracleOpts.MaxDeviation) {
+		fallbackPrices, err := gasPricer.fallback.GasPrice()
+		if err != nil {
+			return nil, err
+		}
+		if !withinDeviation(oraclePrices, fallbackPrices, gasPricer.oracleOpts.MaxDeviation) {
+			gasPricer.failedAt = time.Now()
+			log.Warn().Str("pricer", "oracle").Strs("oraclePrices", pricesToStrings(oraclePrices)).
+				Strs("lastAccepted", pricesToStrings(gasPricer.accepted)).Strs("fallbackPrices", pricesToStrings(fallbackPrices)).
+				Msg("Gas oracle returned outlier, using fallback gas pricer")
+			return fallbackPrices, nil
+		}
+	}
+	gasPricer.accepted = oraclePrices
+
+	prices := gasPricer.applyOpts(oraclePrices)
+	gasPricer.cached = prices
+	gasPricer.fetchedAt = time.Now()
+	log.Debug().Str("pricer", "oracle").Strs("oraclePrices", pricesToStrings(oraclePrices)).
+		Strs("gasPrices", pricesToStrings(prices)).Msg("Estimated oracle gas price")
+	return copyPrices(prices), nil
+}
+
+// queryOracle fetches oracle response and extracts either legacy gas price or MaxTipCap and MaxFeeCap from it
+func (gasPricer *OracleGasPriceDeterminant) queryOracle() ([]*big.Int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), oracleRequestTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, gasPricer.oracleOpts.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := gasPricer.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("gas oracle responded with status %d", resp.StatusCode)
+	}
+
+	var body interface{}
+	decoder := json.NewDecoder(resp.Body)
+	decoder.UseNumber()
+	if err := decoder.Decode(&body); err != nil {
+		return nil, err
+	}
+
+	paths := []string{gasPricer.oracleOpts.GasPricePath}
+	if gasPricer.oracleOpts.TipCapPath != "" {
+		paths = []string{gasPricer.oracleOpts.TipCapPath, gasPricer.oracleOpts.FeeCapPath}
+	}
+	prices := make([]*big.Int, len(paths))
+	for i, path := range paths {
+		price, err := gasPricer.priceAtPath(body, path)
+		if err != nil {
+			return nil, err
+		}
+		if price.Sign() <= 0 {
+			return nil, fmt.Errorf("gas oracle returned non positive price %s at %s", price, path)
+		}
+		prices[i] = price
+	}
+	return prices, nil
+}
+
+// priceAtPath resolves dot separated JSON path and converts found number or numeric string to wei
+func (gasPricer *OracleGasPriceDeterminant) priceAtPath(body interface{}, path string) (*big.Int, error) {
+	value := body
+	for _, key := range strings.Split(path, ".") {
+		switch node := value.(type) {
+		case map[string]interface{}:
+			v, ok := node[key]
+			if !ok {
+				return nil, fmt.Errorf("gas oracle response has no field %s", path)
+			}
+			value = v
+		case []interface{}:
+			index, err := strconv.Atoi(key)
+			if err != nil || index < 0 || index >= len(node) {
+				return nil, fmt.Errorf("gas oracle response has no field %s", path)
+			}
+			value = node[index]
+		default:
+			return nil, fmt.Errorf("gas oracle response has no field %s", path)
+		}
+	}
+
+	var raw string
+	switch v := value.(type) {
+	case json.Number:
+		raw = v.String()
+	case string:
+		raw = v
+	default:
+		return nil, fmt.Errorf("gas oracle field %s is not a number", path)
+	}
+	price, ok := new(big.Rat).SetString(raw)
+	if !ok {
+		return nil, fmt.Errorf("gas oracle field %s is not a number", path)
+	}
+	if gasPricer.oracleOpts.Unit == consts.GasOracleUnitGwei {
+		price.Mul(price, new(big.Rat).SetInt64(1000000000))
+	}
+	return new(big.Int).Quo(price.Num(), price.Denom()), nil
+}
+
+// applyOpts applies gas price factor, min tip and upper limit to oracle prices
+func (gasPricer *OracleGasPriceDeterminant) applyOpts(prices []*big.Int) []*big.Int {
+	opts := gasPricer.opts
+	if opts == nil {
+		return prices
+	}
+	if len(prices) == 1 {
+		gp := adjustTipCap(prices[0], opts)
+		if opts.UpperLimitFeePerGas != nil && gp.Cmp(opts.UpperLimitFeePerGas) == 1 {
+			gp = opts.UpperLimitFeePerGas
+		}
+		return []*big.Int{gp}
+	}
+
+	tipCap := adjustTipCap(prices[0], opts)
+	feeCap := new(big.Int).Add(prices[1], new(big.Int).Sub(tipCap, prices[0]))
+	if opts.UpperLimitFeePerGas != nil && feeCap.Cmp(opts.UpperLimitFeePerGas) == 1 {
+		feeCap = opts.UpperLimitFeePerGas
+	}
+	if tipCap.Cmp(feeCap) == 1 {
+		tipCap = feeCap
+	}
+	return []*big.Int{tipCap, feeCap}
+}
+
+// withinDeviation checks that every price differs from reference price at most maxDeviation times.
+// If only one of prices is legacy gas price, it is compared to MaxFeeCap of the other.
+func withinDeviation(prices []*big.Int, reference []*big.Int, maxDeviation float64) bool {
+	if len(prices) == 0 || len(reference) == 0 {
+		return false
+	}
+	if len(prices) != len(reference) {
+		prices = prices[len(prices)-1:]
+		reference = reference[len(reference)-1:]
+	}
+	for i := range prices {
+		if reference[i] == nil || reference[i].Sign() == 0 {
+			return false
+		}
+		ratio, _ := new(big.Float).Quo(new(big.Float).SetInt(prices[i]), new(big.Float).SetInt(reference[i])).Float64()
+		if ratio > maxDeviation || ratio < 1/maxDeviation {
+			return false
+		}
+	}
+	return true
+}
+
+func copyPrices(prices []*big.Int) []*big.Int {
+	res := make([]*big.Int, len(prices))
+	for i, p := range prices {
+		res[i] = new(big.Int).Set(p)
+	}
+	return res
+}
+
+func pricesToStrings(prices []*big.Int) []string {
+	res := make([]string, len(prices))
+	for i, p := range prices {
+		res[i] = p.String()
+	}
+	return res
+}
@@ -0,0 +1,202 @@
+package evmgaspricer
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	mock_calls "github.com/ChainSafe/chainbridge-core/chains/evm/calls/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type OracleGasPriceTestSuite struct {
+	suite.Suite
+	fallbackMock *mock_calls.MockGasPricer
+	server       *httptest.Server
+	response     atomic.Value
+	requests     int32
+}
+
+func TestRunOracleTestSuite(t *testing.T) {
+	suite.Run(t, new(OracleGasPriceTestSuite))
+}
+
+func (s *OracleGasPriceTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.fallbackMock = mock_calls.NewMockGasPricer(gomockController)
+	s.requests = 0
+	s.response.Store("")
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&s.requests, 1)
+		response := s.response.Load().(string)
+		if response == "" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = fmt.Fprint(w, response)
+	}))
+}
+
+func (s *OracleGasPriceTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *OracleGasPriceTestSuite) TestOracleGasPricerLegacyPrice() {
+	s.response.Store(`{"status":"1","result":{"SafeGasPrice":"20","ProposeGasPrice":"30.1"}}`)
+	gpd := NewOracleGasPriceDeterminant(OracleOpts{URL: s.server.URL, GasPricePath: "result.ProposeGasPrice"}, s.fallbackMock, nil)
+	s.fallbackMock.EXPECT().GasPrice().Return([]*big.Int{big.NewInt(25000000000)}, nil)
+
+	res, err := gpd.GasPrice()
+	s.Nil(err)
+	s.Equal(len(res), 1)
+	s.Equal(0, res[0].Cmp(big.NewInt(30100000000)))
+}
+
+func (s *OracleGasPriceTestSuite) TestOracleGasPricerEIP1559Prices() {
+	s.response.Store(`{"fast":{"maxPriorityFee":2.5,"maxFee":42},"estimates":[{"price":1}]}`)
+	gpd := NewOracleGasPriceDeterminant(OracleOpts{
+		URL:        s.server.URL,
+		TipCapPath: "fast.maxPriorityFee",
+		FeeCapPath: "fast.maxFee",
+	}, s.fallbackMock, &GasPricerOpts{UpperLimitFeePerGas: big.NewInt(40000000000)})
+	s.fallbackMock.EXPECT().GasPrice().Return([]*big.Int{big.NewInt(2000000000), big.NewInt(38000000000)}, nil)
+
+	res, err := gpd.GasPrice()
+	s.Nil(err)
+	s.Equal(len(res), 2)
+	s.Equal(0, res[0].Cmp(big.NewInt(2500000000)))
+	s.Equal(0, res[1].Cmp(big.NewInt(40000000000))) // Equals to UpperLimit
+}
+
+func (s *OracleGasPriceTestSuite) TestOracleGasPricerArrayPathInWei() {
+	s.response.Store(`{"estimates":[{"price":"1000"},{"price":"2000"}]}`)
+	gpd := NewOracleGasPriceDeterminant(OracleOpts{URL: s.server.URL, GasPricePath: "estimates.1.price", Unit: "wei"}, s.fallbackMock, nil)
+	s.fallbackMock.EXPECT().GasPrice().Return([]*big.Int{big.NewInt(1500)}, nil)
+
+	res, err := gpd.GasPrice()
+	s.Nil(err)
+	s.Equal(0, res[0].Cmp(big.NewInt(2000)))
+}
+
+func (s *OracleGasPriceTestSuite) TestOracleGasPricerCachesResponse() {
+	s.response.Store(`{"price":20}`)
+	gpd := NewOracleGasPriceDeterminant(OracleOpts{URL: s.server.URL, GasPricePath: "price", CacheTTL: time.Minute}, s.fallbackMock, nil)
+	s.fallbackMock.EXPECT().GasPrice().Return([]*big.Int{big.NewInt(20000000000)}, nil)
+
+	_, err := gpd.GasPrice()
+	s.Nil(err)
+	s.response.Store(`{"price":25}`)
+	res, err := gpd.GasPrice()
+	s.Nil(err)
+
+	s.Equal(int32(1), atomic.LoadInt32(&s.requests))
+	s.Equal(0, res[0].Cmp(big.NewInt(20000000000)))
+}
+
+func (s *OracleGasPriceTestSuite) TestOracleGasPricerUnavailable() {
+	gpd := NewOracleGasPriceDeterminant(OracleOpts{URL: s.server.URL, GasPricePath: "price"}, s.fallbackMock, nil)
+	s.fallbackMock.EXPECT().GasPrice().Return([]*big.Int{big.NewInt(10)}, nil)
+
+	res, err := gpd.GasPrice()
+	s.Nil(err)
+	s.Equal(0, res[0].Cmp(big.NewInt(10)))
+}
+
+func (s *OracleGasPriceTestSuite) TestOracleGasPricerMissingField() {
+	s.response.Store(`{"fast":20}`)
+	gpd := NewOracleGasPriceDeterminant(OracleOpts{URL: s.server.URL, GasPricePath: "result.fast"}, s.fallbackMock, nil)
+	s.fallbackMock.EXPECT().GasPrice().Return([]*big.Int{big.NewInt(10)}, nil)
+
+	res, err := gpd.GasPrice()
+	s.Nil(err)
+	s.Equal(0, res[0].Cmp(big.NewInt(10)))
+}
+
+func (s *OracleGasPriceTestSuite) TestOracleGasPricerOutlier() {
+	s.response.Store(`{"price":20}`)
+	gpd := NewOracleGasPriceDeterminant(OracleOpts{URL: s.server.URL, GasPricePath: "price", CacheTTL: time.Nanosecond}, s.fallbackMock, nil)
+	s.fallbackMock.EXPECT().GasPrice().Return([]*big.Int{big.NewInt(20000000000)}, nil)
+	_, err := gpd.GasPrice()
+	s.Nil(err)
+
+	s.response.Store(`{"price":2000}`)
+	s.fallbackMock.EXPECT().GasPrice().Return([]*big.Int{big.NewInt(21000000000)}, nil)
+	res, err := gpd.GasPrice()
+	s.Nil(err)
+	s.Equal(0, res[0].Cmp(big.NewInt(21000000000)))
+}
+
+func (s *OracleGasPriceTestSuite) TestOracleGasPricerPriceJumpConfirmedByFallback() {
+	s.response.Store(`{"price":20}`)
+	gpd := NewOracleGasPriceDeterminant(OracleOpts{URL: s.server.URL, GasPricePath: "price", CacheTTL: time.Nanosecond}, s.fallbackMock, nil)
+	s.fallbackMock.EXPECT().GasPrice().Return([]*big.Int{big.NewInt(20000000000)}, nil)
+	_, err := gpd.GasPrice()
+	s.Nil(err)
+
+	s.response.Store(`{"price":100}`)
+	s.fallbackMock.EXPECT().GasPrice().Return([]*big.Int{big.NewInt(90000000000)}, nil)
+	res, err := gpd.GasPrice()
+	s.Nil(err)
+	s.Equal(0, res[0].Cmp(big.NewInt(100000000000)))
+}
+
+func (s *OracleGasPriceTestSuite) TestOracleGasPricerOutlierFallbackErr() {
+	s.response.Store(`{"price":20}`)
+	gpd := NewOracleGasPriceDeterminant(OracleOpts{URL: s.server.URL, GasPricePath: "price", CacheTTL: time.Nanosecond}, s.fallbackMock, nil)
+	s.fallbackMock.EXPECT().GasPrice().Return([]*big.Int{big.NewInt(20000000000)}, nil)
+	_, err := gpd.GasPrice()
+	s.Nil(err)
+
+	s.response.Store(`{"price":2000}`)
+	s.fallbackMock.EXPECT().GasPrice().Return(nil, errors.New("error"))
+	res, err := gpd.GasPrice()
+	s.NotNil(err)
+	s.Nil(res)
+}
+
+func (s *OracleGasPriceTestSuite) TestOracleGasPricerFirstResponseOutlier() {
+	s.response.Store(`{"price":2000}`)
+	gpd := NewOracleGasPriceDeterminant(OracleOpts{URL: s.server.URL, GasPricePath: "price"}, s.fallbackMock, nil)
+	s.fallbackMock.EXPECT().GasPrice().Return([]*big.Int{big.NewInt(21000000000)}, nil)
+
+	res, err := gpd.GasPrice()
+	s.Nil(err)
+	s.Equal(0, res[0].Cmp(big.NewInt(21000000000)))
+}
+
+func (s *OracleGasPriceTestSuite) TestOracleGasPricerFailureNotRetriedBeforeRetryPeriod() {
+	gpd := NewOracleGasPriceDeterminant(OracleOpts{URL: s.server.URL, GasPricePath: "price"}, s.fallbackMock, nil)
+	s.fallbackMock.EXPECT().GasPrice().Return([]*big.Int{big.NewInt(10)}, nil).Times(2)
+
+	_, err := gpd.GasPrice()
+	s.Nil(err)
+	s.response.Store(`{"price":20}`)
+	res, err := gpd.GasPrice()
+	s.Nil(err)
+
+	s.Equal(int32(1), atomic.LoadInt32(&s.requests))
+	s.Equal(0, res[0].Cmp(big.NewInt(10)))
+}
+
+func (s *OracleGasPriceTestSuite) TestOracleGasPricerFailureRetriedAfterRetryPeriod() {
+	OracleRetryPeriod = time.Millisecond
+	defer func() { OracleRetryPeriod = 5 * time.Second }()
+	gpd := NewOracleGasPriceDeterminant(OracleOpts{URL: s.server.URL, GasPricePath: "price"}, s.fallbackMock, nil)
+	s.fallbackMock.EXPECT().GasPrice().Return([]*big.Int{big.NewInt(20000000000)}, nil).Times(2)
+
+	_, err := gpd.GasPrice()
+	s.Nil(err)
+	s.response.Store(`{"price":20}`)
+	time.Sleep(10 * time.Millisecond)
+	res, err := gpd.GasPrice()
+	s.Nil(err)
+
+	s.Equal(int32(2), atomic.LoadInt32(&s.requests))
+	s.Equal(0, res[0].Cmp(big.NewInt(20000000000)))
+}
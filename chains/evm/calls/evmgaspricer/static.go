@@ -32,21 +32,25 @@ func (gasPricer *StaticGasPriceDeterminant) SetOpts(opts *GasPricerOpts) {
 }
 
 func (gasPricer *StaticGasPriceDeterminant) GasPrice() ([]*big.Int, error) {
-	gp, err := gasPricer.client.SuggestGasPrice(context.TODO())
-	log.Debug().Msgf("Suggested GP %s", gp.String())
+	suggestedGP, err := gasPricer.client.SuggestGasPrice(context.TODO())
 	if err != nil {
 		return nil, err
 	}
+	gp := suggestedGP
 	if gasPricer.opts != nil {
 		if gasPricer.opts.GasPriceFactor != nil {
 			gp = multiplyGasPrice(gp, gasPricer.opts.GasPriceFactor)
 		}
+		if gasPricer.opts.MinTipCap != nil && gp.Cmp(gasPricer.opts.MinTipCap) < 0 {
+			gp = gasPricer.opts.MinTipCap
+		}
 		if gasPricer.opts.UpperLimitFeePerGas != nil {
 			if gp.Cmp(gasPricer.opts.UpperLimitFeePerGas) == 1 {
 				gp = gasPricer.opts.UpperLimitFeePerGas
 			}
 		}
 	}
+	log.Debug().Str("pricer", "static").Str("suggestedGasPrice", suggestedGP.String()).Str("gasPrice", gp.String()).Msg("Estimated static gas price")
 	gasPrices := make([]*big.Int, 1)
 	gasPrices[0] = gp
 	return gasPrices, nil
@@ -84,3 +84,16 @@ func (s *StaticGasPriceTestSuite) TestStaticGasPricerErrOnSuggest() {
 	s.NotNil(err)
 	s.Nil(res)
 }
+
+func (s *StaticGasPriceTestSuite) TestStaticGasPricerMinTipSet() {
+	oneGwei := big.NewInt(1000000000)
+	twoGwei := big.NewInt(2000000000)
+	gpd := NewStaticGasPriceDeterminant(s.gasPricerMock, &GasPricerOpts{
+		MinTipCap: twoGwei,
+	})
+	s.gasPricerMock.EXPECT().SuggestGasPrice(gomock.Any()).Return(oneGwei, nil)
+	res, err := gpd.GasPrice()
+	s.Nil(err)
+	s.Equal(len(res), 1)
+	s.Equal(res[0].Cmp(twoGwei), 0)
+}
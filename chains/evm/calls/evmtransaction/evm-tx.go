@@ -1,14 +1,13 @@
 package evmtransaction
 
 import (
-	"crypto/ecdsa"
+	"context"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/signer"
 	"math/big"
 
-	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
 )
 
 type TX struct {
@@ -17,14 +16,9 @@ type TX struct {
 
 // RawWithSignature mostly copies WithSignature interface of type.Transaction from go-ethereum,
 // but return raw byte representation of transaction to be compatible and interchangeable between different go-ethereum forks
-// WithSignature returns a new transaction with the given signature.
-// This signature needs to be in the [R || S || V] format where V is 0 or 1.
-func (a *TX) RawWithSignature(key *ecdsa.PrivateKey, domainID *big.Int) ([]byte, error) {
-	opts, err := bind.NewKeyedTransactorWithChainID(key, domainID)
-	if err != nil {
-		return nil, err
-	}
-	tx, err := opts.Signer(crypto.PubkeyToAddress(key.PublicKey), a.tx)
+// WithSignature returns a new transaction signed by the given signer.
+func (a *TX) RawWithSignature(ctx context.Context, txSigner signer.Signer, domainID *big.Int) ([]byte, error) {
+	tx, err := txSigner.SignTransaction(ctx, a.tx, domainID)
 	if err != nil {
 		return nil, err
 	}
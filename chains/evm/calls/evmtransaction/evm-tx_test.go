@@ -1,11 +1,13 @@
 package evmtransaction
 
 import (
+	"context"
 	evmgaspricer "github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmgaspricer"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmgaspricer/mock"
 	"math/big"
 	"testing"
 
+	"github.com/ChainSafe/chainbridge-core/chains/evm/signer"
 	"github.com/ChainSafe/chainbridge-core/keystore"
 	"github.com/ethereum/go-ethereum/core/types"
 
@@ -42,7 +44,7 @@ func (s *EVMTxTestSuite) TestNewTransactionWithStaticGasPricer() {
 	s.Nil(err)
 	tx, err := txFabric(1, &common.Address{}, big.NewInt(0), 10000, gp, []byte{})
 	s.Nil(err)
-	rawTx, err := tx.RawWithSignature(aliceKp.PrivateKey(), big.NewInt(420))
+	rawTx, err := tx.RawWithSignature(context.Background(), signer.NewLocalSigner(aliceKp), big.NewInt(420))
 	s.Nil(err)
 	txt := types.Transaction{}
 	err = txt.UnmarshalBinary(rawTx)
@@ -59,7 +61,7 @@ func (s *EVMTxTestSuite) TestNewTransactionWithLondonGasPricer() {
 	s.Nil(err)
 	tx, err := txFabric(1, &common.Address{}, big.NewInt(0), 10000, gp, []byte{})
 	s.Nil(err)
-	rawTx, err := tx.RawWithSignature(aliceKp.PrivateKey(), big.NewInt(420))
+	rawTx, err := tx.RawWithSignature(context.Background(), signer.NewLocalSigner(aliceKp), big.NewInt(420))
 	s.Nil(err)
 	txt := types.Transaction{}
 	err = txt.UnmarshalBinary(rawTx)
@@ -10,6 +10,7 @@ import (
 	reflect "reflect"
 
 	evmclient "github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	ethereum "github.com/ethereum/go-ethereum"
 	common "github.com/ethereum/go-ethereum/common"
 	types "github.com/ethereum/go-ethereum/core/types"
 	gomock "github.com/golang/mock/gomock"
@@ -76,6 +77,21 @@ func (m *MockContractCaller) EXPECT() *MockContractCallerMockRecorder {
 	return m.recorder
 }
 
+// BatchCallContract mocks base method.
+func (m *MockContractCaller) BatchCallContract(ctx context.Context, callArgs []map[string]interface{}, blockNumber *big.Int) ([][]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchCallContract", ctx, callArgs, blockNumber)
+	ret0, _ := ret[0].([][]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchCallContract indicates an expected call of BatchCallContract.
+func (mr *MockContractCallerMockRecorder) BatchCallContract(ctx, callArgs, blockNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchCallContract", reflect.TypeOf((*MockContractCaller)(nil).BatchCallContract), ctx, callArgs, blockNumber)
+}
+
 // CallContract mocks base method.
 func (m *MockContractCaller) CallContract(ctx context.Context, callArgs map[string]interface{}, blockNumber *big.Int) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -152,6 +168,21 @@ func (m *MockClientDispatcher) EXPECT() *MockClientDispatcherMockRecorder {
 	return m.recorder
 }
 
+// EstimateGas mocks base method.
+func (m *MockClientDispatcher) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EstimateGas", ctx, msg)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EstimateGas indicates an expected call of EstimateGas.
+func (mr *MockClientDispatcherMockRecorder) EstimateGas(ctx, msg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateGas", reflect.TypeOf((*MockClientDispatcher)(nil).EstimateGas), ctx, msg)
+}
+
 // From mocks base method.
 func (m *MockClientDispatcher) From() common.Address {
 	m.ctrl.T.Helper()
@@ -194,6 +225,20 @@ func (mr *MockClientDispatcherMockRecorder) LockNonce() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockNonce", reflect.TypeOf((*MockClientDispatcher)(nil).LockNonce))
 }
 
+// SendRawTransaction mocks base method.
+func (m *MockClientDispatcher) SendRawTransaction(ctx context.Context, tx []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendRawTransaction", ctx, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendRawTransaction indicates an expected call of SendRawTransaction.
+func (mr *MockClientDispatcherMockRecorder) SendRawTransaction(ctx, tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendRawTransaction", reflect.TypeOf((*MockClientDispatcher)(nil).SendRawTransaction), ctx, tx)
+}
+
 // SignAndSendTransaction mocks base method.
 func (m *MockClientDispatcher) SignAndSendTransaction(ctx context.Context, tx evmclient.CommonTransaction) (common.Hash, error) {
 	m.ctrl.T.Helper()
@@ -209,6 +254,36 @@ func (mr *MockClientDispatcherMockRecorder) SignAndSendTransaction(ctx, tx inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignAndSendTransaction", reflect.TypeOf((*MockClientDispatcher)(nil).SignAndSendTransaction), ctx, tx)
 }
 
+// SignTransaction mocks base method.
+func (m *MockClientDispatcher) SignTransaction(ctx context.Context, tx evmclient.CommonTransaction) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignTransaction", ctx, tx)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignTransaction indicates an expected call of SignTransaction.
+func (mr *MockClientDispatcherMockRecorder) SignTransaction(ctx, tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignTransaction", reflect.TypeOf((*MockClientDispatcher)(nil).SignTransaction), ctx, tx)
+}
+
+// TransactionReceipt mocks base method.
+func (m *MockClientDispatcher) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransactionReceipt", ctx, txHash)
+	ret0, _ := ret[0].(*types.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransactionReceipt indicates an expected call of TransactionReceipt.
+func (mr *MockClientDispatcherMockRecorder) TransactionReceipt(ctx, txHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionReceipt", reflect.TypeOf((*MockClientDispatcher)(nil).TransactionReceipt), ctx, txHash)
+}
+
 // UnlockNonce mocks base method.
 func (m *MockClientDispatcher) UnlockNonce() {
 	m.ctrl.T.Helper()
@@ -250,6 +325,18 @@ func (mr *MockClientDispatcherMockRecorder) UnsafeNonce() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsafeNonce", reflect.TypeOf((*MockClientDispatcher)(nil).UnsafeNonce))
 }
 
+// UnsafeResetNonce mocks base method.
+func (m *MockClientDispatcher) UnsafeResetNonce() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UnsafeResetNonce")
+}
+
+// UnsafeResetNonce indicates an expected call of UnsafeResetNonce.
+func (mr *MockClientDispatcherMockRecorder) UnsafeResetNonce() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsafeResetNonce", reflect.TypeOf((*MockClientDispatcher)(nil).UnsafeResetNonce))
+}
+
 // WaitAndReturnTxReceipt mocks base method.
 func (m *MockClientDispatcher) WaitAndReturnTxReceipt(h common.Hash) (*types.Receipt, error) {
 	m.ctrl.T.Helper()
@@ -288,6 +375,21 @@ func (m *MockContractCallerDispatcher) EXPECT() *MockContractCallerDispatcherMoc
 	return m.recorder
 }
 
+// BatchCallContract mocks base method.
+func (m *MockContractCallerDispatcher) BatchCallContract(ctx context.Context, callArgs []map[string]interface{}, blockNumber *big.Int) ([][]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchCallContract", ctx, callArgs, blockNumber)
+	ret0, _ := ret[0].([][]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchCallContract indicates an expected call of BatchCallContract.
+func (mr *MockContractCallerDispatcherMockRecorder) BatchCallContract(ctx, callArgs, blockNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchCallContract", reflect.TypeOf((*MockContractCallerDispatcher)(nil).BatchCallContract), ctx, callArgs, blockNumber)
+}
+
 // CallContract mocks base method.
 func (m *MockContractCallerDispatcher) CallContract(ctx context.Context, callArgs map[string]interface{}, blockNumber *big.Int) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -318,6 +420,21 @@ func (mr *MockContractCallerDispatcherMockRecorder) CodeAt(ctx, contract, blockN
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CodeAt", reflect.TypeOf((*MockContractCallerDispatcher)(nil).CodeAt), ctx, contract, blockNumber)
 }
 
+// EstimateGas mocks base method.
+func (m *MockContractCallerDispatcher) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EstimateGas", ctx, msg)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EstimateGas indicates an expected call of EstimateGas.
+func (mr *MockContractCallerDispatcherMockRecorder) EstimateGas(ctx, msg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateGas", reflect.TypeOf((*MockContractCallerDispatcher)(nil).EstimateGas), ctx, msg)
+}
+
 // From mocks base method.
 func (m *MockContractCallerDispatcher) From() common.Address {
 	m.ctrl.T.Helper()
@@ -360,6 +477,20 @@ func (mr *MockContractCallerDispatcherMockRecorder) LockNonce() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockNonce", reflect.TypeOf((*MockContractCallerDispatcher)(nil).LockNonce))
 }
 
+// SendRawTransaction mocks base method.
+func (m *MockContractCallerDispatcher) SendRawTransaction(ctx context.Context, tx []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendRawTransaction", ctx, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendRawTransaction indicates an expected call of SendRawTransaction.
+func (mr *MockContractCallerDispatcherMockRecorder) SendRawTransaction(ctx, tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendRawTransaction", reflect.TypeOf((*MockContractCallerDispatcher)(nil).SendRawTransaction), ctx, tx)
+}
+
 // SignAndSendTransaction mocks base method.
 func (m *MockContractCallerDispatcher) SignAndSendTransaction(ctx context.Context, tx evmclient.CommonTransaction) (common.Hash, error) {
 	m.ctrl.T.Helper()
@@ -375,6 +506,36 @@ func (mr *MockContractCallerDispatcherMockRecorder) SignAndSendTransaction(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignAndSendTransaction", reflect.TypeOf((*MockContractCallerDispatcher)(nil).SignAndSendTransaction), ctx, tx)
 }
 
+// SignTransaction mocks base method.
+func (m *MockContractCallerDispatcher) SignTransaction(ctx context.Context, tx evmclient.CommonTransaction) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignTransaction", ctx, tx)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignTransaction indicates an expected call of SignTransaction.
+func (mr *MockContractCallerDispatcherMockRecorder) SignTransaction(ctx, tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignTransaction", reflect.TypeOf((*MockContractCallerDispatcher)(nil).SignTransaction), ctx, tx)
+}
+
+// TransactionReceipt mocks base method.
+func (m *MockContractCallerDispatcher) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransactionReceipt", ctx, txHash)
+	ret0, _ := ret[0].(*types.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransactionReceipt indicates an expected call of TransactionReceipt.
+func (mr *MockContractCallerDispatcherMockRecorder) TransactionReceipt(ctx, txHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionReceipt", reflect.TypeOf((*MockContractCallerDispatcher)(nil).TransactionReceipt), ctx, txHash)
+}
+
 // UnlockNonce mocks base method.
 func (m *MockContractCallerDispatcher) UnlockNonce() {
 	m.ctrl.T.Helper()
@@ -416,6 +577,18 @@ func (mr *MockContractCallerDispatcherMockRecorder) UnsafeNonce() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsafeNonce", reflect.TypeOf((*MockContractCallerDispatcher)(nil).UnsafeNonce))
 }
 
+// UnsafeResetNonce mocks base method.
+func (m *MockContractCallerDispatcher) UnsafeResetNonce() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UnsafeResetNonce")
+}
+
+// UnsafeResetNonce indicates an expected call of UnsafeResetNonce.
+func (mr *MockContractCallerDispatcherMockRecorder) UnsafeResetNonce() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsafeResetNonce", reflect.TypeOf((*MockContractCallerDispatcher)(nil).UnsafeResetNonce))
+}
+
 // WaitAndReturnTxReceipt mocks base method.
 func (m *MockContractCallerDispatcher) WaitAndReturnTxReceipt(h common.Hash) (*types.Receipt, error) {
 	m.ctrl.T.Helper()
@@ -454,6 +627,21 @@ func (m *MockSimulateCaller) EXPECT() *MockSimulateCallerMockRecorder {
 	return m.recorder
 }
 
+// BatchCallContract mocks base method.
+func (m *MockSimulateCaller) BatchCallContract(ctx context.Context, callArgs []map[string]interface{}, blockNumber *big.Int) ([][]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchCallContract", ctx, callArgs, blockNumber)
+	ret0, _ := ret[0].([][]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchCallContract indicates an expected call of BatchCallContract.
+func (mr *MockSimulateCallerMockRecorder) BatchCallContract(ctx, callArgs, blockNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchCallContract", reflect.TypeOf((*MockSimulateCaller)(nil).BatchCallContract), ctx, callArgs, blockNumber)
+}
+
 // CallContract mocks base method.
 func (m *MockSimulateCaller) CallContract(ctx context.Context, callArgs map[string]interface{}, blockNumber *big.Int) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -9,6 +9,7 @@ import (
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/itx"
 	mock_itx "github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/itx/mock"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/signer"
 	"github.com/ChainSafe/chainbridge-core/crypto/secp256k1"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/golang/mock/gomock"
@@ -34,7 +35,7 @@ func (s *TransactTestSuite) SetupTest() {
 	s.kp, _ = secp256k1.NewKeypairFromPrivateKey(common.Hex2Bytes("e8e0f5427111dee651e63a6f1029da6929ebf7d2d61cefaf166cebefdf2c012e"))
 	s.forwarder = mock_itx.NewMockForwarder(gomockController)
 	s.relayCaller = mock_itx.NewMockRelayCaller(gomockController)
-	s.transactor = itx.NewITXTransactor(s.relayCaller, s.forwarder, s.kp)
+	s.transactor = itx.NewITXTransactor(s.relayCaller, s.forwarder, signer.NewLocalSigner(s.kp))
 	s.forwarder.EXPECT().ChainId().Return(big.NewInt(5))
 }
 func (s *TransactTestSuite) TearDownTest() {}
@@ -1,17 +1,18 @@
 package itx
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"sync"
 
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/forwarder"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
-	"github.com/ChainSafe/chainbridge-core/crypto/secp256k1"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/signer"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
-	"github.com/ethereum/go-ethereum/crypto"
-	signer "github.com/ethereum/go-ethereum/signer/core"
+	signercore "github.com/ethereum/go-ethereum/signer/core"
 	"github.com/rs/zerolog/log"
 )
 
@@ -27,7 +28,7 @@ type NonceStorer interface {
 }
 
 type MinimalForwarder struct {
-	kp                *secp256k1.Keypair
+	signer            signer.Signer
 	nonce             *big.Int
 	nonceLock         sync.Mutex
 	chainID           *big.Int
@@ -36,10 +37,10 @@ type MinimalForwarder struct {
 }
 
 // NewMinimalForwarder creates an instance of MinimalForwarder
-func NewMinimalForwarder(chainID *big.Int, kp *secp256k1.Keypair, forwarderContract ForwarderContract, nonceStore NonceStorer) *MinimalForwarder {
+func NewMinimalForwarder(chainID *big.Int, signer signer.Signer, forwarderContract ForwarderContract, nonceStore NonceStorer) *MinimalForwarder {
 	return &MinimalForwarder{
 		chainID:           chainID,
-		kp:                kp,
+		signer:            signer,
 		forwarderContract: forwarderContract,
 		nonceStore:        nonceStore,
 	}
@@ -74,8 +75,7 @@ func (c *MinimalForwarder) UnsafeNonce() (*big.Int, error) {
 			return nil, err
 		}
 
-		from := common.HexToAddress(c.kp.Address())
-		contractNonce, err := c.forwarderContract.GetNonce(from)
+		contractNonce, err := c.forwarderContract.GetNonce(c.signer.Address())
 		if err != nil {
 			return nil, err
 		}
@@ -110,9 +110,9 @@ func (c *MinimalForwarder) ChainId() *big.Int {
 
 // ForwarderData returns ABI packed and signed byte data for a forwarded transaction
 func (c *MinimalForwarder) ForwarderData(to *common.Address, data []byte, opts transactor.TransactOptions) ([]byte, error) {
-	from := c.kp.Address()
-	forwarderHash, err := c.typedHash(
-		from,
+	from := c.signer.Address()
+	typedData := c.typedData(
+		from.Hex(),
 		to.String(),
 		data,
 		math.NewHexOrDecimal256(opts.Value.Int64()),
@@ -120,18 +120,15 @@ func (c *MinimalForwarder) ForwarderData(to *common.Address, data []byte, opts t
 		opts.Nonce,
 		c.ForwarderAddress().Hex(),
 	)
-	if err != nil {
-		return nil, err
-	}
 
-	sig, err := crypto.Sign(forwarderHash, c.kp.PrivateKey())
+	sig, err := c.signer.SignTypedData(context.Background(), typedData)
 	if err != nil {
 		return nil, err
 	}
 	sig[64] += 27 // Transform V from 0/1 to 27/28
 
 	forwardReq := forwarder.ForwardRequest{
-		From:  common.HexToAddress(from),
+		From:  from,
 		To:    *to,
 		Value: opts.Value,
 		Gas:   big.NewInt(int64(opts.GasLimit)),
@@ -141,23 +138,23 @@ func (c *MinimalForwarder) ForwarderData(to *common.Address, data []byte, opts t
 	return c.forwarderContract.PrepareExecute(forwardReq, sig)
 }
 
-func (c *MinimalForwarder) typedHash(
+func (c *MinimalForwarder) typedData(
 	from, to string,
 	data []byte,
 	value, gas *math.HexOrDecimal256,
 	nonce *big.Int,
 	verifyingContract string,
-) ([]byte, error) {
+) signercore.TypedData {
 	chainId := math.NewHexOrDecimal256(c.chainID.Int64())
-	typedData := signer.TypedData{
-		Types: signer.Types{
-			"EIP712Domain": []signer.Type{
+	return signercore.TypedData{
+		Types: signercore.Types{
+			"EIP712Domain": []signercore.Type{
 				{Name: "name", Type: "string"},
 				{Name: "version", Type: "string"},
 				{Name: "chainId", Type: "uint256"},
 				{Name: "verifyingContract", Type: "address"},
 			},
-			"ForwardRequest": []signer.Type{
+			"ForwardRequest": []signercore.Type{
 				{Name: "from", Type: "address"},
 				{Name: "to", Type: "address"},
 				{Name: "value", Type: "uint256"},
@@ -167,32 +164,19 @@ func (c *MinimalForwarder) typedHash(
 			},
 		},
 		PrimaryType: "ForwardRequest",
-		Domain: signer.TypedDataDomain{
+		Domain: signercore.TypedDataDomain{
 			Name:              "MinimalForwarder",
 			ChainId:           chainId,
 			Version:           "0.0.1",
 			VerifyingContract: verifyingContract,
 		},
-		Message: signer.TypedDataMessage{
+		Message: signercore.TypedDataMessage{
 			"from":  from,
 			"to":    to,
 			"value": value,
 			"gas":   gas,
-			"data":  data,
+			"data":  hexutil.Bytes(data),
 			"nonce": math.NewHexOrDecimal256(nonce.Int64()),
 		},
 	}
-
-	domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
-	if err != nil {
-		return nil, err
-	}
-
-	typedDataHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
-	if err != nil {
-		return nil, err
-	}
-
-	rawData := []byte(fmt.Sprintf("\x19\x01%s%s", string(domainSeparator), string(typedDataHash)))
-	return crypto.Keccak256(rawData), nil
 }
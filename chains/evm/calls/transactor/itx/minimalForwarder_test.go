@@ -11,6 +11,7 @@ import (
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/itx"
 	mock_forwarder "github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/itx/mock"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/signer"
 	"github.com/ChainSafe/chainbridge-core/crypto/secp256k1"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
@@ -37,7 +38,7 @@ func (s *MinimalForwarderTestSuite) SetupTest() {
 	s.kp, _ = secp256k1.NewKeypairFromPrivateKey(common.Hex2Bytes("e8e0f5427111dee651e63a6f1029da6929ebf7d2d61cefaf166cebefdf2c012e"))
 	s.forwarderContract = mock_forwarder.NewMockForwarderContract(gomockController)
 	s.nonceStore = mock_forwarder.NewMockNonceStorer(gomockController)
-	s.minimalForwarder = itx.NewMinimalForwarder(big.NewInt(5), s.kp, s.forwarderContract, s.nonceStore)
+	s.minimalForwarder = itx.NewMinimalForwarder(big.NewInt(5), signer.NewLocalSigner(s.kp), s.forwarderContract, s.nonceStore)
 }
 func (s *MinimalForwarderTestSuite) TearDownTest() {}
 
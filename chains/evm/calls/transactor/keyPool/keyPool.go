@@ -0,0 +1,97 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package keyPool
+
+import (
+	"sync"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+)
+
+// BalanceState reports if balance of a key dropped below low balance threshold
+type BalanceState interface {
+	IsLow() bool
+}
+
+// Key is a relayer key of the pool
+type Key struct {
+	Address    common.Address
+	Transactor transactor.Transactor // Transactor sends transactions signed with the key and tracks nonces of the key
+	Balance    BalanceState          // Balance reports if key balance is low, balance is not considered if nil
+}
+
+type poolKey struct {
+	Key
+	inFlight int
+}
+
+func (k *poolKey) lowBalance() bool {
+	return k.Balance != nil && k.Balance.IsLow()
+}
+
+// KeyPoolTransactor distributes transactions across multiple relayer keys so that
+// transactions of different keys are not queued behind a single nonce sequence.
+// Each transaction is sent with the key that has the fewest transactions in flight,
+// keys with low balance are used only if balance of all keys is low.
+type KeyPoolTransactor struct {
+	keys []*poolKey
+	next int
+	lock sync.Mutex
+}
+
+// NewKeyPoolTransactor creates an instance of KeyPoolTransactor that sends transactions with provided keys
+func NewKeyPoolTransactor(keys []Key) *KeyPoolTransactor {
+	poolKeys := make([]*poolKey, len(keys))
+	for i, k := range keys {
+		poolKeys[i] = &poolKey{Key: k}
+	}
+	return &KeyPoolTransactor{keys: poolKeys}
+}
+
+func (t *KeyPoolTransactor) Transact(to *common.Address, data []byte, opts transactor.TransactOptions) (*common.Hash, error) {
+	key := t.acquire()
+	defer t.release(key)
+
+	log.Debug().Str("key", key.Address.Hex()).Strs("references", opts.References).Msg("Sending transaction with pool key")
+	return key.Transactor.Transact(to, data, opts)
+}
+
+// acquire selects key for the next transaction and marks it as having one more transaction in flight.
+// Keys are checked in round-robin order so that keys with the same load are used in turns.
+func (t *KeyPoolTransactor) acquire() *poolKey {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	selected := -1
+	for i := range t.keys {
+		idx := (t.next + i) % len(t.keys)
+		if selected == -1 || isPreferred(t.keys[idx], t.keys[selected]) {
+			selected = idx
+		}
+	}
+
+	key := t.keys[selected]
+	if key.lowBalance() {
+		log.Warn().Str("key", key.Address.Hex()).Msg("Balance of all pool keys is low")
+	}
+	key.inFlight++
+	t.next = (selected + 1) % len(t.keys)
+	return key
+}
+
+func (t *KeyPoolTransactor) release(key *poolKey) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	key.inFlight--
+}
+
+// isPreferred returns true if transaction should rather be sent with key a than key b
+func isPreferred(a *poolKey, b *poolKey) bool {
+	if a.lowBalance() != b.lowBalance() {
+		return !a.lowBalance()
+	}
+	return a.inFlight < b.inFlight
+}
@@ -0,0 +1,131 @@
+package keyPool_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/keyPool"
+	mock_keyPool "github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/keyPool/mock"
+	mock_transactor "github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/mock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type KeyPoolTransactorTestSuite struct {
+	suite.Suite
+	mockTransactor1 *mock_transactor.MockTransactor
+	mockTransactor2 *mock_transactor.MockTransactor
+	mockBalance1    *mock_keyPool.MockBalanceState
+	mockBalance2    *mock_keyPool.MockBalanceState
+	transactor      *keyPool.KeyPoolTransactor
+}
+
+func TestRunKeyPoolTransactorTestSuite(t *testing.T) {
+	suite.Run(t, new(KeyPoolTransactorTestSuite))
+}
+
+func (s *KeyPoolTransactorTestSuite) SetupSuite()    {}
+func (s *KeyPoolTransactorTestSuite) TearDownSuite() {}
+func (s *KeyPoolTransactorTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.mockTransactor1 = mock_transactor.NewMockTransactor(gomockController)
+	s.mockTransactor2 = mock_transactor.NewMockTransactor(gomockController)
+	s.mockBalance1 = mock_keyPool.NewMockBalanceState(gomockController)
+	s.mockBalance2 = mock_keyPool.NewMockBalanceState(gomockController)
+	s.transactor = keyPool.NewKeyPoolTransactor([]keyPool.Key{
+		{Address: common.HexToAddress("0x1"), Transactor: s.mockTransactor1, Balance: s.mockBalance1},
+		{Address: common.HexToAddress("0x2"), Transactor: s.mockTransactor2, Balance: s.mockBalance2},
+	})
+}
+func (s *KeyPoolTransactorTestSuite) TearDownTest() {}
+
+func (s *KeyPoolTransactorTestSuite) TestTransact_KeysUsedInTurns() {
+	s.mockBalance1.EXPECT().IsLow().Return(false).AnyTimes()
+	s.mockBalance2.EXPECT().IsLow().Return(false).AnyTimes()
+	gomock.InOrder(
+		s.mockTransactor1.EXPECT().Transact(gomock.Any(), gomock.Any(), gomock.Any()).Return(&common.Hash{1}, nil),
+		s.mockTransactor2.EXPECT().Transact(gomock.Any(), gomock.Any(), gomock.Any()).Return(&common.Hash{2}, nil),
+		s.mockTransactor1.EXPECT().Transact(gomock.Any(), gomock.Any(), gomock.Any()).Return(&common.Hash{3}, nil),
+	)
+
+	for i := 1; i <= 3; i++ {
+		hash, err := s.transactor.Transact(&common.Address{}, []byte{}, transactor.TransactOptions{})
+
+		s.Nil(err)
+		s.Equal(&common.Hash{byte(i)}, hash)
+	}
+}
+
+func (s *KeyPoolTransactorTestSuite) TestTransact_KeyWithFewestTransactionsInFlightUsed() {
+	s.mockBalance1.EXPECT().IsLow().Return(false).AnyTimes()
+	s.mockBalance2.EXPECT().IsLow().Return(false).AnyTimes()
+	started := make(chan struct{})
+	mined := make(chan struct{})
+	done := make(chan struct{})
+	s.mockTransactor1.EXPECT().Transact(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(to *common.Address, data []byte, opts transactor.TransactOptions) (*common.Hash, error) {
+			close(started)
+			<-mined
+			return &common.Hash{1}, nil
+		})
+	s.mockTransactor2.EXPECT().Transact(gomock.Any(), gomock.Any(), gomock.Any()).Return(&common.Hash{2}, nil).Times(2)
+	go func() {
+		_, _ = s.transactor.Transact(&common.Address{}, []byte{}, transactor.TransactOptions{})
+		close(done)
+	}()
+	<-started
+
+	// key 1 is next in turn after key 2 but it still waits for its transaction
+	_, err := s.transactor.Transact(&common.Address{}, []byte{}, transactor.TransactOptions{})
+	s.Nil(err)
+	_, err = s.transactor.Transact(&common.Address{}, []byte{}, transactor.TransactOptions{})
+	s.Nil(err)
+
+	close(mined)
+	<-done
+}
+
+func (s *KeyPoolTransactorTestSuite) TestTransact_KeyWithLowBalanceSkipped() {
+	s.mockBalance1.EXPECT().IsLow().Return(true).AnyTimes()
+	s.mockBalance2.EXPECT().IsLow().Return(false).AnyTimes()
+	s.mockTransactor2.EXPECT().Transact(gomock.Any(), gomock.Any(), gomock.Any()).Return(&common.Hash{2}, nil).Times(2)
+
+	for i := 0; i < 2; i++ {
+		hash, err := s.transactor.Transact(&common.Address{}, []byte{}, transactor.TransactOptions{})
+
+		s.Nil(err)
+		s.Equal(&common.Hash{2}, hash)
+	}
+}
+
+func (s *KeyPoolTransactorTestSuite) TestTransact_AllKeysWithLowBalance() {
+	s.mockBalance1.EXPECT().IsLow().Return(true).AnyTimes()
+	s.mockBalance2.EXPECT().IsLow().Return(true).AnyTimes()
+	s.mockTransactor1.EXPECT().Transact(gomock.Any(), gomock.Any(), gomock.Any()).Return(&common.Hash{1}, nil)
+
+	hash, err := s.transactor.Transact(&common.Address{}, []byte{}, transactor.TransactOptions{})
+
+	s.Nil(err)
+	s.Equal(&common.Hash{1}, hash)
+}
+
+func (s *KeyPoolTransactorTestSuite) TestTransact_TransactionFailed() {
+	s.mockBalance1.EXPECT().IsLow().Return(false).AnyTimes()
+	s.mockBalance2.EXPECT().IsLow().Return(false).AnyTimes()
+	gomock.InOrder(
+		s.mockTransactor1.EXPECT().Transact(gomock.Any(), gomock.Any(), gomock.Any()).Return(&common.Hash{}, errors.New("error")),
+		s.mockTransactor2.EXPECT().Transact(gomock.Any(), gomock.Any(), gomock.Any()).Return(&common.Hash{2}, nil),
+		s.mockTransactor1.EXPECT().Transact(gomock.Any(), gomock.Any(), gomock.Any()).Return(&common.Hash{1}, nil),
+	)
+
+	_, err := s.transactor.Transact(&common.Address{}, []byte{}, transactor.TransactOptions{})
+	s.NotNil(err)
+
+	// failed transaction is no longer in flight so keys are still used in turns
+	_, err = s.transactor.Transact(&common.Address{}, []byte{}, transactor.TransactOptions{})
+	s.Nil(err)
+	_, err = s.transactor.Transact(&common.Address{}, []byte{}, transactor.TransactOptions{})
+	s.Nil(err)
+}
@@ -0,0 +1,48 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: chains/evm/calls/transactor/keyPool/keyPool.go
+
+// Package mock_keyPool is a generated GoMock package.
+package mock_keyPool
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockBalanceState is a mock of BalanceState interface.
+type MockBalanceState struct {
+	ctrl     *gomock.Controller
+	recorder *MockBalanceStateMockRecorder
+}
+
+// MockBalanceStateMockRecorder is the mock recorder for MockBalanceState.
+type MockBalanceStateMockRecorder struct {
+	mock *MockBalanceState
+}
+
+// NewMockBalanceState creates a new mock instance.
+func NewMockBalanceState(ctrl *gomock.Controller) *MockBalanceState {
+	mock := &MockBalanceState{ctrl: ctrl}
+	mock.recorder = &MockBalanceStateMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBalanceState) EXPECT() *MockBalanceStateMockRecorder {
+	return m.recorder
+}
+
+// IsLow mocks base method.
+func (m *MockBalanceState) IsLow() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsLow")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsLow indicates an expected call of IsLow.
+func (mr *MockBalanceStateMockRecorder) IsLow() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsLow", reflect.TypeOf((*MockBalanceState)(nil).IsLow))
+}
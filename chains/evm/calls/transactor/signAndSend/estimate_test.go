@@ -0,0 +1,112 @@
+package signAndSend_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmtransaction"
+	mock_calls "github.com/ChainSafe/chainbridge-core/chains/evm/calls/mock"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/signAndSend"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type EstimateGasTestSuite struct {
+	suite.Suite
+	mockClient    *mock_calls.MockContractCallerDispatcher
+	mockGasPricer *mock_calls.MockGasPricer
+	gasLimits     []uint64
+}
+
+func TestRunEstimateGasTestSuite(t *testing.T) {
+	suite.Run(t, new(EstimateGasTestSuite))
+}
+
+func (s *EstimateGasTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.mockClient = mock_calls.NewMockContractCallerDispatcher(gomockController)
+	s.mockGasPricer = mock_calls.NewMockGasPricer(gomockController)
+	s.gasLimits = nil
+}
+
+// txFabric records gas limits of created transactions
+func (s *EstimateGasTestSuite) txFabric(nonce uint64, to *common.Address, amount *big.Int, gasLimit uint64, gasPrices []*big.Int, data []byte) (evmclient.CommonTransaction, error) {
+	s.gasLimits = append(s.gasLimits, gasLimit)
+	return evmtransaction.NewTransaction(nonce, to, amount, gasLimit, gasPrices, data)
+}
+
+func (s *EstimateGasTestSuite) transactor() transactor.Transactor {
+	return signAndSend.NewSignAndSendTransactorWithOpts(s.txFabric, s.mockGasPricer, s.mockClient, signAndSend.TransactorOpts{
+		EstimateGas:        true,
+		GasLimitMultiplier: 1.5,
+		MinGasLimit:        50000,
+		MaxGasLimit:        200000,
+	})
+}
+
+func (s *EstimateGasTestSuite) expectSend() {
+	s.mockClient.EXPECT().LockNonce()
+	s.mockClient.EXPECT().UnsafeNonce().Return(big.NewInt(1), nil)
+	s.mockGasPricer.EXPECT().GasPrice().Return([]*big.Int{big.NewInt(1)}, nil)
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1}, nil)
+	s.mockClient.EXPECT().UnsafeIncreaseNonce().Return(nil)
+	s.mockClient.EXPECT().UnlockNonce()
+	s.mockClient.EXPECT().WaitAndReturnTxReceipt(gomock.Any()).Return(&types.Receipt{}, nil)
+}
+
+func (s *EstimateGasTestSuite) TestTransact_EstimatedGasMultiplied() {
+	s.mockClient.EXPECT().From().Return(common.Address{})
+	s.mockClient.EXPECT().EstimateGas(gomock.Any(), gomock.Any()).Return(uint64(100000), nil)
+	s.expectSend()
+
+	_, err := s.transactor().Transact(&common.Address{}, []byte{}, transactor.TransactOptions{})
+
+	s.Nil(err)
+	s.Equal([]uint64{150000}, s.gasLimits)
+}
+
+func (s *EstimateGasTestSuite) TestTransact_EstimatedGasBelowMinimum() {
+	s.mockClient.EXPECT().From().Return(common.Address{})
+	s.mockClient.EXPECT().EstimateGas(gomock.Any(), gomock.Any()).Return(uint64(21000), nil)
+	s.expectSend()
+
+	_, err := s.transactor().Transact(&common.Address{}, []byte{}, transactor.TransactOptions{})
+
+	s.Nil(err)
+	s.Equal([]uint64{50000}, s.gasLimits)
+}
+
+func (s *EstimateGasTestSuite) TestTransact_EstimatedGasAboveMaximum() {
+	s.mockClient.EXPECT().From().Return(common.Address{})
+	s.mockClient.EXPECT().EstimateGas(gomock.Any(), gomock.Any()).Return(uint64(1000000), nil)
+	s.expectSend()
+
+	_, err := s.transactor().Transact(&common.Address{}, []byte{}, transactor.TransactOptions{})
+
+	s.Nil(err)
+	s.Equal([]uint64{200000}, s.gasLimits)
+}
+
+func (s *EstimateGasTestSuite) TestTransact_FixedGasLimitNotEstimated() {
+	s.expectSend()
+
+	_, err := s.transactor().Transact(&common.Address{}, []byte{}, transactor.TransactOptions{GasLimit: 300000})
+
+	s.Nil(err)
+	s.Equal([]uint64{300000}, s.gasLimits)
+}
+
+func (s *EstimateGasTestSuite) TestTransact_EstimationFails() {
+	s.mockClient.EXPECT().From().Return(common.Address{})
+	s.mockClient.EXPECT().EstimateGas(gomock.Any(), gomock.Any()).Return(uint64(0), errors.New("execution reverted"))
+
+	_, err := s.transactor().Transact(&common.Address{}, []byte{}, transactor.TransactOptions{})
+
+	s.NotNil(err)
+	s.Nil(s.gasLimits)
+}
@@ -0,0 +1,188 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: chains/evm/calls/transactor/signAndSend/reconcile.go
+
+// Package mock_signAndSend is a generated GoMock package.
+package mock_signAndSend
+
+import (
+	context "context"
+	big "math/big"
+	reflect "reflect"
+
+	store "github.com/ChainSafe/chainbridge-core/store"
+	common "github.com/ethereum/go-ethereum/common"
+	types "github.com/ethereum/go-ethereum/core/types"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReconcileClient is a mock of ReconcileClient interface.
+type MockReconcileClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockReconcileClientMockRecorder
+}
+
+// MockReconcileClientMockRecorder is the mock recorder for MockReconcileClient.
+type MockReconcileClientMockRecorder struct {
+	mock *MockReconcileClient
+}
+
+// NewMockReconcileClient creates a new mock instance.
+func NewMockReconcileClient(ctrl *gomock.Controller) *MockReconcileClient {
+	mock := &MockReconcileClient{ctrl: ctrl}
+	mock.recorder = &MockReconcileClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReconcileClient) EXPECT() *MockReconcileClientMockRecorder {
+	return m.recorder
+}
+
+// From mocks base method.
+func (m *MockReconcileClient) From() common.Address {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "From")
+	ret0, _ := ret[0].(common.Address)
+	return ret0
+}
+
+// From indicates an expected call of From.
+func (mr *MockReconcileClientMockRecorder) From() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "From", reflect.TypeOf((*MockReconcileClient)(nil).From))
+}
+
+// NonceAt mocks base method.
+func (m *MockReconcileClient) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NonceAt", ctx, account, blockNumber)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NonceAt indicates an expected call of NonceAt.
+func (mr *MockReconcileClientMockRecorder) NonceAt(ctx, account, blockNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NonceAt", reflect.TypeOf((*MockReconcileClient)(nil).NonceAt), ctx, account, blockNumber)
+}
+
+// TransactionByHash mocks base method.
+func (m *MockReconcileClient) TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransactionByHash", ctx, hash)
+	ret0, _ := ret[0].(*types.Transaction)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// TransactionByHash indicates an expected call of TransactionByHash.
+func (mr *MockReconcileClientMockRecorder) TransactionByHash(ctx, hash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionByHash", reflect.TypeOf((*MockReconcileClient)(nil).TransactionByHash), ctx, hash)
+}
+
+// TransactionReceipt mocks base method.
+func (m *MockReconcileClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransactionReceipt", ctx, txHash)
+	ret0, _ := ret[0].(*types.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransactionReceipt indicates an expected call of TransactionReceipt.
+func (mr *MockReconcileClientMockRecorder) TransactionReceipt(ctx, txHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionReceipt", reflect.TypeOf((*MockReconcileClient)(nil).TransactionReceipt), ctx, txHash)
+}
+
+// MockSentTxStore is a mock of SentTxStore interface.
+type MockSentTxStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockSentTxStoreMockRecorder
+}
+
+// MockSentTxStoreMockRecorder is the mock recorder for MockSentTxStore.
+type MockSentTxStoreMockRecorder struct {
+	mock *MockSentTxStore
+}
+
+// NewMockSentTxStore creates a new mock instance.
+func NewMockSentTxStore(ctrl *gomock.Controller) *MockSentTxStore {
+	mock := &MockSentTxStore{ctrl: ctrl}
+	mock.recorder = &MockSentTxStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSentTxStore) EXPECT() *MockSentTxStoreMockRecorder {
+	return m.recorder
+}
+
+// RemoveRestored mocks base method.
+func (m *MockSentTxStore) RemoveRestored(nonce uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveRestored", nonce)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveRestored indicates an expected call of RemoveRestored.
+func (mr *MockSentTxStoreMockRecorder) RemoveRestored(nonce interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRestored", reflect.TypeOf((*MockSentTxStore)(nil).RemoveRestored), nonce)
+}
+
+// Restored mocks base method.
+func (m *MockSentTxStore) Restored() ([]*store.SentTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restored")
+	ret0, _ := ret[0].([]*store.SentTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restored indicates an expected call of Restored.
+func (mr *MockSentTxStoreMockRecorder) Restored() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restored", reflect.TypeOf((*MockSentTxStore)(nil).Restored))
+}
+
+// MockResender is a mock of Resender interface.
+type MockResender struct {
+	ctrl     *gomock.Controller
+	recorder *MockResenderMockRecorder
+}
+
+// MockResenderMockRecorder is the mock recorder for MockResender.
+type MockResenderMockRecorder struct {
+	mock *MockResender
+}
+
+// NewMockResender creates a new mock instance.
+func NewMockResender(ctrl *gomock.Controller) *MockResender {
+	mock := &MockResender{ctrl: ctrl}
+	mock.recorder = &MockResenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResender) EXPECT() *MockResenderMockRecorder {
+	return m.recorder
+}
+
+// Resend mocks base method.
+func (m *MockResender) Resend(tx *store.SentTx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resend", tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Resend indicates an expected call of Resend.
+func (mr *MockResenderMockRecorder) Resend(tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resend", reflect.TypeOf((*MockResender)(nil).Resend), tx)
+}
@@ -0,0 +1,183 @@
+package signAndSend
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/store"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	// ReconcilePeriod is the time between reconciliations of tracked transactions
+	ReconcilePeriod = time.Minute
+)
+
+type ReconcileClient interface {
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	TransactionByHash(ctx context.Context, hash common.Hash) (tx *types.Transaction, isPending bool, err error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	From() common.Address
+}
+
+type SentTxStore interface {
+	Restored() ([]*store.SentTx, error)
+	RemoveRestored(nonce uint64) error
+}
+
+// Resender sends again transactions that were dropped or replaced by other transaction
+type Resender interface {
+	Resend(tx *store.SentTx) error
+}
+
+// Reconciler checks transactions tracked by previous relayer runs against chain state
+// and stops tracking transactions that were mined, replaced or dropped.
+// Transactions that were replaced or dropped without being mined are sent again.
+// Transactions sent by this relayer run are left to transactor that awaits them.
+type Reconciler struct {
+	client   ReconcileClient
+	store    SentTxStore
+	resender Resender
+	missing  map[uint64]bool // missing holds nonces of transactions that were not found on the last reconciliation
+}
+
+func NewReconciler(client ReconcileClient, store SentTxStore) *Reconciler {
+	return &Reconciler{
+		client:  client,
+		store:   store,
+		missing: make(map[uint64]bool),
+	}
+}
+
+// SetResender sets resender used to send again transactions that were dropped or
+// replaced by other transaction, for example votes of a proposal. Such transactions
+// are only removed from the store if resender is not set.
+func (r *Reconciler) SetResender(resender Resender) {
+	r.resender = resender
+}
+
+// Start reconciles transactions tracked by previous relayer runs on start and then
+// every ReconcilePeriod until stop channel is closed
+func (r *Reconciler) Start(stop <-chan struct{}) {
+	r.Reconcile()
+
+	ticker := time.NewTicker(ReconcilePeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			r.Reconcile()
+		}
+	}
+}
+
+// Reconcile stops tracking transactions that were mined, replaced by another transaction with
+// the same nonce or dropped by the node. Transactions that are still pending remain tracked.
+// Transaction is treated as dropped only if it was not found on two consecutive reconciliations
+// as endpoint it is read from might not have received it yet.
+func (r *Reconciler) Reconcile() {
+	txs, err := r.store.Restored()
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed reading tracked transactions")
+		return
+	}
+	if len(txs) == 0 {
+		return
+	}
+
+	minedNonce, err := r.client.NonceAt(context.Background(), r.client.From(), nil)
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed reading relayer nonce")
+		return
+	}
+
+	for _, tx := range txs {
+		logger := log.With().Uint64("nonce", tx.Nonce).Strs("references", tx.References).Logger()
+
+		receipt, hash, err := r.minedReceipt(tx)
+		if err != nil {
+			logger.Warn().Err(err).Msg("Failed reading receipt of tracked transaction")
+			continue
+		}
+
+		resend := false
+		if receipt != nil {
+			logger.Info().Str("hash", hash.Hex()).Uint64("status", receipt.Status).Msg("Tracked transaction mined")
+		} else if tx.Nonce < minedNonce {
+			logger.Warn().Msg("Nonce of tracked transaction used by other transaction")
+			resend = true
+		} else if r.isPending(tx) {
+			delete(r.missing, tx.Nonce)
+			continue
+		} else if !r.missing[tx.Nonce] {
+			logger.Debug().Msg("Tracked transaction not found, checking it again on next reconciliation")
+			r.missing[tx.Nonce] = true
+			continue
+		} else {
+			logger.Warn().Msg("Tracked transaction dropped")
+			resend = true
+		}
+
+		delete(r.missing, tx.Nonce)
+		err = r.store.RemoveRestored(tx.Nonce)
+		if err != nil {
+			logger.Warn().Err(err).Msg("Failed removing tracked transaction")
+			continue
+		}
+		if resend {
+			r.resend(tx)
+		}
+	}
+}
+
+// resend passes transaction to resender without waiting for it to be sent again
+func (r *Reconciler) resend(tx *store.SentTx) {
+	if r.resender == nil {
+		return
+	}
+
+	go func() {
+		err := r.resender.Resend(tx)
+		if err != nil {
+			log.Error().Err(err).Strs("references", tx.References).Msg("Failed resending transaction")
+			return
+		}
+		log.Info().Strs("references", tx.References).Msg("Resent transaction")
+	}()
+}
+
+// minedReceipt returns receipt of the tracked transaction or one of its replacements if any of them was mined.
+// Error is returned if receipts could not be read so transaction is not treated as replaced or dropped.
+func (r *Reconciler) minedReceipt(tx *store.SentTx) (*types.Receipt, common.Hash, error) {
+	for _, h := range tx.Hashes {
+		receipt, err := r.client.TransactionReceipt(context.Background(), h)
+		if err != nil {
+			if errors.Is(err, ethereum.NotFound) {
+				continue
+			}
+			return nil, common.Hash{}, err
+		}
+		if receipt != nil {
+			return receipt, h, nil
+		}
+	}
+	return nil, common.Hash{}, nil
+}
+
+// isPending returns true if any of the transaction hashes is pending or its state could not be read
+func (r *Reconciler) isPending(tx *store.SentTx) bool {
+	for _, h := range tx.Hashes {
+		_, isPending, err := r.client.TransactionByHash(context.Background(), h)
+		if (err == nil && isPending) || (err != nil && !errors.Is(err, ethereum.NotFound)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,142 @@
+package signAndSend_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/signAndSend"
+	mock_signAndSend "github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/signAndSend/mock"
+	"github.com/ChainSafe/chainbridge-core/lvldb"
+	"github.com/ChainSafe/chainbridge-core/store"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ReconcilerTestSuite struct {
+	suite.Suite
+	db           *lvldb.LVLDB
+	previousRun  *store.TxStore
+	txStore      *store.TxStore
+	mockClient   *mock_signAndSend.MockReconcileClient
+	mockResender *mock_signAndSend.MockResender
+	reconciler   *signAndSend.Reconciler
+}
+
+func TestRunReconcilerTestSuite(t *testing.T) {
+	suite.Run(t, new(ReconcilerTestSuite))
+}
+
+func (s *ReconcilerTestSuite) SetupTest() {
+	db, err := lvldb.NewLvlDB(s.T().TempDir())
+	s.Nil(err)
+	s.db = db
+	// transactions tracked by previous relayer run are reconciled by the current one
+	s.previousRun = store.NewTxStore(db, 1)
+	s.txStore = store.NewTxStore(db, 1)
+	gomockController := gomock.NewController(s.T())
+	s.mockClient = mock_signAndSend.NewMockReconcileClient(gomockController)
+	s.mockClient.EXPECT().From().Return(common.Address{}).AnyTimes()
+	s.mockResender = mock_signAndSend.NewMockResender(gomockController)
+	s.reconciler = signAndSend.NewReconciler(s.mockClient, s.txStore)
+	s.reconciler.SetResender(s.mockResender)
+}
+func (s *ReconcilerTestSuite) TearDownTest() {
+	s.db.Close()
+}
+
+func (s *ReconcilerTestSuite) TestReconcile_EmptyStore() {
+	s.reconciler.Reconcile()
+}
+
+func (s *ReconcilerTestSuite) TestReconcile_NonceReadFailed_KeepsTracking() {
+	s.Nil(s.previousRun.Track(&store.SentTx{Nonce: 5, Hashes: []common.Hash{{1}}}))
+	s.mockClient.EXPECT().NonceAt(gomock.Any(), gomock.Any(), nil).Return(uint64(0), errors.New("error"))
+
+	s.reconciler.Reconcile()
+
+	txs, _ := s.txStore.Pending()
+	s.Len(txs, 1)
+}
+
+func (s *ReconcilerTestSuite) TestReconcile_ReceiptReadFailed_KeepsTracking() {
+	s.Nil(s.previousRun.Track(&store.SentTx{Nonce: 5, Hashes: []common.Hash{{1}}}))
+	s.mockClient.EXPECT().NonceAt(gomock.Any(), gomock.Any(), nil).Return(uint64(6), nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{1}).Return(nil, errors.New("error"))
+
+	s.reconciler.Reconcile()
+
+	txs, _ := s.txStore.Pending()
+	s.Len(txs, 1)
+}
+
+func (s *ReconcilerTestSuite) TestReconcile() {
+	// mined replacement
+	s.Nil(s.previousRun.Track(&store.SentTx{Nonce: 5, Hashes: []common.Hash{{1}, {2}}, References: []string{"mined"}}))
+	// nonce used by another transaction
+	s.Nil(s.previousRun.Track(&store.SentTx{Nonce: 6, Hashes: []common.Hash{{3}}, To: common.HexToAddress("0x1"), Data: []byte{1}, References: []string{"replaced"}}))
+	// still pending
+	s.Nil(s.previousRun.Track(&store.SentTx{Nonce: 7, Hashes: []common.Hash{{4}}, References: []string{"pending"}}))
+	// dropped by the node
+	s.Nil(s.previousRun.Track(&store.SentTx{Nonce: 8, Hashes: []common.Hash{{5}}, To: common.HexToAddress("0x1"), Data: []byte{2}, References: []string{"dropped"}}))
+
+	s.mockClient.EXPECT().NonceAt(gomock.Any(), gomock.Any(), nil).Return(uint64(7), nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{1}).Return(nil, ethereum.NotFound)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{2}).Return(&types.Receipt{Status: 1}, nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{3}).Return(nil, ethereum.NotFound)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{4}).Return(nil, ethereum.NotFound)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{5}).Return(nil, ethereum.NotFound)
+	s.mockClient.EXPECT().TransactionByHash(gomock.Any(), common.Hash{4}).Return(&types.Transaction{}, true, nil)
+	s.mockClient.EXPECT().TransactionByHash(gomock.Any(), common.Hash{5}).Return(nil, false, ethereum.NotFound)
+	var resent sync.WaitGroup
+	resent.Add(1)
+	s.mockResender.EXPECT().Resend(gomock.Any()).DoAndReturn(func(tx *store.SentTx) error {
+		defer resent.Done()
+		s.Equal([]byte{1}, tx.Data)
+		s.Equal([]string{"replaced"}, tx.References)
+		return nil
+	})
+
+	s.reconciler.Reconcile()
+	resent.Wait()
+
+	// dropped transaction is resent once it is not found again
+	s.mockClient.EXPECT().NonceAt(gomock.Any(), gomock.Any(), nil).Return(uint64(7), nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{4}).Return(nil, ethereum.NotFound)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{5}).Return(nil, ethereum.NotFound)
+	s.mockClient.EXPECT().TransactionByHash(gomock.Any(), common.Hash{4}).Return(&types.Transaction{}, true, nil)
+	s.mockClient.EXPECT().TransactionByHash(gomock.Any(), common.Hash{5}).Return(nil, false, ethereum.NotFound)
+	resent.Add(1)
+	s.mockResender.EXPECT().Resend(gomock.Any()).DoAndReturn(func(tx *store.SentTx) error {
+		defer resent.Done()
+		s.Equal([]byte{2}, tx.Data)
+		s.Equal([]string{"dropped"}, tx.References)
+		return nil
+	})
+
+	s.reconciler.Reconcile()
+	resent.Wait()
+
+	txs, err := s.txStore.Pending()
+	s.Nil(err)
+	s.Len(txs, 1)
+	s.Equal(uint64(7), txs[0].Nonce)
+	for ref, tracked := range map[string]bool{"mined": false, "replaced": false, "pending": true, "dropped": false} {
+		isTracked, err := s.txStore.IsTracked(ref)
+		s.Nil(err)
+		s.Equal(tracked, isTracked, ref)
+	}
+}
+
+func (s *ReconcilerTestSuite) TestReconcile_SkipsTransactionsSentByThisRun() {
+	s.Nil(s.txStore.Track(&store.SentTx{Nonce: 5, Hashes: []common.Hash{{1}}, References: []string{"in-flight"}}))
+
+	s.reconciler.Reconcile()
+
+	tracked, err := s.txStore.IsTracked("in-flight")
+	s.Nil(err)
+	s.True(tracked)
+}
@@ -0,0 +1,119 @@
+package signAndSend
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// maxReplacements is the number of times replacement timeout passes before transactor gives up waiting
+	// for the transaction, counted also if the transaction could not be replaced
+	maxReplacements = 5
+	// priceBumpPercent is the fee increase of replacement transaction, nodes require at least 10%
+	priceBumpPercent = 12
+)
+
+var (
+	// ReceiptPollPeriod is the time between receipt checks of sent transactions
+	ReceiptPollPeriod = 5 * time.Second
+)
+
+// waitOrReplace waits for receipt of any transaction from the replacement chain and replaces
+// the latest transaction with bumped fees each time replacement timeout passes.
+// Returns receipt and hash of the mined transaction.
+func (t *signAndSendTransactor) waitOrReplace(nonce uint64, to *common.Address, data []byte, opts transactor.TransactOptions, gp []*big.Int, hash common.Hash) (*types.Receipt, common.Hash, error) {
+	chain := []common.Hash{hash}
+	replaceAt := time.Now().Add(t.opts.ReplacementTimeout)
+	for attempt := 1; ; {
+		for _, h := range chain {
+			receipt, err := t.client.TransactionReceipt(context.Background(), h)
+			if err != nil || receipt == nil {
+				continue
+			}
+			if len(chain) > 1 {
+				log.Info().Uint64("nonce", nonce).Str("hash", h.Hex()).Msgf("Replaced transaction mined, replacement chain %v", chain)
+			}
+			if receipt.Status != types.ReceiptStatusSuccessful {
+				return receipt, h, fmt.Errorf("transaction failed on chain. Receipt status %v", receipt.Status)
+			}
+			return receipt, h, nil
+		}
+
+		if time.Now().Before(replaceAt) {
+			time.Sleep(ReceiptPollPeriod)
+			continue
+		}
+		if attempt > maxReplacements {
+			return nil, chain[len(chain)-1], fmt.Errorf("transaction with nonce %d not mined after %d replacement attempts, sent transactions %v", nonce, maxReplacements, chain)
+		}
+		attempt++
+		replaceAt = time.Now().Add(t.opts.ReplacementTimeout)
+
+		bumped, ok := t.bumpGasPrices(gp)
+		if !ok {
+			log.Warn().Uint64("nonce", nonce).Str("hash", chain[len(chain)-1].Hex()).Msg("Transaction not mined but fees are already at max gas price")
+			continue
+		}
+
+		tx, err := t.TxFabric(nonce, to, opts.Value, opts.GasLimit, bumped, data)
+		if err != nil {
+			return nil, chain[len(chain)-1], err
+		}
+		rawTx, err := t.client.SignTransaction(context.TODO(), tx)
+		if err != nil {
+			return nil, chain[len(chain)-1], err
+		}
+		// replacement is tracked before it is sent so that it is known once the replaced transaction leaves the pool
+		h := tx.Hash()
+		t.trackReplacement(nonce, h)
+		err = t.client.SendRawTransaction(context.TODO(), rawTx)
+		if err != nil {
+			// previous transaction from the chain might have been mined meanwhile
+			log.Warn().Err(err).Uint64("nonce", nonce).Msg("Failed sending replacement transaction")
+			continue
+		}
+
+		log.Info().Uint64("nonce", nonce).Str("replaced", chain[len(chain)-1].Hex()).Str("hash", h.Hex()).Msgf("Replaced transaction with gas prices %v", bumped)
+		gp = bumped
+		chain = append(chain, h)
+	}
+}
+
+// bumpGasPrices increases gas price of legacy transaction or tip and fee cap of dynamic fee transaction
+// by priceBumpPercent or to the current suggested price if it is higher. Prices are capped by max gas price.
+// Returns false if prices can not be increased.
+func (t *signAndSendTransactor) bumpGasPrices(gp []*big.Int) ([]*big.Int, bool) {
+	suggested, err := t.gasPriceClient.GasPrice()
+	if err != nil || len(suggested) != len(gp) {
+		suggested = nil
+	}
+
+	bumped := make([]*big.Int, len(gp))
+	for i, p := range gp {
+		bumped[i] = new(big.Int).Div(new(big.Int).Mul(p, big.NewInt(100+priceBumpPercent)), big.NewInt(100))
+		if suggested != nil && suggested[i].Cmp(bumped[i]) > 0 {
+			bumped[i] = suggested[i]
+		}
+		if t.opts.MaxGasPrice != nil && bumped[i].Cmp(t.opts.MaxGasPrice) > 0 {
+			bumped[i] = new(big.Int).Set(t.opts.MaxGasPrice)
+		}
+	}
+	// tip of dynamic fee transaction can not exceed fee cap
+	if len(bumped) > 1 && bumped[0].Cmp(bumped[1]) > 0 {
+		bumped[0] = new(big.Int).Set(bumped[1])
+	}
+
+	for i := range gp {
+		if bumped[i].Cmp(gp[i]) <= 0 {
+			return nil, false
+		}
+	}
+	return bumped, true
+}
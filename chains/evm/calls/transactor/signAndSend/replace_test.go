@@ -0,0 +1,189 @@
+package signAndSend_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmtransaction"
+	mock_calls "github.com/ChainSafe/chainbridge-core/chains/evm/calls/mock"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/signAndSend"
+	"github.com/ChainSafe/chainbridge-core/lvldb"
+	"github.com/ChainSafe/chainbridge-core/store"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ReplacementTestSuite struct {
+	suite.Suite
+	mockClient    *mock_calls.MockContractCallerDispatcher
+	mockGasPricer *mock_calls.MockGasPricer
+	gasPrices     [][]*big.Int
+	nonces        []uint64
+	replacements  []common.Hash
+}
+
+func TestRunReplacementTestSuite(t *testing.T) {
+	suite.Run(t, new(ReplacementTestSuite))
+}
+
+func (s *ReplacementTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.mockClient = mock_calls.NewMockContractCallerDispatcher(gomockController)
+	s.mockGasPricer = mock_calls.NewMockGasPricer(gomockController)
+	s.gasPrices = nil
+	s.nonces = nil
+	s.replacements = nil
+	signAndSend.ReceiptPollPeriod = time.Millisecond
+
+	s.mockClient.EXPECT().LockNonce()
+	s.mockClient.EXPECT().UnsafeNonce().Return(big.NewInt(7), nil)
+	s.mockClient.EXPECT().UnsafeIncreaseNonce().Return(nil)
+	s.mockClient.EXPECT().UnlockNonce()
+}
+
+// txFabric records nonces and gas prices of created transactions
+func (s *ReplacementTestSuite) txFabric(nonce uint64, to *common.Address, amount *big.Int, gasLimit uint64, gasPrices []*big.Int, data []byte) (evmclient.CommonTransaction, error) {
+	s.nonces = append(s.nonces, nonce)
+	s.gasPrices = append(s.gasPrices, gasPrices)
+	return evmtransaction.NewTransaction(nonce, to, amount, gasLimit, gasPrices, data)
+}
+
+// expectReplacements expects replacement transactions to be signed and sent and records their hashes
+func (s *ReplacementTestSuite) expectReplacements(sendErr error, times int) {
+	s.mockClient.EXPECT().SignTransaction(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, tx evmclient.CommonTransaction) ([]byte, error) {
+		s.replacements = append(s.replacements, tx.Hash())
+		return []byte{}, nil
+	}).Times(times)
+	s.mockClient.EXPECT().SendRawTransaction(gomock.Any(), gomock.Any()).Return(sendErr).Times(times)
+}
+
+func (s *ReplacementTestSuite) transactor(maxGasPrice *big.Int) transactor.Transactor {
+	return signAndSend.NewSignAndSendTransactorWithOpts(s.txFabric, s.mockGasPricer, s.mockClient, signAndSend.TransactorOpts{
+		ReplacementTimeout: time.Millisecond * 5,
+		MaxGasPrice:        maxGasPrice,
+	})
+}
+
+func (s *ReplacementTestSuite) TestTransact_LegacyTxReplaced_ReturnsMinedReplacementHash() {
+	s.mockGasPricer.EXPECT().GasPrice().Return([]*big.Int{big.NewInt(100)}, nil).AnyTimes()
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1}, nil)
+	s.expectReplacements(nil, 1)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{1}).Return(nil, errors.New("not found")).AnyTimes()
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), gomock.Any()).Return(&types.Receipt{Status: types.ReceiptStatusSuccessful}, nil)
+
+	hash, err := s.transactor(nil).Transact(&common.Address{}, []byte{}, transactor.TransactOptions{})
+
+	s.Nil(err)
+	s.Equal(s.replacements[0], *hash)
+	s.Equal([]uint64{7, 7}, s.nonces)
+	s.Equal([]*big.Int{big.NewInt(112)}, s.gasPrices[1])
+}
+
+func (s *ReplacementTestSuite) TestTransact_DynamicFeeTxReplaced_FeesCappedByMaxGasPrice() {
+	s.mockGasPricer.EXPECT().GasPrice().Return([]*big.Int{big.NewInt(10), big.NewInt(100)}, nil).AnyTimes()
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1}, nil)
+	s.expectReplacements(nil, 1)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{1}).Return(nil, errors.New("not found")).AnyTimes()
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), gomock.Any()).Return(&types.Receipt{Status: types.ReceiptStatusSuccessful}, nil)
+
+	hash, err := s.transactor(big.NewInt(105)).Transact(&common.Address{}, []byte{}, transactor.TransactOptions{})
+
+	s.Nil(err)
+	s.Equal(s.replacements[0], *hash)
+	s.Equal([]*big.Int{big.NewInt(11), big.NewInt(105)}, s.gasPrices[1])
+}
+
+func (s *ReplacementTestSuite) TestTransact_AtMaxGasPrice_NotReplaced() {
+	s.mockGasPricer.EXPECT().GasPrice().Return([]*big.Int{big.NewInt(100)}, nil).AnyTimes()
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1}, nil)
+	calls := 0
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{1}).DoAndReturn(func(_ interface{}, _ common.Hash) (*types.Receipt, error) {
+		calls++
+		if calls < 10 {
+			return nil, errors.New("not found")
+		}
+		return &types.Receipt{Status: types.ReceiptStatusSuccessful}, nil
+	}).Times(10)
+
+	hash, err := s.transactor(big.NewInt(100)).Transact(&common.Address{}, []byte{}, transactor.TransactOptions{})
+
+	s.Nil(err)
+	s.Equal(common.Hash{1}, *hash)
+	s.Len(s.gasPrices, 1)
+}
+
+func (s *ReplacementTestSuite) TestTransact_AtMaxGasPrice_NotMined() {
+	s.mockGasPricer.EXPECT().GasPrice().Return([]*big.Int{big.NewInt(100)}, nil).AnyTimes()
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1}, nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{1}).Return(nil, errors.New("not found")).AnyTimes()
+	s.mockClient.EXPECT().LockNonce()
+	s.mockClient.EXPECT().UnsafeResetNonce()
+	s.mockClient.EXPECT().UnlockNonce()
+
+	_, err := s.transactor(big.NewInt(100)).Transact(&common.Address{}, []byte{}, transactor.TransactOptions{})
+
+	s.NotNil(err)
+	s.Len(s.gasPrices, 1)
+}
+
+func (s *ReplacementTestSuite) TestTransact_ReplacementSendFails_NotMined() {
+	s.mockGasPricer.EXPECT().GasPrice().Return([]*big.Int{big.NewInt(100)}, nil).AnyTimes()
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1}, nil)
+	s.expectReplacements(errors.New("error"), 5)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{1}).Return(nil, errors.New("not found")).AnyTimes()
+	s.mockClient.EXPECT().LockNonce()
+	s.mockClient.EXPECT().UnsafeResetNonce()
+	s.mockClient.EXPECT().UnlockNonce()
+
+	_, err := s.transactor(nil).Transact(&common.Address{}, []byte{}, transactor.TransactOptions{})
+
+	s.NotNil(err)
+}
+
+func (s *ReplacementTestSuite) TestTransact_TxFailedOnChain() {
+	s.mockGasPricer.EXPECT().GasPrice().Return([]*big.Int{big.NewInt(100)}, nil).AnyTimes()
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1}, nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{1}).Return(&types.Receipt{Status: types.ReceiptStatusFailed}, nil)
+
+	_, err := s.transactor(nil).Transact(&common.Address{}, []byte{}, transactor.TransactOptions{})
+
+	s.NotNil(err)
+}
+
+func (s *ReplacementTestSuite) TestTransact_TracksReplacementsUntilMined() {
+	db, err := lvldb.NewLvlDB(s.T().TempDir())
+	s.Nil(err)
+	defer db.Close()
+	txStore := store.NewTxStore(db, 1)
+	trans := signAndSend.NewSignAndSendTransactorWithOpts(s.txFabric, s.mockGasPricer, s.mockClient, signAndSend.TransactorOpts{
+		ReplacementTimeout: time.Millisecond * 5,
+		TxTracker:          txStore,
+	})
+
+	s.mockGasPricer.EXPECT().GasPrice().Return([]*big.Int{big.NewInt(100)}, nil).AnyTimes()
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1}, nil)
+	s.expectReplacements(nil, 1)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{1}).Return(nil, errors.New("not found")).AnyTimes()
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, _ common.Hash) (*types.Receipt, error) {
+		txs, err := txStore.Pending()
+		s.Nil(err)
+		s.Len(txs, 1)
+		s.Equal([]common.Hash{{1}, s.replacements[0]}, txs[0].Hashes)
+		s.Equal([]string{"proposal"}, txs[0].References)
+		return &types.Receipt{Status: types.ReceiptStatusSuccessful}, nil
+	})
+
+	_, err = trans.Transact(&common.Address{}, []byte{}, transactor.TransactOptions{References: []string{"proposal"}})
+
+	s.Nil(err)
+	tracked, err := txStore.IsTracked("proposal")
+	s.Nil(err)
+	s.False(tracked)
+}
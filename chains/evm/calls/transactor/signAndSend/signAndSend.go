@@ -2,14 +2,23 @@ package signAndSend
 
 import (
 	"context"
+	"fmt"
 	"math/big"
+	"strings"
+	"time"
 
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ChainSafe/chainbridge-core/store"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/rs/zerolog/log"
 )
 
+// maxNonceRetries is the number of times transaction is resent if node rejects its nonce
+const maxNonceRetries = 3
+
 var DefaultTransactionOptions = transactor.TransactOptions{
 	GasLimit: 2000000,
 	GasPrice: big.NewInt(0),
@@ -20,8 +29,35 @@ type signAndSendTransactor struct {
 	TxFabric       calls.TxFabric
 	gasPriceClient calls.GasPricer
 	client         calls.ClientDispatcher
+	opts           TransactorOpts
+}
+
+// TxTracker persists sent transactions until they are mined
+type TxTracker interface {
+	Track(tx *store.SentTx) error
+	Replace(nonce uint64, hash common.Hash) error
+	Remove(nonce uint64) error
+}
+
+// SpendTracker tracks fees paid for transactions mined with receipt
+type SpendTracker interface {
+	TrackSpend(receipt *types.Receipt)
 }
 
+// TransactorOpts configures optional behaviour of the transactor
+type TransactorOpts struct {
+	ReplacementTimeout time.Duration // ReplacementTimeout is the time after which transaction is resent with the same nonce and bumped fees, disabled if zero
+	MaxGasPrice        *big.Int      // MaxGasPrice limits gas price or fee cap of replacement transactions, not applied if nil
+	TxTracker          TxTracker     // TxTracker persists sent transactions, not used if nil
+	EstimateGas        bool          // EstimateGas makes transactor estimate gas limit of transactions sent without one
+	GasLimitMultiplier float64       // GasLimitMultiplier is applied to estimated gas, not applied if zero
+	MinGasLimit        uint64        // MinGasLimit is the lowest gas limit used for estimated transactions
+	MaxGasLimit        uint64        // MaxGasLimit is the highest gas limit used for estimated transactions, not applied if zero
+	SpendTracker       SpendTracker  // SpendTracker tracks fees paid for mined transactions, not used if nil
+}
+
+// NewSignAndSendTransactor creates a transactor that assigns nonces locally. Nonce lock is held
+// only while transaction is sent so multiple transactions can wait for receipts at once.
 func NewSignAndSendTransactor(txFabric calls.TxFabric, gasPriceClient calls.GasPricer, client calls.ClientDispatcher) transactor.Transactor {
 	return &signAndSendTransactor{
 		TxFabric:       txFabric,
@@ -30,15 +66,27 @@ func NewSignAndSendTransactor(txFabric calls.TxFabric, gasPriceClient calls.GasP
 	}
 }
 
+// NewSignAndSendTransactorWithOpts creates a transactor that can replace transactions not mined within
+// replacement timeout with transactions with the same nonce and bumped fees and track sent transactions
+func NewSignAndSendTransactorWithOpts(txFabric calls.TxFabric, gasPriceClient calls.GasPricer, client calls.ClientDispatcher, opts TransactorOpts) transactor.Transactor {
+	return &signAndSendTransactor{
+		TxFabric:       txFabric,
+		gasPriceClient: gasPriceClient,
+		client:         client,
+		opts:           opts,
+	}
+}
+
 func (t *signAndSendTransactor) Transact(to *common.Address, data []byte, opts transactor.TransactOptions) (*common.Hash, error) {
-	defer t.client.UnlockNonce()
-	t.client.LockNonce()
-	n, err := t.client.UnsafeNonce()
-	if err != nil {
-		return &common.Hash{}, err
+	if opts.GasLimit == 0 && t.opts.EstimateGas {
+		gasLimit, err := t.estimateGasLimit(to, data, opts.Value)
+		if err != nil {
+			return &common.Hash{}, err
+		}
+		opts.GasLimit = gasLimit
 	}
 
-	err = transactor.MergeTransactionOptions(&opts, &DefaultTransactionOptions)
+	err := transactor.MergeTransactionOptions(&opts, &DefaultTransactionOptions)
 	if err != nil {
 		return &common.Hash{}, err
 	}
@@ -51,21 +99,162 @@ func (t *signAndSendTransactor) Transact(to *common.Address, data []byte, opts t
 		}
 	}
 
-	tx, err := t.TxFabric(n.Uint64(), to, opts.Value, opts.GasLimit, gp, data)
+	n, h, err := t.send(to, data, opts, gp)
 	if err != nil {
+		log.Error().Err(err)
 		return &common.Hash{}, err
 	}
 
-	h, err := t.client.SignAndSendTransaction(context.TODO(), tx)
+	t.track(n, h, to, data, opts)
+
+	var receipt *types.Receipt
+	if t.opts.ReplacementTimeout != 0 {
+		receipt, h, err = t.waitOrReplace(n, to, data, opts, gp, h)
+	} else {
+		receipt, err = t.client.WaitAndReturnTxReceipt(h)
+	}
+	t.untrack(n)
+	if receipt != nil && t.opts.SpendTracker != nil {
+		t.opts.SpendTracker.TrackSpend(receipt)
+	}
 	if err != nil {
-		log.Error().Err(err)
+		if receipt == nil {
+			// transaction was dropped so following transactions would be stuck behind a nonce gap
+			t.resyncNonce()
+		}
 		return &common.Hash{}, err
 	}
 
-	_, err = t.client.WaitAndReturnTxReceipt(h)
+	return &h, nil
+}
+
+// send signs and sends transaction with locally assigned nonce. If node rejects
+// the nonce it is resynced and transaction is sent again.
+func (t *signAndSendTransactor) send(to *common.Address, data []byte, opts transactor.TransactOptions, gp []*big.Int) (uint64, common.Hash, error) {
+	defer t.client.UnlockNonce()
+	t.client.LockNonce()
+
+	for i := 0; ; i++ {
+		n, err := t.client.UnsafeNonce()
+		if err != nil {
+			return 0, common.Hash{}, err
+		}
+
+		tx, err := t.TxFabric(n.Uint64(), to, opts.Value, opts.GasLimit, gp, data)
+		if err != nil {
+			return 0, common.Hash{}, err
+		}
+
+		h, err := t.client.SignAndSendTransaction(context.TODO(), tx)
+		if err == nil {
+			return n.Uint64(), h, t.client.UnsafeIncreaseNonce()
+		}
+		if isAlreadyKnown(err) {
+			// the same transaction is already in the pool, e.g. because it was sent before the previous call failed
+			log.Debug().Err(err).Uint64("nonce", n.Uint64()).Msg("Transaction already known by node")
+			return n.Uint64(), tx.Hash(), t.client.UnsafeIncreaseNonce()
+		}
+		if i >= maxNonceRetries {
+			return 0, common.Hash{}, err
+		}
+
+		switch {
+		case isNonceTooLow(err):
+			t.client.UnsafeResetNonce()
+		case isNonceTaken(err):
+			err = t.client.UnsafeIncreaseNonce()
+			if err != nil {
+				return 0, common.Hash{}, err
+			}
+		default:
+			return 0, common.Hash{}, err
+		}
+		log.Warn().Err(err).Uint64("nonce", n.Uint64()).Msg("Transaction nonce rejected, resending")
+	}
+}
+
+// estimateGasLimit estimates gas used by transaction, applies gas limit multiplier
+// and clamps the result between min and max gas limit
+func (t *signAndSendTransactor) estimateGasLimit(to *common.Address, data []byte, value *big.Int) (uint64, error) {
+	gas, err := t.client.EstimateGas(context.TODO(), ethereum.CallMsg{
+		From:  t.client.From(),
+		To:    to,
+		Value: value,
+		Data:  data,
+	})
 	if err != nil {
-		return &common.Hash{}, err
+		return 0, fmt.Errorf("gas estimation failed: %w", err)
 	}
 
-	return &h, nil
+	gasLimit := gas
+	if t.opts.GasLimitMultiplier != 0 {
+		gasLimit = uint64(float64(gas) * t.opts.GasLimitMultiplier)
+	}
+	if gasLimit < t.opts.MinGasLimit {
+		gasLimit = t.opts.MinGasLimit
+	}
+	if t.opts.MaxGasLimit != 0 && gasLimit > t.opts.MaxGasLimit {
+		gasLimit = t.opts.MaxGasLimit
+	}
+
+	log.Debug().Uint64("estimated", gas).Uint64("gasLimit", gasLimit).Msg("Estimated transaction gas limit")
+	return gasLimit, nil
+}
+
+func (t *signAndSendTransactor) resyncNonce() {
+	t.client.LockNonce()
+	defer t.client.UnlockNonce()
+	t.client.UnsafeResetNonce()
+}
+
+func (t *signAndSendTransactor) track(nonce uint64, hash common.Hash, to *common.Address, data []byte, opts transactor.TransactOptions) {
+	if t.opts.TxTracker == nil {
+		return
+	}
+	tx := &store.SentTx{Nonce: nonce, Hashes: []common.Hash{hash}, Data: data, References: opts.References}
+	if to != nil {
+		tx.To = *to
+	}
+	err := t.opts.TxTracker.Track(tx)
+	if err != nil {
+		log.Warn().Err(err).Uint64("nonce", nonce).Msg("Failed storing sent transaction")
+	}
+}
+
+func (t *signAndSendTransactor) trackReplacement(nonce uint64, hash common.Hash) {
+	if t.opts.TxTracker == nil {
+		return
+	}
+	err := t.opts.TxTracker.Replace(nonce, hash)
+	if err != nil {
+		log.Warn().Err(err).Uint64("nonce", nonce).Msg("Failed storing replacement transaction")
+	}
+}
+
+func (t *signAndSendTransactor) untrack(nonce uint64) {
+	if t.opts.TxTracker == nil {
+		return
+	}
+	err := t.opts.TxTracker.Remove(nonce)
+	if err != nil {
+		log.Warn().Err(err).Uint64("nonce", nonce).Msg("Failed removing sent transaction")
+	}
+}
+
+// isNonceTooLow returns true if node rejected transaction because nonce was already used by mined transaction
+func isNonceTooLow(err error) bool {
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "nonce too low") || strings.Contains(msg, "nonce is too low")
+}
+
+// isNonceTaken returns true if node rejected transaction because it already has other pending transaction with the nonce
+func isNonceTaken(err error) bool {
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "replacement transaction underpriced")
+}
+
+// isAlreadyKnown returns true if node rejected transaction because the exact transaction is already in its pool
+func isAlreadyKnown(err error) bool {
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "already known") || strings.Contains(msg, "known transaction")
 }
@@ -1,6 +1,7 @@
 package signAndSend_test
 
 import (
+	"errors"
 	"math/big"
 	"testing"
 
@@ -74,3 +75,123 @@ func (s *TransactorTestSuite) TestTransactor_SignAndSend_Success() {
 	// without prepare flag omitted SignAndSendTransactor is used and output is normal tx hash
 	s.Equal("0x0102030405000000000000000000000000000000000000000000000000000000", txHash.String())
 }
+
+func (s *TransactorTestSuite) TestTransactor_SignAndSend_NonceTooLow_ResyncsNonce() {
+	gomock.InOrder(
+		s.mockContractCallerDispatcherClient.EXPECT().LockNonce(),
+		s.mockContractCallerDispatcherClient.EXPECT().UnsafeNonce().Return(big.NewInt(1), nil),
+		s.mockContractCallerDispatcherClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{}, errors.New("nonce too low")),
+		s.mockContractCallerDispatcherClient.EXPECT().UnsafeResetNonce(),
+		s.mockContractCallerDispatcherClient.EXPECT().UnsafeNonce().Return(big.NewInt(5), nil),
+		s.mockContractCallerDispatcherClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1}, nil),
+		s.mockContractCallerDispatcherClient.EXPECT().UnsafeIncreaseNonce().Return(nil),
+		s.mockContractCallerDispatcherClient.EXPECT().UnlockNonce(),
+		s.mockContractCallerDispatcherClient.EXPECT().WaitAndReturnTxReceipt(common.Hash{1}).Return(&types.Receipt{}, nil),
+	)
+
+	trans := signAndSend.NewSignAndSendTransactor(evmtransaction.NewTransaction, s.mockGasPricer, s.mockContractCallerDispatcherClient)
+	txHash, err := trans.Transact(&common.Address{}, []byte{}, transactor.TransactOptions{GasPrice: big.NewInt(1)})
+
+	s.Nil(err)
+	s.Equal(common.Hash{1}, *txHash)
+}
+
+func (s *TransactorTestSuite) TestTransactor_SignAndSend_NonceTaken_UsesNextNonce() {
+	gomock.InOrder(
+		s.mockContractCallerDispatcherClient.EXPECT().LockNonce(),
+		s.mockContractCallerDispatcherClient.EXPECT().UnsafeNonce().Return(big.NewInt(1), nil),
+		s.mockContractCallerDispatcherClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{}, errors.New("replacement transaction underpriced")),
+		s.mockContractCallerDispatcherClient.EXPECT().UnsafeIncreaseNonce().Return(nil),
+		s.mockContractCallerDispatcherClient.EXPECT().UnsafeNonce().Return(big.NewInt(2), nil),
+		s.mockContractCallerDispatcherClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1}, nil),
+		s.mockContractCallerDispatcherClient.EXPECT().UnsafeIncreaseNonce().Return(nil),
+		s.mockContractCallerDispatcherClient.EXPECT().UnlockNonce(),
+		s.mockContractCallerDispatcherClient.EXPECT().WaitAndReturnTxReceipt(common.Hash{1}).Return(&types.Receipt{}, nil),
+	)
+
+	trans := signAndSend.NewSignAndSendTransactor(evmtransaction.NewTransaction, s.mockGasPricer, s.mockContractCallerDispatcherClient)
+	_, err := trans.Transact(&common.Address{}, []byte{}, transactor.TransactOptions{GasPrice: big.NewInt(1)})
+
+	s.Nil(err)
+}
+
+func (s *TransactorTestSuite) TestTransactor_SignAndSend_AlreadyKnown_ReturnsTxHash() {
+	tx, err := evmtransaction.NewTransaction(1, &common.Address{}, big.NewInt(0), signAndSend.DefaultTransactionOptions.GasLimit, []*big.Int{big.NewInt(1)}, []byte{})
+	s.Nil(err)
+	gomock.InOrder(
+		s.mockContractCallerDispatcherClient.EXPECT().LockNonce(),
+		s.mockContractCallerDispatcherClient.EXPECT().UnsafeNonce().Return(big.NewInt(1), nil),
+		s.mockContractCallerDispatcherClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{}, errors.New("already known")),
+		s.mockContractCallerDispatcherClient.EXPECT().UnsafeIncreaseNonce().Return(nil),
+		s.mockContractCallerDispatcherClient.EXPECT().UnlockNonce(),
+		s.mockContractCallerDispatcherClient.EXPECT().WaitAndReturnTxReceipt(tx.Hash()).Return(&types.Receipt{}, nil),
+	)
+
+	trans := signAndSend.NewSignAndSendTransactor(evmtransaction.NewTransaction, s.mockGasPricer, s.mockContractCallerDispatcherClient)
+	txHash, err := trans.Transact(&common.Address{}, []byte{}, transactor.TransactOptions{GasPrice: big.NewInt(1)})
+
+	s.Nil(err)
+	s.Equal(tx.Hash(), *txHash)
+}
+
+func (s *TransactorTestSuite) TestTransactor_SignAndSend_SendFailed_NonceNotIncreased() {
+	s.mockContractCallerDispatcherClient.EXPECT().LockNonce()
+	s.mockContractCallerDispatcherClient.EXPECT().UnsafeNonce().Return(big.NewInt(1), nil)
+	s.mockContractCallerDispatcherClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{}, errors.New("insufficient funds"))
+	s.mockContractCallerDispatcherClient.EXPECT().UnlockNonce()
+
+	trans := signAndSend.NewSignAndSendTransactor(evmtransaction.NewTransaction, s.mockGasPricer, s.mockContractCallerDispatcherClient)
+	_, err := trans.Transact(&common.Address{}, []byte{}, transactor.TransactOptions{GasPrice: big.NewInt(1)})
+
+	s.NotNil(err)
+}
+
+func (s *TransactorTestSuite) TestTransactor_SignAndSend_ReceiptNotFound_ResyncsNonce() {
+	gomock.InOrder(
+		s.mockContractCallerDispatcherClient.EXPECT().LockNonce(),
+		s.mockContractCallerDispatcherClient.EXPECT().UnsafeNonce().Return(big.NewInt(1), nil),
+		s.mockContractCallerDispatcherClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1}, nil),
+		s.mockContractCallerDispatcherClient.EXPECT().UnsafeIncreaseNonce().Return(nil),
+		s.mockContractCallerDispatcherClient.EXPECT().UnlockNonce(),
+		s.mockContractCallerDispatcherClient.EXPECT().WaitAndReturnTxReceipt(common.Hash{1}).Return(nil, errors.New("tx did not appear")),
+		s.mockContractCallerDispatcherClient.EXPECT().LockNonce(),
+		s.mockContractCallerDispatcherClient.EXPECT().UnsafeResetNonce(),
+		s.mockContractCallerDispatcherClient.EXPECT().UnlockNonce(),
+	)
+
+	trans := signAndSend.NewSignAndSendTransactor(evmtransaction.NewTransaction, s.mockGasPricer, s.mockContractCallerDispatcherClient)
+	_, err := trans.Transact(&common.Address{}, []byte{}, transactor.TransactOptions{GasPrice: big.NewInt(1)})
+
+	s.NotNil(err)
+}
+
+type spendTracker struct {
+	receipts []*types.Receipt
+}
+
+func (t *spendTracker) TrackSpend(receipt *types.Receipt) {
+	t.receipts = append(t.receipts, receipt)
+}
+
+func (s *TransactorTestSuite) TestTransactor_SignAndSend_TracksSpend() {
+	receipt := &types.Receipt{GasUsed: 21000}
+	s.mockContractCallerDispatcherClient.EXPECT().LockNonce()
+	s.mockContractCallerDispatcherClient.EXPECT().UnsafeNonce().Return(big.NewInt(1), nil)
+	s.mockGasPricer.EXPECT().GasPrice().Return([]*big.Int{big.NewInt(1)}, nil)
+	s.mockContractCallerDispatcherClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1}, nil)
+	s.mockContractCallerDispatcherClient.EXPECT().UnsafeIncreaseNonce().Return(nil)
+	s.mockContractCallerDispatcherClient.EXPECT().UnlockNonce()
+	s.mockContractCallerDispatcherClient.EXPECT().WaitAndReturnTxReceipt(gomock.Any()).Return(receipt, nil)
+
+	tracker := &spendTracker{}
+	trans := signAndSend.NewSignAndSendTransactorWithOpts(
+		evmtransaction.NewTransaction,
+		s.mockGasPricer,
+		s.mockContractCallerDispatcherClient,
+		signAndSend.TransactorOpts{SpendTracker: tracker},
+	)
+	_, err := trans.Transact(&common.Address{}, []byte{}, transactor.TransactOptions{})
+
+	s.Nil(err)
+	s.Equal([]*types.Receipt{receipt}, tracker.receipts)
+}
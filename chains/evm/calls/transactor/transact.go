@@ -9,12 +9,13 @@ import (
 )
 
 type TransactOptions struct {
-	GasLimit uint64
-	GasPrice *big.Int
-	Value    *big.Int
-	Nonce    *big.Int
-	ChainID  *big.Int
-	Priority string
+	GasLimit   uint64
+	GasPrice   *big.Int
+	Value      *big.Int
+	Nonce      *big.Int
+	ChainID    *big.Int
+	Priority   string
+	References []string // References identify what transaction is sent for, for example proposals it votes for
 }
 
 func MergeTransactionOptions(primary *TransactOptions, additional *TransactOptions) error {
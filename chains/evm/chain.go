@@ -4,21 +4,31 @@
 package evm
 
 import (
+	"context"
 	"fmt"
 	"math/big"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/multicall"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmgaspricer"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/keyPool"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/signAndSend"
 
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/executor"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/listener"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/monitor"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/sweeper"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/voter"
 	"github.com/ChainSafe/chainbridge-core/config/chain"
 	"github.com/ChainSafe/chainbridge-core/relayer/message"
 	"github.com/ChainSafe/chainbridge-core/store"
+	"github.com/ChainSafe/chainbridge-core/types"
+	"github.com/ChainSafe/chainbridge-core/util"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rs/zerolog/log"
 )
@@ -31,16 +41,51 @@ type ProposalVoter interface {
 	VoteProposal(message *message.Message, chainConfig *chain.EVMConfig) error
 }
 
+// Service is a chain component that runs in the background while chain is polling events
+type Service interface {
+	Start(stop <-chan struct{})
+}
+
+// Metrics reports relayer balance, fees spent and health of endpoints of the chain
+type Metrics interface {
+	monitor.Metrics
+	evmclient.EndpointMetrics
+}
+
+// ResourceMatcher decides if bridge is able to handle messages for resourceID
+type ResourceMatcher interface {
+	HandlesResource(resourceID types.ResourceID) (bool, error)
+}
+
+// EVMBridge aggregates components of a single bridge contract deployed on the chain
+type EVMBridge struct {
+	Address    common.Address
+	StartBlock *big.Int
+	Listener   EventListener
+	Writer     ProposalVoter
+	Resources  ResourceMatcher
+}
+
 // EVMChain is struct that aggregates all data required for
 type EVMChain struct {
-	listener   EventListener
-	writer     ProposalVoter
-	blockstore *store.BlockStore
-	config     *chain.EVMConfig
+	bridges         []*EVMBridge
+	blockstore      *store.BlockStore
+	config          *chain.EVMConfig
+	services        []Service
+	depositSource   *voter.DepositSource
+	voters          []*voter.EVMVoter
+	executors       []*executor.Executor
+	balanceMonitors []*monitor.BalanceMonitor
+	gasSpend        *monitor.GasSpendTracker
+	endpoints       *evmclient.EndpointPool
+
+	resourceBridges map[types.ResourceID]*EVMBridge
+	resourcesLock   sync.Mutex
 }
 
 // SetupDefaultEVMChain sets up an EVMChain with all supported handlers configured
-func SetupDefaultEVMChain(rawConfig map[string]interface{}, txFabric calls.TxFabric, blockstore *store.BlockStore) (*EVMChain, error) {
+// for each bridge contract configured on the chain
+func SetupDefaultEVMChain(rawConfig map[string]interface{}, txFabric calls.TxFabric, db store.KeyValueReaderWriter) (*EVMChain, error) {
 	config, err := chain.NewEVMConfig(rawConfig)
 	if err != nil {
 		return nil, err
@@ -51,58 +96,267 @@ func SetupDefaultEVMChain(rawConfig map[string]interface{}, txFabric calls.TxFab
 		return nil, err
 	}
 
-	gasPricer := evmgaspricer.NewLondonGasPriceClient(client, nil)
-	t := signAndSend.NewSignAndSendTransactor(txFabric, gasPricer, client)
-	bridgeContract := bridge.NewBridgeContract(client, common.HexToAddress(config.Bridge), t)
+	gasPricer := newGasPricer(config, client)
+	domainID := *config.GeneralChainConfig.Id
+	gasSpend := monitor.NewGasSpendTracker(client, store.NewGasSpendStore(db, domainID), domainID, config.DailyGasBudget)
+	var services []Service
+	var txStores []*store.TxStore
+	var balanceMonitors []*monitor.BalanceMonitor
+	var keys []keyPool.Key
+	var reconcilers []*signAndSend.Reconciler
+	// each key of the key pool has its own nonce sequence, sent transactions and balance
+	for i, keyClient := range client.KeyClients() {
+		txStore := store.NewTxStore(db, domainID)
+		if i > 0 {
+			txStore = store.NewAccountTxStore(db, domainID, keyClient.From())
+		}
+		balanceMonitor := monitor.NewBalanceMonitor(keyClient, keyClient.From(), domainID, config.MinBalance)
+		keys = append(keys, keyPool.Key{
+			Address: keyClient.From(),
+			Transactor: signAndSend.NewSignAndSendTransactorWithOpts(txFabric, gasPricer, keyClient, signAndSend.TransactorOpts{
+				ReplacementTimeout: config.TxReplacement,
+				MaxGasPrice:        config.MaxGasPrice,
+				TxTracker:          txStore,
+				EstimateGas:        true,
+				GasLimitMultiplier: config.GasLimitMultiplier,
+				MinGasLimit:        config.MinGasLimit,
+				MaxGasLimit:        config.GasLimit.Uint64(),
+				SpendTracker:       gasSpend,
+			}),
+			Balance: balanceMonitor,
+		})
+		txStores = append(txStores, txStore)
+		balanceMonitors = append(balanceMonitors, balanceMonitor)
+		reconciler := signAndSend.NewReconciler(keyClient, txStore)
+		reconcilers = append(reconcilers, reconciler)
+		services = append(services, reconciler, balanceMonitor)
+	}
+	// transactor is shared between bridges so that nonces are managed for the whole chain
+	t := keys[0].Transactor
+	if len(keys) > 1 {
+		t = keyPool.NewKeyPoolTransactor(keys)
+	}
+	resender := voter.NewVoteResender()
+	for _, reconciler := range reconcilers {
+		reconciler.SetResender(resender)
+	}
+	messageQueue := store.NewMessageQueue(db)
+	depositSource := voter.NewDepositSource(client, config.BlockConfirmations)
+	alerter := &voter.LogAlerter{}
+	var multicallContract *multicall.MulticallContract
+	if config.Multicall != "" {
+		multicallContract = multicall.NewMulticallContract(client, common.HexToAddress(config.Multicall), t)
+		resender.SetMulticall(common.HexToAddress(config.Multicall))
+	}
+
+	bridges := make([]*EVMBridge, 0, len(config.Bridges))
+	services = append(services, client.Endpoints())
+	var voters []*voter.EVMVoter
+	var executors []*executor.Executor
+	for _, bridgeConfig := range config.Bridges {
+		bridgeAddress := common.HexToAddress(bridgeConfig.Address)
+		adapter, err := bridgeABIAdapter(client, bridgeAddress, bridgeConfig.Version)
+		if err != nil {
+			return nil, err
+		}
+		bridgeContract := bridge.NewVersionedBridgeContract(client, bridgeAddress, t, adapter)
 
-	eventHandler := listener.NewETHEventHandler(*bridgeContract)
-	mh := voter.NewEVMMessageHandler(*bridgeContract)
+		eventHandler := listener.NewETHEventHandler(*bridgeContract)
+		mh := voter.NewEVMMessageHandler(*bridgeContract)
 
-	for _, erc20HandlerContract := range config.Erc20Handlers {
-		eventHandler.RegisterEventHandler(erc20HandlerContract, listener.Erc20EventHandler)
-		mh.RegisterMessageHandler(erc20HandlerContract, voter.ERC20MessageHandler)
+		for _, erc20HandlerContract := range bridgeConfig.Erc20Handlers {
+			eventHandler.RegisterEventHandler(erc20HandlerContract, listener.Erc20EventHandler)
+			mh.RegisterMessageHandler(erc20HandlerContract, voter.ERC20MessageHandler)
+		}
+		for _, erc721HandlerContract := range bridgeConfig.Erc721Handlers {
+			eventHandler.RegisterEventHandler(erc721HandlerContract, listener.Erc721EventHandler)
+			mh.RegisterMessageHandler(erc721HandlerContract, voter.ERC721MessageHandler)
+		}
+		for _, genericHandlerContract := range bridgeConfig.GenericHandlers {
+			eventHandler.RegisterEventHandler(genericHandlerContract, listener.GenericEventHandler)
+			mh.RegisterMessageHandler(genericHandlerContract, voter.GenericMessageHandler)
+		}
+
+		depositSource.RegisterBridge(bridgeAddress, adapter, eventHandler)
+
+		evmListener := listener.NewVersionedEVMListener(client, eventHandler, bridgeAddress, adapter)
+		var evmVoter *voter.EVMVoter
+		evmVoter, err = voter.NewVoterWithSubscription(mh, client, bridgeContract, *config.GeneralChainConfig.Id)
+		if err != nil {
+			log.Error().Msgf("failed creating voter with subscription: %s. Falling back to default voter.", err.Error())
+			evmVoter = voter.NewVoter(mh, client, bridgeContract, *config.GeneralChainConfig.Id)
+		}
+		resender.AddVoter(bridgeAddress, evmVoter)
+		voteTracker := voter.NewProposalVoteTracker(evmListener.SubscribeToBridgeEvents(util.ProposalVote), client, bridgeAddress, adapter)
+		evmVoter.SetDataHashTracker(voteTracker, alerter)
+		for _, txStore := range txStores {
+			evmVoter.AddSentTxStore(txStore)
+		}
+		if config.DailyGasBudget != nil {
+			evmVoter.SetGasBudget(gasSpend)
+		}
+		if config.VoteCoordination == chain.VoteCoordinationRanked {
+			relayerSet := voter.NewRelayerSet(bridgeContract, evmListener.SubscribeToBridgeEvents(util.RelayerAdded, util.RelayerRemoved))
+			evmVoter.SetRelayerProvider(relayerSet)
+			services = append(services, relayerSet)
+		}
+		proposalSweeper := sweeper.NewSweeper(db, client, bridgeContract, alerter, config)
+		evmVoter.AddProposalRecorder(proposalSweeper)
+		if config.ExecuteProposals {
+			journal := executor.NewProposalJournal(db, domainID, executor.DefaultJournalTTL)
+			evmVoter.AddProposalRecorder(journal)
+			proposalExecutor := executor.NewExecutor(
+				bridgeContract,
+				mh,
+				journal,
+				evmListener.SubscribeToBridgeEvents(util.ProposalEvent),
+				config,
+			)
+			services = append(services, proposalExecutor)
+			executors = append(executors, proposalExecutor)
+		}
+		if multicallContract != nil {
+			batcher := voter.NewVoteBatcher(multicallContract, bridgeContract, client, config)
+			evmVoter.SetBatchVoter(batcher)
+			services = append(services, batcher)
+		}
+		pauseAwareVoter := voter.NewPauseAwareVoter(
+			evmVoter,
+			bridgeContract,
+			messageQueue,
+			evmListener.SubscribeToBridgeEvents(util.Paused, util.Unpaused),
+			config,
+		)
+		pauseAwareVoter.SetAlerter(alerter)
+
+		bridges = append(bridges, &EVMBridge{
+			Address:    bridgeAddress,
+			StartBlock: bridgeConfig.StartBlock,
+			Listener:   evmListener,
+			Writer:     pauseAwareVoter,
+			Resources:  mh,
+		})
+		services = append(services, pauseAwareVoter, voteTracker, proposalSweeper)
+		voters = append(voters, evmVoter)
 	}
-	for _, erc721HandlerContract := range config.Erc721Handlers {
-		eventHandler.RegisterEventHandler(erc721HandlerContract, listener.Erc721EventHandler)
-		mh.RegisterMessageHandler(erc721HandlerContract, voter.ERC721MessageHandler)
+
+	evmChain := NewMultiBridgeEVMChain(bridges, store.NewBlockStore(db), config)
+	for _, s := range services {
+		evmChain.RegisterService(s)
 	}
-	for _, genericHandlerContract := range config.GenericHandlers {
-		eventHandler.RegisterEventHandler(genericHandlerContract, listener.GenericEventHandler)
-		mh.RegisterMessageHandler(genericHandlerContract, voter.GenericMessageHandler)
+	evmChain.depositSource = depositSource
+	evmChain.voters = voters
+	evmChain.executors = executors
+	evmChain.balanceMonitors = balanceMonitors
+	evmChain.gasSpend = gasSpend
+	evmChain.endpoints = client.Endpoints()
+	return evmChain, nil
+}
+
+// bridgeABIAdapter returns ABI adapter for configured bridge version
+// or detects version from code deployed at bridge address
+func bridgeABIAdapter(client calls.ContractChecker, bridgeAddress common.Address, version string) (bridge.ABIAdapter, error) {
+	if version != "" {
+		return bridge.GetABIAdapter(version)
 	}
+	return bridge.DetectABIAdapter(context.Background(), client, bridgeAddress)
+}
 
-	evmListener := listener.NewEVMListener(client, eventHandler, common.HexToAddress(config.Bridge))
-	var evmVoter *voter.EVMVoter
-	evmVoter, err = voter.NewVoterWithSubscription(mh, client, bridgeContract)
-	if err != nil {
-		log.Error().Msgf("failed creating voter with subscription: %s. Falling back to default voter.", err.Error())
-		evmVoter = voter.NewVoter(mh, client, bridgeContract)
+// NewEVMChain creates an EVMChain with a single bridge contract configured as config.Bridge
+func NewEVMChain(listener EventListener, writer ProposalVoter, blockstore *store.BlockStore, config *chain.EVMConfig) *EVMChain {
+	bridge := &EVMBridge{
+		Address:    common.HexToAddress(config.Bridge),
+		StartBlock: config.StartBlock,
+		Listener:   listener,
+		Writer:     writer,
 	}
+	return NewMultiBridgeEVMChain([]*EVMBridge{bridge}, blockstore, config)
+}
 
-	return NewEVMChain(evmListener, evmVoter, blockstore, config), nil
+// NewMultiBridgeEVMChain creates an EVMChain that listens to and writes to multiple bridge contracts
+func NewMultiBridgeEVMChain(bridges []*EVMBridge, blockstore *store.BlockStore, config *chain.EVMConfig) *EVMChain {
+	return &EVMChain{
+		bridges:         bridges,
+		blockstore:      blockstore,
+		config:          config,
+		resourceBridges: make(map[types.ResourceID]*EVMBridge),
+	}
 }
 
-func NewEVMChain(listener EventListener, writer ProposalVoter, blockstore *store.BlockStore, config *chain.EVMConfig) *EVMChain {
-	return &EVMChain{listener: listener, writer: writer, blockstore: blockstore, config: config}
+// RegisterService registers service that is started together with event polling
+func (c *EVMChain) RegisterService(s Service) {
+	c.services = append(c.services, s)
+}
+
+// DepositSource returns verifier of deposits made on the chain.
+// Returns nil if chain was not created with SetupDefaultEVMChain.
+func (c *EVMChain) DepositSource() *voter.DepositSource {
+	return c.depositSource
+}
+
+// SetMessageVerifier makes voters verify messages against their source chain before voting
+// if deposit verification is enabled in chain config
+func (c *EVMChain) SetMessageVerifier(verifier voter.MessageVerifier) {
+	if !c.config.VerifyDeposits {
+		return
+	}
+
+	for _, v := range c.voters {
+		v.SetMessageVerifier(verifier)
+	}
+}
+
+// SetDepositFetcher makes executors rebuild passed proposals missing from their
+// journal from deposits made on the source chain
+func (c *EVMChain) SetDepositFetcher(deposits executor.DepositFetcher) {
+	for _, e := range c.executors {
+		e.SetDepositFetcher(deposits)
+	}
+}
+
+// SetMetrics makes chain report relayer balance, fees spent and endpoint error rates to metrics.
+// Has no effect if chain was not created with SetupDefaultEVMChain.
+func (c *EVMChain) SetMetrics(metrics Metrics) {
+	for _, m := range c.balanceMonitors {
+		m.SetMetrics(metrics)
+	}
+	if c.gasSpend != nil {
+		c.gasSpend.SetMetrics(metrics)
+	}
+	if c.endpoints != nil {
+		c.endpoints.SetMetrics(metrics)
+	}
 }
 
 // PollEvents is the goroutine that polls blocks and searches Deposit events in them.
-// Events are then sent to eventsChan.
+// Each bridge is polled from its own last stored block. Events are then sent to eventsChan.
 func (c *EVMChain) PollEvents(stop <-chan struct{}, sysErr chan<- error, eventsChan chan *message.Message) {
 	log.Info().Msg("Polling Blocks...")
 
-	startBlock, err := c.blockstore.GetStartBlock(
-		*c.config.GeneralChainConfig.Id,
-		c.config.StartBlock,
-		c.config.GeneralChainConfig.LatestBlock,
-		c.config.GeneralChainConfig.FreshStart,
-	)
-	if err != nil {
-		sysErr <- fmt.Errorf("error %w on getting last stored block", err)
-		return
+	for _, s := range c.services {
+		go s.Start(stop)
+	}
+
+	for _, b := range c.bridges {
+		startBlock, err := c.blockstore.GetBridgeStartBlock(
+			*c.config.GeneralChainConfig.Id,
+			b.Address.Hex(),
+			b.StartBlock,
+			c.config.GeneralChainConfig.LatestBlock,
+			c.config.GeneralChainConfig.FreshStart,
+		)
+		if err != nil {
+			sysErr <- fmt.Errorf("error %w on getting last stored block of bridge %s", err, b.Address.Hex())
+			return
+		}
+
+		ech := b.Listener.ListenToEvents(startBlock, c.config.BlockConfirmations, c.config.BlockRetryInterval, *c.config.GeneralChainConfig.Id, c.blockstore, stop, sysErr)
+		go forwardEvents(ech, eventsChan, stop)
 	}
+	<-stop
+}
 
-	ech := c.listener.ListenToEvents(startBlock, c.config.BlockConfirmations, c.config.BlockRetryInterval, *c.config.GeneralChainConfig.Id, c.blockstore, stop, sysErr)
+func forwardEvents(ech <-chan *message.Message, eventsChan chan *message.Message, stop <-chan struct{}) {
 	for {
 		select {
 		case <-stop:
@@ -115,10 +369,102 @@ func (c *EVMChain) PollEvents(stop <-chan struct{}, sysErr chan<- error, eventsC
 	}
 }
 
+// Write votes for message on the bridge that handles message resourceID
 func (c *EVMChain) Write(msg *message.Message) error {
-	return c.writer.VoteProposal(msg, c.config)
+	b, err := c.bridgeForResource(msg.ResourceId)
+	if err != nil {
+		return err
+	}
+	return b.Writer.VoteProposal(msg, c.config)
+}
+
+// bridgeForResource returns bridge that handles resourceID. Bridges are resolved
+// on chain on first use of resourceID and cached afterwards.
+func (c *EVMChain) bridgeForResource(resourceID types.ResourceID) (*EVMBridge, error) {
+	if len(c.bridges) == 1 {
+		return c.bridges[0], nil
+	}
+
+	c.resourcesLock.Lock()
+	defer c.resourcesLock.Unlock()
+	if b, ok := c.resourceBridges[resourceID]; ok {
+		return b, nil
+	}
+
+	var matches []*EVMBridge
+	complete := true
+	for _, b := range c.bridges {
+		if b.Resources == nil {
+			matches = append(matches, b)
+			continue
+		}
+		ok, err := b.Resources.HandlesResource(resourceID)
+		if err != nil {
+			log.Warn().Err(err).Str("bridge", b.Address.Hex()).Msgf("Failed matching resourceID %x", resourceID)
+			complete = false
+			continue
+		}
+		if ok {
+			matches = append(matches, b)
+		}
+	}
+
+	switch {
+	case len(matches) == 0:
+		return nil, fmt.Errorf("no bridge on domain %d handles resourceID %x", c.DomainID(), resourceID)
+	case len(matches) > 1:
+		addresses := make([]string, len(matches))
+		for i, b := range matches {
+			addresses[i] = b.Address.Hex()
+		}
+		return nil, fmt.Errorf("resourceID %x is handled by multiple bridges on domain %d: %s", resourceID, c.DomainID(), strings.Join(addresses, ", "))
+	}
+	// bridges that failed matching could also handle resourceID so result is not cached
+	if complete {
+		c.resourceBridges[resourceID] = matches[0]
+	}
+	return matches[0], nil
 }
 
 func (c *EVMChain) DomainID() uint8 {
 	return *c.config.GeneralChainConfig.Id
 }
+
+// newGasPricer creates gas pricer of the type configured for the chain
+func newGasPricer(config *chain.EVMConfig, client *evmclient.EVMClient) calls.GasPricer {
+	opts := &evmgaspricer.GasPricerOpts{
+		UpperLimitFeePerGas: config.MaxGasPrice,
+		GasPriceFactor:      config.GasMultiplier,
+		MinTipCap:           config.MinGasTip,
+		FeeHistoryBlocks:    config.FeeHistoryBlocks,
+		RewardPercentile:    config.RewardPercentile,
+		InclusionBlocks:     config.InclusionBlocks,
+		Speed:               config.GasPriceSpeed,
+	}
+	log.Info().Uint8("domainID", *config.GeneralChainConfig.Id).Str("pricer", config.GasPricer).Str("speed", config.GasPriceSpeed).
+		Str("upperLimit", config.MaxGasPrice.String()).Str("multiplier", config.GasMultiplier.String()).
+		Str("minTip", config.MinGasTip.String()).Msg("Configured gas pricer")
+
+	switch config.GasPricer {
+	case chain.GasPricerStatic:
+		return evmgaspricer.NewStaticGasPriceDeterminant(client, opts)
+	case chain.GasPricerFeeHistory:
+		return evmgaspricer.NewFeeHistoryGasPriceDeterminant(client, opts)
+	case chain.GasPricerOracle:
+		var fallback calls.GasPricer = evmgaspricer.NewLondonGasPriceClient(client, opts)
+		if config.GasOracle.Fallback == chain.GasPricerStatic {
+			fallback = evmgaspricer.NewStaticGasPriceDeterminant(client, opts)
+		}
+		return evmgaspricer.NewOracleGasPriceDeterminant(evmgaspricer.OracleOpts{
+			URL:          config.GasOracle.URL,
+			GasPricePath: config.GasOracle.GasPricePath,
+			TipCapPath:   config.GasOracle.TipCapPath,
+			FeeCapPath:   config.GasOracle.FeeCapPath,
+			Unit:         config.GasOracle.Unit,
+			CacheTTL:     config.GasOracle.CacheTTL,
+			MaxDeviation: config.GasOracle.MaxDeviation,
+		}, fallback, opts)
+	default:
+		return evmgaspricer.NewLondonGasPriceClient(client, opts)
+	}
+}
@@ -32,7 +32,7 @@ var transferBaseCurrencyCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
-		t, err := initialize.InitializeTransactor(gasPrice, evmtransaction.NewTransaction, c, prepare)
+		t, err := initialize.InitializeTransactor(gasPrice, gasSpeed, evmtransaction.NewTransaction, c, prepare)
 		if err != nil {
 			return err
 		}
@@ -30,7 +30,7 @@ var getThresholdCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
-		t, err := initialize.InitializeTransactor(gasPrice, evmtransaction.NewTransaction, c, prepare)
+		t, err := initialize.InitializeTransactor(gasPrice, gasSpeed, evmtransaction.NewTransaction, c, prepare)
 		if err != nil {
 			return err
 		}
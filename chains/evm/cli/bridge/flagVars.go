@@ -42,6 +42,7 @@ var (
 	url           string
 	gasLimit      uint64
 	gasPrice      *big.Int
+	gasSpeed      string
 	senderKeyPair *secp256k1.Keypair
 	prepare       bool
 )
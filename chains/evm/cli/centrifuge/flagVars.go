@@ -23,6 +23,7 @@ var (
 var (
 	url           string
 	gasPrice      *big.Int
+	gasSpeed      string
 	senderKeyPair *secp256k1.Keypair
 	prepare       bool
 )
@@ -35,6 +35,7 @@ var (
 	UrlFlagName                = "url"
 	GasLimitFlagName           = "gas-limit"
 	GasPriceFlagName           = "gas-price"
+	GasSpeedFlagName           = "gas-speed"
 	NetworkIdFlagName          = "network"
 	PrivateKeyFlagName         = "private-key"
 	JsonWalletFlagName         = "json-wallet"
@@ -44,8 +45,9 @@ var (
 
 func BindEVMCLIFlags(evmRootCLI *cobra.Command) {
 	evmRootCLI.PersistentFlags().String(UrlFlagName, "ws://localhost:8545", "URL of the node to receive RPC calls")
-	evmRootCLI.PersistentFlags().Uint64(GasLimitFlagName, 6721975, "Gas limit to be used in transactions")
+	evmRootCLI.PersistentFlags().Uint64(GasLimitFlagName, 0, "Gas limit to be used in transactions, estimated if zero")
 	evmRootCLI.PersistentFlags().Uint64(GasPriceFlagName, 0, "Used as upperLimitGasPrice for transactions if not 0. Transactions gasPrice is defined by estimating it on network for pre London fork networks and by estimating BaseFee and MaxTipFeePerGas in post London networks")
+	evmRootCLI.PersistentFlags().String(GasSpeedFlagName, "", "Speed of transactions inclusion (slow, normal or fast). If set, fees are estimated from priority fees paid in recent blocks")
 	evmRootCLI.PersistentFlags().Uint64(NetworkIdFlagName, 0, "ID of the Network")
 	evmRootCLI.PersistentFlags().String(PrivateKeyFlagName, "", "Private key to use")
 	evmRootCLI.PersistentFlags().String(JsonWalletFlagName, "", "Encrypted JSON wallet")
@@ -55,6 +57,7 @@ func BindEVMCLIFlags(evmRootCLI *cobra.Command) {
 	_ = viper.BindPFlag(UrlFlagName, evmRootCLI.PersistentFlags().Lookup(UrlFlagName))
 	_ = viper.BindPFlag(GasLimitFlagName, evmRootCLI.PersistentFlags().Lookup(GasLimitFlagName))
 	_ = viper.BindPFlag(GasPriceFlagName, evmRootCLI.PersistentFlags().Lookup(GasPriceFlagName))
+	_ = viper.BindPFlag(GasSpeedFlagName, evmRootCLI.PersistentFlags().Lookup(GasSpeedFlagName))
 	_ = viper.BindPFlag(NetworkIdFlagName, evmRootCLI.PersistentFlags().Lookup(NetworkIdFlagName))
 	_ = viper.BindPFlag(PrivateKeyFlagName, evmRootCLI.PersistentFlags().Lookup(PrivateKeyFlagName))
 	_ = viper.BindPFlag(JsonWalletFlagName, evmRootCLI.PersistentFlags().Lookup(JsonWalletFlagName))
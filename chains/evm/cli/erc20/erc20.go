@@ -18,6 +18,10 @@ var ERC20Cmd = &cobra.Command{
 		if err != nil {
 			return fmt.Errorf("could not get global flags: %v", err)
 		}
+		gasSpeed, err = flags.GasSpeed(cmd)
+		if err != nil {
+			return fmt.Errorf("could not get gas speed flag: %v", err)
+		}
 		return nil
 	},
 }
@@ -17,6 +17,12 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// GasSpeed returns value of the gas speed flag. Empty speed means that
+// fees should not be estimated from priority fees paid in recent blocks.
+func GasSpeed(cmd *cobra.Command) (string, error) {
+	return cmd.Flags().GetString("gas-speed")
+}
+
 func GlobalFlagValues(cmd *cobra.Command) (string, uint64, *big.Int, *secp256k1.Keypair, bool, error) {
 	url, err := cmd.Flags().GetString("url")
 	if err != nil {
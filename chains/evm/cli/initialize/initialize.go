@@ -5,6 +5,7 @@ import (
 	"math/big"
 
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/consts"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
 	evmgaspricer "github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmgaspricer"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
@@ -29,9 +30,11 @@ func InitializeClient(
 
 // Initialize transactor which is used for contract calls
 // if --prepare flag value is set as true (from CLI) call data is outputted to stdout
-// which can be used for multisig contract calls
+// which can be used for multisig contract calls.
+// Fees are estimated from priority fees paid in recent blocks if gasSpeed is set.
 func InitializeTransactor(
 	gasPrice *big.Int,
+	gasSpeed string,
 	txFabric calls.TxFabric,
 	client *evmclient.EVMClient,
 	prepareFlag bool,
@@ -40,11 +43,23 @@ func InitializeTransactor(
 	if prepareFlag {
 		trans = prepare.NewPrepareTransactor()
 	} else {
-		gasPricer := evmgaspricer.NewLondonGasPriceClient(
-			client,
-			&evmgaspricer.GasPricerOpts{UpperLimitFeePerGas: gasPrice},
-		)
-		trans = signAndSend.NewSignAndSendTransactor(txFabric, gasPricer, client)
+		var gasPricer calls.GasPricer
+		gasPricerOpts := &evmgaspricer.GasPricerOpts{UpperLimitFeePerGas: gasPrice}
+		// gas speed switches to fee history gas pricer
+		if gasSpeed != "" {
+			if !evmgaspricer.IsValidSpeed(gasSpeed) {
+				return nil, fmt.Errorf("unknown gas speed %s", gasSpeed)
+			}
+			gasPricerOpts.Speed = gasSpeed
+			gasPricer = evmgaspricer.NewFeeHistoryGasPriceDeterminant(client, gasPricerOpts)
+		} else {
+			gasPricer = evmgaspricer.NewLondonGasPriceClient(client, gasPricerOpts)
+		}
+		trans = signAndSend.NewSignAndSendTransactorWithOpts(txFabric, gasPricer, client, signAndSend.TransactorOpts{
+			EstimateGas:        true,
+			GasLimitMultiplier: consts.DefaultGasLimitMultiplier,
+			MinGasLimit:        consts.DefaultMinGasLimit,
+		})
 	}
 
 	return trans, nil
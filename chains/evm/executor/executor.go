@@ -0,0 +1,215 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package executor
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/voter/proposal"
+	"github.com/ChainSafe/chainbridge-core/config/chain"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/util"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+)
+
+const maxExecuteTries = 3
+
+var (
+	// ExecuteRetryPeriod is the time executor waits before retrying failed execution
+	ExecuteRetryPeriod = 30 * time.Second
+)
+
+type BridgeContract interface {
+	GetProposal(source uint8, depositNonce uint64, dataHash common.Hash) (message.ProposalStatus, error)
+	ExecuteProposal(proposal *proposal.Proposal, opts transactor.TransactOptions) (*common.Hash, error)
+}
+
+type MessageHandler interface {
+	HandleMessage(m *message.Message) (*proposal.Proposal, error)
+}
+
+type Journal interface {
+	Proposal(source uint8, nonce uint64, dataHash common.Hash) (*proposal.Proposal, bool)
+}
+
+// DepositFetcher resolves deposits made on source domain into messages
+type DepositFetcher interface {
+	Deposits(source, destination uint8, nonce uint64) ([]*message.Message, error)
+}
+
+// Executor executes proposals that passed on the bridge. With relayer index election
+// each passed proposal has a single designated executor and other relayers execute it
+// only if it remains passed for executor timeout.
+type Executor struct {
+	bridgeContract BridgeContract
+	messageHandler MessageHandler
+	journal        Journal
+	deposits       DepositFetcher
+	events         <-chan *bridge.Event
+	config         *chain.EVMConfig
+	domainID       uint8
+
+	inProgress map[journalKey]bool
+	lock       sync.Mutex
+}
+
+// NewExecutor creates an instance of Executor. Events channel should receive
+// bridge ProposalEvent events from the destination chain listener.
+func NewExecutor(bridgeContract BridgeContract, messageHandler MessageHandler, journal Journal, events <-chan *bridge.Event, config *chain.EVMConfig) *Executor {
+	return &Executor{
+		bridgeContract: bridgeContract,
+		messageHandler: messageHandler,
+		journal:        journal,
+		events:         events,
+		config:         config,
+		domainID:       *config.GeneralChainConfig.Id,
+		inProgress:     make(map[journalKey]bool),
+	}
+}
+
+// SetDepositFetcher makes executor rebuild proposals missing from the journal
+// from deposits made on their source chain
+func (e *Executor) SetDepositFetcher(deposits DepositFetcher) {
+	e.deposits = deposits
+}
+
+// Start executes proposals on ProposalEvent events with passed status until stop channel is closed
+func (e *Executor) Start(stop <-chan struct{}) {
+	for {
+		select {
+		case <-stop:
+			return
+		case evt := <-e.events:
+			if evt.Sig != util.ProposalEvent {
+				continue
+			}
+			pe, ok := evt.Data.(*bridge.ProposalEvent)
+			if !ok || pe.Status != message.ProposalStatusPassed {
+				continue
+			}
+
+			key := journalKey{source: pe.OriginDomainID, nonce: pe.DepositNonce, dataHash: pe.DataHash}
+			if !e.start(key) {
+				continue
+			}
+			go func() {
+				defer e.finish(key)
+				e.execute(key, stop)
+			}()
+		}
+	}
+}
+
+// IsElected returns true if relayer is designated executor of proposal with deposit nonce
+func (e *Executor) IsElected(nonce uint64) bool {
+	if e.config.ExecutorElection != chain.ExecutorElectionRelayerIndex {
+		return true
+	}
+	return nonce%uint64(e.config.RelayerCount) == uint64(e.config.RelayerIndex)
+}
+
+func (e *Executor) start(key journalKey) bool {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.inProgress[key] {
+		return false
+	}
+	e.inProgress[key] = true
+	return true
+}
+
+func (e *Executor) finish(key journalKey) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	delete(e.inProgress, key)
+}
+
+// execute waits for executor timeout if relayer is not elected and executes proposal
+// while it is passed. Execution is retried if execution transaction fails.
+func (e *Executor) execute(key journalKey, stop <-chan struct{}) {
+	logger := log.With().Uint8("domainID", e.domainID).Uint8("src", key.source).Uint64("nonce", key.nonce).Str("dataHash", key.dataHash.Hex()).Logger()
+
+	if !e.IsElected(key.nonce) && !wait(e.config.ExecutorTimeout, stop) {
+		return
+	}
+
+	for i := 0; i < maxExecuteTries; i++ {
+		ps, err := e.bridgeContract.GetProposal(key.source, key.nonce, key.dataHash)
+		if err != nil {
+			logger.Error().Err(err).Msg("Failed reading proposal status")
+		} else if ps.Status != message.ProposalStatusPassed {
+			logger.Debug().Str("status", message.StatusMap[ps.Status]).Msg("Proposal no longer passed, skipping execution")
+			return
+		} else {
+			err = e.executeProposal(key)
+			if err == nil {
+				return
+			}
+			logger.Error().Err(err).Msg("Failed executing proposal")
+		}
+
+		if !wait(ExecuteRetryPeriod, stop) {
+			return
+		}
+	}
+}
+
+func (e *Executor) executeProposal(key journalKey) error {
+	prop, err := e.proposal(key)
+	if err != nil {
+		return err
+	}
+
+	hash, err := e.bridgeContract.ExecuteProposal(prop, transactor.TransactOptions{GasLimit: e.config.HandlerGasLimit(prop.HandlerAddress.Hex())})
+	if err != nil {
+		return err
+	}
+
+	log.Info().Uint8("domainID", e.domainID).Uint8("src", key.source).Uint64("nonce", key.nonce).Str("hash", hash.Hex()).Msg("Executed proposal")
+	return nil
+}
+
+// proposal returns proposal from the journal or rebuilds it from the deposit
+// made on the source chain
+func (e *Executor) proposal(key journalKey) (*proposal.Proposal, error) {
+	prop, ok := e.journal.Proposal(key.source, key.nonce, key.dataHash)
+	if ok {
+		return prop, nil
+	}
+	if e.deposits == nil {
+		return nil, fmt.Errorf("proposal with data hash %s not found in journal", key.dataHash.Hex())
+	}
+
+	messages, err := e.deposits.Deposits(key.source, e.domainID, key.nonce)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range messages {
+		prop, err := e.messageHandler.HandleMessage(m)
+		if err != nil {
+			log.Warn().Err(err).Uint8("src", key.source).Uint64("nonce", key.nonce).Msg("Failed converting deposit into proposal")
+			continue
+		}
+		if prop.GetDataHash() == key.dataHash {
+			return prop, nil
+		}
+	}
+	return nil, fmt.Errorf("no deposit from domain %d with nonce %d resolves to proposal with data hash %s", key.source, key.nonce, key.dataHash.Hex())
+}
+
+// wait blocks for duration d and returns false if stop channel was closed meanwhile
+func wait(d time.Duration, stop <-chan struct{}) bool {
+	select {
+	case <-stop:
+		return false
+	case <-time.After(d):
+		return true
+	}
+}
@@ -0,0 +1,276 @@
+package executor_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/executor"
+	mock_executor "github.com/ChainSafe/chainbridge-core/chains/evm/executor/mock"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/voter/proposal"
+	"github.com/ChainSafe/chainbridge-core/config/chain"
+	"github.com/ChainSafe/chainbridge-core/lvldb"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/util"
+	"github.com/ethereum/go-ethereum/common"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ExecutorTestSuite struct {
+	suite.Suite
+	mockBridgeContract *mock_executor.MockBridgeContract
+	mockMessageHandler *mock_executor.MockMessageHandler
+	mockJournal        *mock_executor.MockJournal
+	mockDeposits       *mock_executor.MockDepositFetcher
+	events             chan *bridge.Event
+	config             *chain.EVMConfig
+	proposal           *proposal.Proposal
+	stop               chan struct{}
+	done               chan struct{}
+}
+
+func TestRunExecutorTestSuite(t *testing.T) {
+	suite.Run(t, new(ExecutorTestSuite))
+}
+
+func (s *ExecutorTestSuite) SetupSuite()    {}
+func (s *ExecutorTestSuite) TearDownSuite() {}
+func (s *ExecutorTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.mockBridgeContract = mock_executor.NewMockBridgeContract(gomockController)
+	s.mockMessageHandler = mock_executor.NewMockMessageHandler(gomockController)
+	s.mockJournal = mock_executor.NewMockJournal(gomockController)
+	s.mockDeposits = mock_executor.NewMockDepositFetcher(gomockController)
+	s.events = make(chan *bridge.Event)
+	id := uint8(1)
+	s.config = &chain.EVMConfig{
+		GeneralChainConfig: chain.GeneralChainConfig{Id: &id},
+		GasLimit:           big.NewInt(1000),
+		ExecutorElection:   chain.ExecutorElectionAlways,
+		ExecutorTimeout:    time.Millisecond,
+	}
+	s.proposal = proposal.NewProposal(2, 1, 5, [32]byte{1}, []byte{1, 2, 3}, common.HexToAddress("0x1"), common.HexToAddress("0x2"), common.Hash{}, 0)
+	s.stop = make(chan struct{})
+	s.done = make(chan struct{})
+	executor.ExecuteRetryPeriod = 0
+}
+func (s *ExecutorTestSuite) TearDownTest() {
+	close(s.stop)
+}
+
+func (s *ExecutorTestSuite) start() {
+	e := executor.NewExecutor(s.mockBridgeContract, s.mockMessageHandler, s.mockJournal, s.events, s.config)
+	e.SetDepositFetcher(s.mockDeposits)
+	go e.Start(s.stop)
+}
+
+func (s *ExecutorTestSuite) passedEvent(p *proposal.Proposal, txHash common.Hash) *bridge.Event {
+	return &bridge.Event{
+		Sig: util.ProposalEvent,
+		Log: ethereumTypes.Log{TxHash: txHash},
+		Data: &bridge.ProposalEvent{
+			OriginDomainID: p.Source,
+			DepositNonce:   p.DepositNonce,
+			Status:         message.ProposalStatusPassed,
+			DataHash:       p.GetDataHash(),
+		},
+	}
+}
+
+func (s *ExecutorTestSuite) waitDone() {
+	select {
+	case <-s.done:
+	case <-time.After(5 * time.Second):
+		s.Fail("executor did not finish")
+	}
+}
+
+func (s *ExecutorTestSuite) TestExecute_ProposalFromJournal() {
+	s.mockJournal.EXPECT().Proposal(uint8(2), uint64(5), s.proposal.GetDataHash()).Return(s.proposal, true)
+	s.mockBridgeContract.EXPECT().GetProposal(uint8(2), uint64(5), s.proposal.GetDataHash()).Return(message.ProposalStatus{Status: message.ProposalStatusPassed}, nil)
+	s.mockBridgeContract.EXPECT().ExecuteProposal(s.proposal, gomock.Any()).DoAndReturn(func(p *proposal.Proposal, opts interface{}) (*common.Hash, error) {
+		close(s.done)
+		return &common.Hash{}, nil
+	})
+	s.start()
+
+	s.events <- &bridge.Event{Sig: util.ProposalEvent, Data: &bridge.ProposalEvent{Status: message.ProposalStatusActive}}
+	s.events <- &bridge.Event{Sig: util.ProposalVote, Data: &bridge.ProposalVote{Status: message.ProposalStatusPassed}}
+	s.events <- s.passedEvent(s.proposal, common.HexToHash("0xa"))
+
+	s.waitDone()
+}
+
+func (s *ExecutorTestSuite) TestExecute_ProposalFromSourceDeposit() {
+	otherDeposit := &message.Message{Source: 2, Destination: 1, DepositNonce: 5, ResourceId: [32]byte{2}}
+	deposit := &message.Message{Source: 2, Destination: 1, DepositNonce: 5, ResourceId: [32]byte{1}}
+	otherProposal := proposal.NewProposal(2, 1, 5, [32]byte{2}, []byte{4}, common.HexToAddress("0x3"), common.HexToAddress("0x2"), common.Hash{}, 0)
+	s.mockJournal.EXPECT().Proposal(uint8(2), uint64(5), s.proposal.GetDataHash()).Return(nil, false)
+	s.mockBridgeContract.EXPECT().GetProposal(uint8(2), uint64(5), s.proposal.GetDataHash()).Return(message.ProposalStatus{Status: message.ProposalStatusPassed}, nil)
+	s.mockDeposits.EXPECT().Deposits(uint8(2), uint8(1), uint64(5)).Return([]*message.Message{otherDeposit, deposit}, nil)
+	s.mockMessageHandler.EXPECT().HandleMessage(otherDeposit).Return(otherProposal, nil)
+	s.mockMessageHandler.EXPECT().HandleMessage(deposit).Return(s.proposal, nil)
+	s.mockBridgeContract.EXPECT().ExecuteProposal(s.proposal, gomock.Any()).DoAndReturn(func(p *proposal.Proposal, opts interface{}) (*common.Hash, error) {
+		close(s.done)
+		return &common.Hash{}, nil
+	})
+	s.start()
+
+	s.events <- s.passedEvent(s.proposal, common.HexToHash("0xa"))
+
+	s.waitDone()
+}
+
+func (s *ExecutorTestSuite) TestExecute_NoMatchingSourceDeposit() {
+	s.mockJournal.EXPECT().Proposal(uint8(2), uint64(5), s.proposal.GetDataHash()).Return(nil, false).Times(3)
+	s.mockBridgeContract.EXPECT().GetProposal(uint8(2), uint64(5), s.proposal.GetDataHash()).Return(message.ProposalStatus{Status: message.ProposalStatusPassed}, nil).Times(3)
+	gomock.InOrder(
+		s.mockDeposits.EXPECT().Deposits(uint8(2), uint8(1), uint64(5)).Return(nil, nil).Times(2),
+		s.mockDeposits.EXPECT().Deposits(uint8(2), uint8(1), uint64(5)).DoAndReturn(func(source, destination uint8, nonce uint64) ([]*message.Message, error) {
+			close(s.done)
+			return nil, errors.New("error")
+		}),
+	)
+	s.start()
+
+	s.events <- s.passedEvent(s.proposal, common.HexToHash("0xa"))
+
+	s.waitDone()
+}
+
+func (s *ExecutorTestSuite) TestExecute_NotElectedProposalExecutedMeanwhile() {
+	s.config.ExecutorElection = chain.ExecutorElectionRelayerIndex
+	s.config.RelayerIndex = 1
+	s.config.RelayerCount = 3
+	s.mockBridgeContract.EXPECT().GetProposal(uint8(2), uint64(5), s.proposal.GetDataHash()).DoAndReturn(func(source uint8, nonce uint64, dataHash common.Hash) (message.ProposalStatus, error) {
+		close(s.done)
+		return message.ProposalStatus{Status: message.ProposalStatusExecuted}, nil
+	})
+	s.start()
+
+	s.events <- s.passedEvent(s.proposal, common.HexToHash("0xa"))
+
+	s.waitDone()
+}
+
+func (s *ExecutorTestSuite) TestExecute_RetriesFailedExecution() {
+	s.mockJournal.EXPECT().Proposal(uint8(2), uint64(5), s.proposal.GetDataHash()).Return(s.proposal, true).Times(2)
+	s.mockBridgeContract.EXPECT().GetProposal(uint8(2), uint64(5), s.proposal.GetDataHash()).Return(message.ProposalStatus{Status: message.ProposalStatusPassed}, nil).Times(2)
+	gomock.InOrder(
+		s.mockBridgeContract.EXPECT().ExecuteProposal(s.proposal, gomock.Any()).Return(nil, errors.New("reverted")),
+		s.mockBridgeContract.EXPECT().ExecuteProposal(s.proposal, gomock.Any()).DoAndReturn(func(p *proposal.Proposal, opts interface{}) (*common.Hash, error) {
+			close(s.done)
+			return &common.Hash{}, nil
+		}),
+	)
+	s.start()
+
+	s.events <- s.passedEvent(s.proposal, common.HexToHash("0xa"))
+
+	s.waitDone()
+}
+
+func (s *ExecutorTestSuite) TestIsElected() {
+	e := executor.NewExecutor(s.mockBridgeContract, s.mockMessageHandler, s.mockJournal, s.events, s.config)
+	s.True(e.IsElected(4))
+
+	s.config.ExecutorElection = chain.ExecutorElectionRelayerIndex
+	s.config.RelayerIndex = 1
+	s.config.RelayerCount = 3
+	s.True(e.IsElected(4))
+	s.False(e.IsElected(5))
+}
+
+type ProposalJournalTestSuite struct {
+	suite.Suite
+	db      *lvldb.LVLDB
+	journal *executor.ProposalJournal
+}
+
+func TestRunProposalJournalTestSuite(t *testing.T) {
+	suite.Run(t, new(ProposalJournalTestSuite))
+}
+
+func (s *ProposalJournalTestSuite) SetupSuite()    {}
+func (s *ProposalJournalTestSuite) TearDownSuite() {}
+func (s *ProposalJournalTestSuite) SetupTest() {
+	db, err := lvldb.NewLvlDB(s.T().TempDir())
+	s.Nil(err)
+	s.db = db
+	s.journal = executor.NewProposalJournal(db, 1, executor.DefaultJournalTTL)
+}
+func (s *ProposalJournalTestSuite) TearDownTest() {
+	s.db.Close()
+}
+
+func (s *ProposalJournalTestSuite) TestProposal_Recorded() {
+	p := proposal.NewProposal(2, 1, 5, [32]byte{1}, []byte{1, 2, 3}, common.HexToAddress("0x1"), common.HexToAddress("0x2"), common.HexToHash("0xa"), 10)
+	s.journal.Record(p)
+
+	recorded, ok := s.journal.Proposal(2, 5, p.GetDataHash())
+	s.True(ok)
+	s.Equal(p, recorded)
+
+	_, ok = s.journal.Proposal(2, 5, common.HexToHash("0x1"))
+	s.False(ok)
+}
+
+func (s *ProposalJournalTestSuite) TestProposal_PersistedWithoutPayload() {
+	p := proposal.NewProposal(2, 1, 5, [32]byte{1}, []byte{1, 2, 3}, common.HexToAddress("0x1"), common.HexToAddress("0x2"), common.Hash{}, 0)
+	p.Payload = []interface{}{big.NewInt(1), []byte{1}}
+	s.journal.Record(p)
+
+	recorded, ok := executor.NewProposalJournal(s.db, 1, executor.DefaultJournalTTL).Proposal(2, 5, p.GetDataHash())
+	s.True(ok)
+	s.Nil(recorded.Payload)
+	s.Equal(p.Data, recorded.Data)
+	s.Equal(p.HandlerAddress, recorded.HandlerAddress)
+
+	_, ok = executor.NewProposalJournal(s.db, 2, executor.DefaultJournalTTL).Proposal(2, 5, p.GetDataHash())
+	s.False(ok)
+}
+
+func (s *ProposalJournalTestSuite) TestRecord_PrunesExpiredProposals() {
+	journal := executor.NewProposalJournal(s.db, 1, 0)
+	expired := proposal.NewProposal(2, 1, 5, [32]byte{1}, []byte{1}, common.HexToAddress("0x1"), common.HexToAddress("0x2"), common.Hash{}, 0)
+	journal.Record(expired)
+	time.Sleep(time.Millisecond)
+
+	p := proposal.NewProposal(2, 1, 6, [32]byte{1}, []byte{2}, common.HexToAddress("0x1"), common.HexToAddress("0x2"), common.Hash{}, 0)
+	journal.Record(p)
+
+	_, ok := journal.Proposal(2, 5, expired.GetDataHash())
+	s.False(ok)
+	_, ok = journal.Proposal(2, 6, p.GetDataHash())
+	s.True(ok)
+}
+
+func (s *ProposalJournalTestSuite) TestRecord_KeepsProposalRecordedAgain() {
+	journal := executor.NewProposalJournal(s.db, 1, 100*time.Millisecond)
+	p := proposal.NewProposal(2, 1, 5, [32]byte{1}, []byte{1}, common.HexToAddress("0x1"), common.HexToAddress("0x2"), common.Hash{}, 0)
+	journal.Record(p)
+	time.Sleep(60 * time.Millisecond)
+	journal.Record(p)
+	time.Sleep(60 * time.Millisecond)
+
+	journal.Record(proposal.NewProposal(2, 1, 6, [32]byte{1}, []byte{2}, common.HexToAddress("0x1"), common.HexToAddress("0x2"), common.Hash{}, 0))
+
+	_, ok := journal.Proposal(2, 5, p.GetDataHash())
+	s.True(ok)
+}
+
+func (s *ProposalJournalTestSuite) TestRecord_PrunesAcrossRestart() {
+	expired := proposal.NewProposal(2, 1, 5, [32]byte{1}, []byte{1}, common.HexToAddress("0x1"), common.HexToAddress("0x2"), common.Hash{}, 0)
+	executor.NewProposalJournal(s.db, 1, 0).Record(expired)
+	time.Sleep(time.Millisecond)
+
+	journal := executor.NewProposalJournal(s.db, 1, 0)
+	journal.Record(proposal.NewProposal(2, 1, 6, [32]byte{1}, []byte{2}, common.HexToAddress("0x1"), common.HexToAddress("0x2"), common.Hash{}, 0))
+
+	_, ok := journal.Proposal(2, 5, expired.GetDataHash())
+	s.False(ok)
+}
@@ -0,0 +1,219 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package executor
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/voter/proposal"
+	"github.com/ChainSafe/chainbridge-core/store"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+// DefaultJournalTTL is the time proposals are kept in the journal by default
+const DefaultJournalTTL = time.Hour * 24
+
+type journalKey struct {
+	source   uint8
+	nonce    uint64
+	dataHash common.Hash
+}
+
+func (k journalKey) String() string {
+	return fmt.Sprintf("%d:%d:%s", k.source, k.nonce, k.dataHash.Hex())
+}
+
+// journalEntry is a recorded proposal together with the time it was recorded
+type journalEntry struct {
+	Proposal proposal.Proposal
+	Recorded time.Time
+}
+
+// journalLogEntry is an entry of the append-only log of recorded proposal keys
+// that is used to find expired proposals without reading all of them
+type journalLogEntry struct {
+	Key      string
+	Recorded time.Time
+}
+
+// ProposalJournal persists proposals the relayer voted for so they can be executed
+// once passed, also after relayer restart. Keys of recorded proposals are appended to a
+// log in recording order so that expired proposals are pruned from its head.
+// It is safe for concurrent use.
+type ProposalJournal struct {
+	db        store.KeyValueReaderWriter
+	prefix    string
+	ttl       time.Duration
+	lastPrune time.Time
+	lock      sync.Mutex
+}
+
+// NewProposalJournal creates ProposalJournal of proposals voted for on domainID
+// that discards proposals older than ttl
+func NewProposalJournal(db store.KeyValueReaderWriter, domainID uint8, ttl time.Duration) *ProposalJournal {
+	return &ProposalJournal{
+		db:        db,
+		prefix:    fmt.Sprintf("chain:%d:journal", domainID),
+		ttl:       ttl,
+		lastPrune: time.Now(),
+	}
+}
+
+// Record stores proposal under its source, deposit nonce and data hash
+func (j *ProposalJournal) Record(p *proposal.Proposal) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	err := j.record(p)
+	if err != nil {
+		log.Error().Err(err).Uint8("src", p.Source).Uint64("nonce", p.DepositNonce).Msg("Failed recording proposal to journal")
+	}
+}
+
+// Proposal returns recorded proposal with provided source, deposit nonce and data hash
+func (j *ProposalJournal) Proposal(source uint8, nonce uint64, dataHash common.Hash) (*proposal.Proposal, bool) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	key := journalKey{source: source, nonce: nonce, dataHash: dataHash}
+	entry, err := j.entry(key.String())
+	if err != nil {
+		if !errors.Is(err, leveldb.ErrNotFound) {
+			log.Error().Err(err).Uint8("src", source).Uint64("nonce", nonce).Msg("Failed reading proposal from journal")
+		}
+		return nil, false
+	}
+	return &entry.Proposal, true
+}
+
+func (j *ProposalJournal) record(p *proposal.Proposal) error {
+	now := time.Now()
+	if now.Sub(j.lastPrune) >= j.ttl {
+		err := j.prune(now)
+		if err != nil {
+			return err
+		}
+		j.lastPrune = now
+	}
+
+	// payload is not needed for execution and holds interface values gob can not encode
+	entry := journalEntry{Proposal: *p, Recorded: now}
+	entry.Proposal.Payload = nil
+	key := journalKey{source: p.Source, nonce: p.DepositNonce, dataHash: p.GetDataHash()}.String()
+	err := j.set(j.proposalKey(key), &entry)
+	if err != nil {
+		return err
+	}
+
+	tail, err := j.getIndex("tail")
+	if err != nil {
+		return err
+	}
+	err = j.set(j.logKey(tail), &journalLogEntry{Key: key, Recorded: now})
+	if err != nil {
+		return err
+	}
+	return j.db.SetByKey(j.indexKey("tail"), tail.Add(tail, big.NewInt(1)).Bytes())
+}
+
+// prune removes proposals recorded more than ttl ago from the head of the log.
+// Proposal recorded again after the log entry was appended is kept.
+func (j *ProposalJournal) prune(now time.Time) error {
+	head, err := j.getIndex("head")
+	if err != nil {
+		return err
+	}
+	tail, err := j.getIndex("tail")
+	if err != nil {
+		return err
+	}
+
+	for ; head.Cmp(tail) < 0; head.Add(head, big.NewInt(1)) {
+		v, err := j.db.GetByKey(j.logKey(head))
+		if err != nil {
+			return err
+		}
+		var logEntry journalLogEntry
+		err = gob.NewDecoder(bytes.NewReader(v)).Decode(&logEntry)
+		if err != nil {
+			return err
+		}
+		if now.Sub(logEntry.Recorded) <= j.ttl {
+			break
+		}
+
+		entry, err := j.entry(logEntry.Key)
+		if err != nil && !errors.Is(err, leveldb.ErrNotFound) {
+			return err
+		}
+		if err == nil && now.Sub(entry.Recorded) > j.ttl {
+			err = j.db.DeleteByKey(j.proposalKey(logEntry.Key))
+			if err != nil {
+				return err
+			}
+		}
+		err = j.db.DeleteByKey(j.logKey(head))
+		if err != nil {
+			return err
+		}
+		err = j.db.SetByKey(j.indexKey("head"), big.NewInt(0).Add(head, big.NewInt(1)).Bytes())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (j *ProposalJournal) entry(key string) (*journalEntry, error) {
+	v, err := j.db.GetByKey(j.proposalKey(key))
+	if err != nil {
+		return nil, err
+	}
+	var entry journalEntry
+	err = gob.NewDecoder(bytes.NewReader(v)).Decode(&entry)
+	if err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+func (j *ProposalJournal) set(key []byte, value interface{}) error {
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(value)
+	if err != nil {
+		return err
+	}
+	return j.db.SetByKey(key, buf.Bytes())
+}
+
+func (j *ProposalJournal) getIndex(name string) (*big.Int, error) {
+	v, err := j.db.GetByKey(j.indexKey(name))
+	if err != nil {
+		if errors.Is(err, leveldb.ErrNotFound) {
+			return big.NewInt(0), nil
+		}
+		return nil, err
+	}
+	return big.NewInt(0).SetBytes(v), nil
+}
+
+func (j *ProposalJournal) indexKey(name string) []byte {
+	return []byte(fmt.Sprintf("%s:log:%s", j.prefix, name))
+}
+
+func (j *ProposalJournal) logKey(index *big.Int) []byte {
+	return []byte(fmt.Sprintf("%s:log:entry:%s", j.prefix, index.String()))
+}
+
+func (j *ProposalJournal) proposalKey(key string) []byte {
+	return []byte(fmt.Sprintf("%s:proposal:%s", j.prefix, key))
+}
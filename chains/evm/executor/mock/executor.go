@@ -0,0 +1,182 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: chains/evm/executor/executor.go
+
+// Package mock_executor is a generated GoMock package.
+package mock_executor
+
+import (
+	reflect "reflect"
+
+	transactor "github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	proposal "github.com/ChainSafe/chainbridge-core/chains/evm/voter/proposal"
+	message "github.com/ChainSafe/chainbridge-core/relayer/message"
+	common "github.com/ethereum/go-ethereum/common"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockBridgeContract is a mock of BridgeContract interface.
+type MockBridgeContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockBridgeContractMockRecorder
+}
+
+// MockBridgeContractMockRecorder is the mock recorder for MockBridgeContract.
+type MockBridgeContractMockRecorder struct {
+	mock *MockBridgeContract
+}
+
+// NewMockBridgeContract creates a new mock instance.
+func NewMockBridgeContract(ctrl *gomock.Controller) *MockBridgeContract {
+	mock := &MockBridgeContract{ctrl: ctrl}
+	mock.recorder = &MockBridgeContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBridgeContract) EXPECT() *MockBridgeContractMockRecorder {
+	return m.recorder
+}
+
+// ExecuteProposal mocks base method.
+func (m *MockBridgeContract) ExecuteProposal(proposal *proposal.Proposal, opts transactor.TransactOptions) (*common.Hash, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteProposal", proposal, opts)
+	ret0, _ := ret[0].(*common.Hash)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteProposal indicates an expected call of ExecuteProposal.
+func (mr *MockBridgeContractMockRecorder) ExecuteProposal(proposal, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteProposal", reflect.TypeOf((*MockBridgeContract)(nil).ExecuteProposal), proposal, opts)
+}
+
+// GetProposal mocks base method.
+func (m *MockBridgeContract) GetProposal(source uint8, depositNonce uint64, dataHash common.Hash) (message.ProposalStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProposal", source, depositNonce, dataHash)
+	ret0, _ := ret[0].(message.ProposalStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProposal indicates an expected call of GetProposal.
+func (mr *MockBridgeContractMockRecorder) GetProposal(source, depositNonce, dataHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProposal", reflect.TypeOf((*MockBridgeContract)(nil).GetProposal), source, depositNonce, dataHash)
+}
+
+// MockMessageHandler is a mock of MessageHandler interface.
+type MockMessageHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockMessageHandlerMockRecorder
+}
+
+// MockMessageHandlerMockRecorder is the mock recorder for MockMessageHandler.
+type MockMessageHandlerMockRecorder struct {
+	mock *MockMessageHandler
+}
+
+// NewMockMessageHandler creates a new mock instance.
+func NewMockMessageHandler(ctrl *gomock.Controller) *MockMessageHandler {
+	mock := &MockMessageHandler{ctrl: ctrl}
+	mock.recorder = &MockMessageHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMessageHandler) EXPECT() *MockMessageHandlerMockRecorder {
+	return m.recorder
+}
+
+// HandleMessage mocks base method.
+func (m_2 *MockMessageHandler) HandleMessage(m *message.Message) (*proposal.Proposal, error) {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "HandleMessage", m)
+	ret0, _ := ret[0].(*proposal.Proposal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HandleMessage indicates an expected call of HandleMessage.
+func (mr *MockMessageHandlerMockRecorder) HandleMessage(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleMessage", reflect.TypeOf((*MockMessageHandler)(nil).HandleMessage), m)
+}
+
+// MockJournal is a mock of Journal interface.
+type MockJournal struct {
+	ctrl     *gomock.Controller
+	recorder *MockJournalMockRecorder
+}
+
+// MockJournalMockRecorder is the mock recorder for MockJournal.
+type MockJournalMockRecorder struct {
+	mock *MockJournal
+}
+
+// NewMockJournal creates a new mock instance.
+func NewMockJournal(ctrl *gomock.Controller) *MockJournal {
+	mock := &MockJournal{ctrl: ctrl}
+	mock.recorder = &MockJournalMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJournal) EXPECT() *MockJournalMockRecorder {
+	return m.recorder
+}
+
+// Proposal mocks base method.
+func (m *MockJournal) Proposal(source uint8, nonce uint64, dataHash common.Hash) (*proposal.Proposal, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Proposal", source, nonce, dataHash)
+	ret0, _ := ret[0].(*proposal.Proposal)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Proposal indicates an expected call of Proposal.
+func (mr *MockJournalMockRecorder) Proposal(source, nonce, dataHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Proposal", reflect.TypeOf((*MockJournal)(nil).Proposal), source, nonce, dataHash)
+}
+
+// MockDepositFetcher is a mock of DepositFetcher interface.
+type MockDepositFetcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockDepositFetcherMockRecorder
+}
+
+// MockDepositFetcherMockRecorder is the mock recorder for MockDepositFetcher.
+type MockDepositFetcherMockRecorder struct {
+	mock *MockDepositFetcher
+}
+
+// NewMockDepositFetcher creates a new mock instance.
+func NewMockDepositFetcher(ctrl *gomock.Controller) *MockDepositFetcher {
+	mock := &MockDepositFetcher{ctrl: ctrl}
+	mock.recorder = &MockDepositFetcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDepositFetcher) EXPECT() *MockDepositFetcherMockRecorder {
+	return m.recorder
+}
+
+// Deposits mocks base method.
+func (m *MockDepositFetcher) Deposits(source, destination uint8, nonce uint64) ([]*message.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deposits", source, destination, nonce)
+	ret0, _ := ret[0].([]*message.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Deposits indicates an expected call of Deposits.
+func (mr *MockDepositFetcherMockRecorder) Deposits(source, destination, nonce interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deposits", reflect.TypeOf((*MockDepositFetcher)(nil).Deposits), source, destination, nonce)
+}
@@ -0,0 +1,81 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package listener
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/rs/zerolog/log"
+)
+
+// ErrMalformedCalldata is returned when deposit calldata does not match the expected layout
+var ErrMalformedCalldata = errors.New("malformed deposit calldata")
+
+// DepositDataDecoder sequentially reads 32 byte words and length prefixed
+// byte fields from deposit calldata, checking bounds on every read.
+type DepositDataDecoder struct {
+	data   []byte
+	offset int
+}
+
+func NewDepositDataDecoder(data []byte) *DepositDataDecoder {
+	return &DepositDataDecoder{
+		data: data,
+	}
+}
+
+// Word reads next 32 bytes of calldata
+func (d *DepositDataDecoder) Word(field string) ([]byte, error) {
+	return d.Bytes(field, 32)
+}
+
+// Length reads next 32 bytes as a big endian length of the following field.
+// Length can not be greater than the amount of calldata left after it.
+func (d *DepositDataDecoder) Length(field string) (int, error) {
+	word, err := d.Word(field + " length")
+	if err != nil {
+		return 0, err
+	}
+
+	length := big.NewInt(0).SetBytes(word)
+	if length.Cmp(big.NewInt(int64(d.Remaining()))) == 1 {
+		return 0, fmt.Errorf("%w: %s length %s at offset %d exceeds remaining %d bytes", ErrMalformedCalldata, field, length, d.offset-32, d.Remaining())
+	}
+	return int(length.Int64()), nil
+}
+
+// Bytes reads next n bytes of calldata
+func (d *DepositDataDecoder) Bytes(field string, n int) ([]byte, error) {
+	if n < 0 || n > d.Remaining() {
+		return nil, fmt.Errorf("%w: %s at offset %d needs %d bytes, only %d left", ErrMalformedCalldata, field, d.offset, n, d.Remaining())
+	}
+
+	b := d.data[d.offset : d.offset+n]
+	d.offset += n
+	return b, nil
+}
+
+// LengthPrefixedBytes reads a field prefixed with its 32 byte length
+func (d *DepositDataDecoder) LengthPrefixedBytes(field string) ([]byte, error) {
+	length, err := d.Length(field)
+	if err != nil {
+		return nil, err
+	}
+	return d.Bytes(field, length)
+}
+
+// Remaining returns the amount of calldata bytes that were not read yet
+func (d *DepositDataDecoder) Remaining() int {
+	return len(d.data) - d.offset
+}
+
+// End warns if whole calldata was not read. Trailing bytes are not an error as handlers
+// may append fields to deposit data that are not used by the relayer.
+func (d *DepositDataDecoder) End() {
+	if d.Remaining() != 0 {
+		log.Warn().Int("offset", d.offset).Int("trailing", d.Remaining()).Msg("Ignoring unexpected trailing bytes of deposit calldata")
+	}
+}
@@ -0,0 +1,84 @@
+package listener_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/deposit"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/listener"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/stretchr/testify/suite"
+)
+
+type DepositDataDecoderTestSuite struct {
+	suite.Suite
+}
+
+func TestRunDepositDataDecoderTestSuite(t *testing.T) {
+	suite.Run(t, new(DepositDataDecoderTestSuite))
+}
+
+func (s *DepositDataDecoderTestSuite) SetupSuite()    {}
+func (s *DepositDataDecoderTestSuite) TearDownSuite() {}
+func (s *DepositDataDecoderTestSuite) SetupTest()     {}
+func (s *DepositDataDecoderTestSuite) TearDownTest()  {}
+
+func (s *DepositDataDecoderTestSuite) TestWord_TruncatedData() {
+	d := listener.NewDepositDataDecoder([]byte{1, 2, 3})
+
+	_, err := d.Word("amount")
+
+	s.ErrorIs(err, listener.ErrMalformedCalldata)
+}
+
+func (s *DepositDataDecoderTestSuite) TestLengthPrefixedBytes_LengthExceedsData() {
+	var calldata []byte
+	calldata = append(calldata, math.PaddedBigBytes(big.NewInt(20), 32)...)
+	calldata = append(calldata, make([]byte, 10)...)
+	d := listener.NewDepositDataDecoder(calldata)
+
+	_, err := d.LengthPrefixedBytes("recipient")
+
+	s.ErrorIs(err, listener.ErrMalformedCalldata)
+}
+
+func (s *DepositDataDecoderTestSuite) TestLengthPrefixedBytes_LengthOverflowsInt() {
+	calldata := math.PaddedBigBytes(math.MaxBig256, 32)
+	d := listener.NewDepositDataDecoder(calldata)
+
+	_, err := d.LengthPrefixedBytes("recipient")
+
+	s.ErrorIs(err, listener.ErrMalformedCalldata)
+}
+
+func (s *DepositDataDecoderTestSuite) TestErc20EventHandler_TrailingBytesIgnored() {
+	recipient := common.HexToAddress("0xf1e58fb17704c2da8479a533f9fad4ad0993ca6b")
+	calldata := deposit.ConstructErc20DepositData(recipient.Bytes(), big.NewInt(2))
+	calldata = append(calldata, 1)
+
+	m, err := listener.Erc20EventHandler(1, 0, 1, [32]byte{0}, calldata, []byte{}, common.Hash{}, 0)
+
+	s.Nil(err)
+	s.Equal(recipient.Bytes(), m.Payload[1])
+}
+
+func (s *DepositDataDecoderTestSuite) TestErc721EventHandler_TruncatedMetadata() {
+	recipient := common.HexToAddress("0xf1e58fb17704c2da8479a533f9fad4ad0993ca6b")
+	calldata := deposit.ConstructErc721DepositData(recipient.Bytes(), big.NewInt(2), []byte("metadata.url"))
+
+	m, err := listener.Erc721EventHandler(1, 0, 1, [32]byte{0}, calldata[:len(calldata)-4], []byte{}, common.Hash{}, 0)
+
+	s.Nil(m)
+	s.ErrorIs(err, listener.ErrMalformedCalldata)
+}
+
+func (s *DepositDataDecoderTestSuite) TestErc721EventHandler_MissingMetadataLength() {
+	recipient := common.HexToAddress("0xf1e58fb17704c2da8479a533f9fad4ad0993ca6b")
+	calldata := deposit.ConstructErc721DepositData(recipient.Bytes(), big.NewInt(2), []byte{})
+
+	m, err := listener.Erc721EventHandler(1, 0, 1, [32]byte{0}, calldata[:84], []byte{}, common.Hash{}, 0)
+
+	s.Nil(m)
+	s.ErrorIs(err, listener.ErrMalformedCalldata)
+}
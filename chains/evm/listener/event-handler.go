@@ -6,7 +6,6 @@ import (
 	"github.com/ChainSafe/chainbridge-core/relayer/message"
 	"github.com/ChainSafe/chainbridge-core/types"
 	"github.com/rs/zerolog/log"
-	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -15,8 +14,9 @@ type EventHandlers map[common.Address]EventHandlerFunc
 type EventHandlerFunc func(sourceID, destId uint8, nonce uint64, resourceID types.ResourceID, calldata, handlerResponse []byte, depositTxHash common.Hash, depositBlock uint64) (*message.Message, error)
 
 type ETHEventHandler struct {
-	bridgeContract bridge.BridgeContract
-	eventHandlers  EventHandlers
+	bridgeContract   bridge.BridgeContract
+	eventHandlers    EventHandlers
+	handlerAddresses map[types.ResourceID]common.Address
 }
 
 // NewETHEventHandler creates an instance of ETHEventHandler that contains
@@ -28,9 +28,13 @@ func NewETHEventHandler(bridgeContract bridge.BridgeContract) *ETHEventHandler {
 }
 
 func (e *ETHEventHandler) HandleEvent(sourceID, destID uint8, depositNonce uint64, resourceID types.ResourceID, calldata, handlerResponse []byte, depositTxHash common.Hash, depositBlock uint64) (*message.Message, error) {
-	handlerAddr, err := e.bridgeContract.GetHandlerAddressForResourceID(resourceID)
-	if err != nil {
-		return nil, err
+	handlerAddr, ok := e.handlerAddresses[resourceID]
+	if !ok {
+		var err error
+		handlerAddr, err = e.bridgeContract.GetHandlerAddressForResourceID(resourceID)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	eventHandler, err := e.matchAddressWithHandlerFunc(handlerAddr)
@@ -41,6 +45,22 @@ func (e *ETHEventHandler) HandleEvent(sourceID, destID uint8, depositNonce uint6
 	return eventHandler(sourceID, destID, depositNonce, resourceID, calldata, handlerResponse, depositTxHash, depositBlock)
 }
 
+// PrefetchHandlers resolves handler addresses of resources in a single batch request. Prefetched
+// addresses replace previously prefetched ones and are used instead of querying the bridge for each event.
+func (e *ETHEventHandler) PrefetchHandlers(resourceIDs []types.ResourceID) error {
+	e.handlerAddresses = nil
+	addresses, err := e.bridgeContract.GetHandlerAddressesForResourceIDs(resourceIDs)
+	if err != nil {
+		return err
+	}
+
+	e.handlerAddresses = make(map[types.ResourceID]common.Address, len(resourceIDs))
+	for i, resourceID := range resourceIDs {
+		e.handlerAddresses[resourceID] = addresses[i]
+	}
+	return nil
+}
+
 // matchAddressWithHandlerFunc matches a handler address with an associated handler function
 func (e *ETHEventHandler) matchAddressWithHandlerFunc(handlerAddress common.Address) (EventHandlerFunc, error) {
 	hf, ok := e.eventHandlers[handlerAddress]
@@ -68,28 +88,26 @@ func (e *ETHEventHandler) RegisterEventHandler(handlerAddress string, handler Ev
 // Erc20EventHandler converts data pulled from event logs into message
 // handlerResponse can be an empty slice
 func Erc20EventHandler(sourceID, destId uint8, nonce uint64, resourceID types.ResourceID, calldata, handlerResponse []byte, depositTxHash common.Hash, depositBlock uint64) (*message.Message, error) {
-	if len(calldata) < 84 {
-		err := errors.New("invalid calldata length: less than 84 bytes")
-		return nil, err
-	}
-
 	// @dev
 	// amount: first 32 bytes of calldata
-	amount := calldata[:32]
+	// lenRecipientAddress: second 32 bytes of calldata [32:64]
+	// recipientAddress: lenRecipientAddress bytes after offset 64
+	d := NewDepositDataDecoder(calldata)
+	amount, err := d.Word("amount")
+	if err != nil {
+		return nil, err
+	}
+	recipientAddress, err := d.LengthPrefixedBytes("recipient")
+	if err != nil {
+		return nil, err
+	}
+	d.End()
 
 	if len(handlerResponse) == 32 {
 		// for fee handling ERC20Handler can return a new amount, which is the amount to transfer after fees
 		amount = handlerResponse[:32]
 	}
 
-	// lenRecipientAddress: second 32 bytes of calldata [32:64]
-	// does not need to be derived because it is being calculated
-	// within ERC20MessageHandler
-	// https://github.com/ChainSafe/chainbridge-core/blob/main/chains/evm/voter/message-handler.go#L108
-
-	// recipientAddress: last 20 bytes of calldata
-	recipientAddress := calldata[64:]
-
 	return &message.Message{
 		DepositTxHash: depositTxHash,
 		DepositBlock:  depositBlock,
@@ -107,13 +125,13 @@ func Erc20EventHandler(sourceID, destId uint8, nonce uint64, resourceID types.Re
 
 // GenericEventHandler converts data pulled from generic deposit event logs into message
 func GenericEventHandler(sourceID, destId uint8, nonce uint64, resourceID types.ResourceID, calldata, handlerResponse []byte, depositTxHash common.Hash, depositBlock uint64) (*message.Message, error) {
-	if len(calldata) < 32 {
-		err := errors.New("invalid calldata length: less than 32 bytes")
+	// first 32 bytes are metadata length followed by metadata
+	d := NewDepositDataDecoder(calldata)
+	metadata, err := d.LengthPrefixedBytes("metadata")
+	if err != nil {
 		return nil, err
 	}
-
-	// first 32 bytes are metadata length
-	metadata := calldata[32:]
+	d.End()
 
 	return &message.Message{
 		DepositTxHash: depositTxHash,
@@ -131,31 +149,30 @@ func GenericEventHandler(sourceID, destId uint8, nonce uint64, resourceID types.
 
 // Erc721EventHandler converts data pulled from ERC721 deposit event logs into message
 func Erc721EventHandler(sourceID, destId uint8, nonce uint64, resourceID types.ResourceID, calldata, handlerResponse []byte, depositTxHash common.Hash, depositBlock uint64) (*message.Message, error) {
-	if len(calldata) < 64 {
-		err := errors.New("invalid calldata length: less than 84 bytes")
+	// tokenId: first 32 bytes of calldata
+	// recipientAddress: prefixed with 32 bytes of its length
+	// metadata: prefixed with 32 bytes of its length
+	d := NewDepositDataDecoder(calldata)
+	tokenId, err := d.Word("tokenId")
+	if err != nil {
+		return nil, err
+	}
+	recipientAddress, err := d.LengthPrefixedBytes("recipient")
+	if err != nil {
 		return nil, err
 	}
-
-	// first 32 bytes are tokenId
-	tokenId := calldata[:32]
-
-	// 32 - 64 is recipient address length
-	recipientAddressLength := big.NewInt(0).SetBytes(calldata[32:64])
-
-	// 64 - (64 + recipient address length) is recipient address
-	recipientAddress := calldata[64:(64 + recipientAddressLength.Int64())]
-
-	// (64 + recipient address length) - ((64 + recipient address length) + 32) is metadata length
-	medataLength := big.NewInt(0).SetBytes(
-		calldata[(64 + recipientAddressLength.Int64()):((64 + recipientAddressLength.Int64()) + 32)],
-	)
-
-	// ((64 + recipient address length) + 32) - ((64 + recipient address length) + 32 + metadata length) is metadata
 	var metadata []byte
-	if medataLength.Cmp(big.NewInt(0)) == 1 {
-		metadataStart := (64 + recipientAddressLength.Int64()) + 32
-		metadata = calldata[metadataStart : metadataStart+medataLength.Int64()]
+	metadataLength, err := d.Length("metadata")
+	if err != nil {
+		return nil, err
+	}
+	if metadataLength > 0 {
+		metadata, err = d.Bytes("metadata", metadataLength)
+		if err != nil {
+			return nil, err
+		}
 	}
+	d.End()
 
 	return &message.Message{
 		DepositTxHash: depositTxHash,
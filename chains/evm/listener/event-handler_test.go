@@ -2,20 +2,22 @@ package listener_test
 
 import (
 	"errors"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/deposit"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	mock_calls "github.com/ChainSafe/chainbridge-core/chains/evm/calls/mock"
 	"math/big"
 	"testing"
 
 	"github.com/ChainSafe/chainbridge-core/chains/evm/listener"
 	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 )
 
-var errIncorrectDataLen = errors.New("invalid calldata length: less than 84 bytes")
-
 type Erc20HandlerTestSuite struct {
 	suite.Suite
 }
@@ -59,7 +61,7 @@ func (s *Erc20HandlerTestSuite) TestErc20HandleEvent() {
 		},
 	}
 
-	message, err := listener.Erc20EventHandler(sourceID, depositLog.DestinationDomainID, depositLog.DepositNonce, depositLog.ResourceID, depositLog.Data, depositLog.HandlerResponse)
+	message, err := listener.Erc20EventHandler(sourceID, depositLog.DestinationDomainID, depositLog.DepositNonce, depositLog.ResourceID, depositLog.Data, depositLog.HandlerResponse, common.Hash{}, 0)
 
 	s.Nil(err)
 	s.NotNil(message)
@@ -84,10 +86,10 @@ func (s *Erc20HandlerTestSuite) TestErc20HandleEventIncorrectDataLen() {
 
 	sourceID := uint8(1)
 
-	message, err := listener.Erc20EventHandler(sourceID, depositLog.DestinationDomainID, depositLog.DepositNonce, depositLog.ResourceID, depositLog.Data, depositLog.HandlerResponse)
+	message, err := listener.Erc20EventHandler(sourceID, depositLog.DestinationDomainID, depositLog.DepositNonce, depositLog.ResourceID, depositLog.Data, depositLog.HandlerResponse, common.Hash{}, 0)
 
 	s.Nil(message)
-	s.EqualError(err, errIncorrectDataLen.Error())
+	s.ErrorIs(err, listener.ErrMalformedCalldata)
 }
 
 type Erc721HandlerTestSuite struct {
@@ -133,7 +135,7 @@ func (s *Erc721HandlerTestSuite) TestErc721EventHandlerEmptyMetadata() {
 		},
 	}
 
-	m, err := listener.Erc721EventHandler(sourceID, depositLog.DestinationDomainID, depositLog.DepositNonce, depositLog.ResourceID, depositLog.Data, depositLog.HandlerResponse)
+	m, err := listener.Erc721EventHandler(sourceID, depositLog.DestinationDomainID, depositLog.DepositNonce, depositLog.ResourceID, depositLog.Data, depositLog.HandlerResponse, common.Hash{}, 0)
 	s.Nil(err)
 	s.NotNil(m)
 	s.Equal(expected, m)
@@ -157,9 +159,9 @@ func (s *Erc721HandlerTestSuite) TestErc721EventHandlerIncorrectDataLen() {
 
 	sourceID := uint8(1)
 
-	m, err := listener.Erc721EventHandler(sourceID, depositLog.DestinationDomainID, depositLog.DepositNonce, depositLog.ResourceID, depositLog.Data, depositLog.HandlerResponse)
+	m, err := listener.Erc721EventHandler(sourceID, depositLog.DestinationDomainID, depositLog.DepositNonce, depositLog.ResourceID, depositLog.Data, depositLog.HandlerResponse, common.Hash{}, 0)
 	s.Nil(m)
-	s.EqualError(err, "invalid calldata length: less than 84 bytes")
+	s.ErrorIs(err, listener.ErrMalformedCalldata)
 }
 
 func (s *Erc721HandlerTestSuite) TestErc721EventHandler() {
@@ -193,7 +195,7 @@ func (s *Erc721HandlerTestSuite) TestErc721EventHandler() {
 		},
 	}
 
-	m, err := listener.Erc721EventHandler(sourceID, depositLog.DestinationDomainID, depositLog.DepositNonce, depositLog.ResourceID, depositLog.Data, depositLog.HandlerResponse)
+	m, err := listener.Erc721EventHandler(sourceID, depositLog.DestinationDomainID, depositLog.DepositNonce, depositLog.ResourceID, depositLog.Data, depositLog.HandlerResponse, common.Hash{}, 0)
 	s.Nil(err)
 	s.NotNil(m)
 	s.Equal(expected, m)
@@ -236,10 +238,12 @@ func (s *GenericHandlerTestSuite) TestGenericHandleEventIncorrectDataLen() {
 		depositLog.ResourceID,
 		depositLog.Data,
 		depositLog.HandlerResponse,
+		common.Hash{},
+		0,
 	)
 
 	s.Nil(message)
-	s.EqualError(err, "invalid calldata length: less than 32 bytes")
+	s.ErrorIs(err, listener.ErrMalformedCalldata)
 }
 
 func (s *GenericHandlerTestSuite) TestGenericHandleEventEmptyMetadata() {
@@ -274,6 +278,8 @@ func (s *GenericHandlerTestSuite) TestGenericHandleEventEmptyMetadata() {
 		depositLog.ResourceID,
 		depositLog.Data,
 		depositLog.HandlerResponse,
+		common.Hash{},
+		0,
 	)
 
 	s.Nil(err)
@@ -313,9 +319,68 @@ func (s *GenericHandlerTestSuite) TestGenericHandleEvent() {
 		depositLog.ResourceID,
 		depositLog.Data,
 		depositLog.HandlerResponse,
+		common.Hash{},
+		0,
 	)
 
 	s.Nil(err)
 	s.NotNil(message)
 	s.Equal(message, expected)
 }
+
+type ETHEventHandlerTestSuite struct {
+	suite.Suite
+	mockContractCaller *mock_calls.MockContractCallerDispatcher
+	eventHandler       *listener.ETHEventHandler
+	handled            []common.Address
+}
+
+func TestRunETHEventHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(ETHEventHandlerTestSuite))
+}
+
+func (s *ETHEventHandlerTestSuite) SetupSuite()    {}
+func (s *ETHEventHandlerTestSuite) TearDownSuite() {}
+func (s *ETHEventHandlerTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.mockContractCaller = mock_calls.NewMockContractCallerDispatcher(gomockController)
+	s.mockContractCaller.EXPECT().From().Return(common.Address{}).AnyTimes()
+	s.eventHandler = listener.NewETHEventHandler(*bridge.NewBridgeContract(s.mockContractCaller, common.HexToAddress("0x1"), nil))
+	s.handled = nil
+	for _, handler := range []string{"0x2", "0x3"} {
+		handlerAddress := common.HexToAddress(handler)
+		s.eventHandler.RegisterEventHandler(handler, func(sourceID, destId uint8, nonce uint64, resourceID types.ResourceID, calldata, handlerResponse []byte, depositTxHash common.Hash, depositBlock uint64) (*message.Message, error) {
+			s.handled = append(s.handled, handlerAddress)
+			return &message.Message{}, nil
+		})
+	}
+}
+func (s *ETHEventHandlerTestSuite) TearDownTest() {}
+
+func (s *ETHEventHandlerTestSuite) TestHandleEvent_UsesPrefetchedHandlers() {
+	s.mockContractCaller.EXPECT().BatchCallContract(gomock.Any(), gomock.Any(), nil).Return([][]byte{
+		common.LeftPadBytes([]byte{2}, 32),
+		common.LeftPadBytes([]byte{3}, 32),
+	}, nil)
+
+	err := s.eventHandler.PrefetchHandlers([]types.ResourceID{{1}, {2}})
+	s.Nil(err)
+	_, err = s.eventHandler.HandleEvent(1, 2, 1, types.ResourceID{2}, []byte{}, []byte{}, common.Hash{}, 0)
+	s.Nil(err)
+	_, err = s.eventHandler.HandleEvent(1, 2, 2, types.ResourceID{1}, []byte{}, []byte{}, common.Hash{}, 0)
+	s.Nil(err)
+
+	s.Equal([]common.Address{common.HexToAddress("0x3"), common.HexToAddress("0x2")}, s.handled)
+}
+
+func (s *ETHEventHandlerTestSuite) TestHandleEvent_QueriesHandlerIfNotPrefetched() {
+	s.mockContractCaller.EXPECT().BatchCallContract(gomock.Any(), gomock.Any(), nil).Return(nil, errors.New("error"))
+	s.mockContractCaller.EXPECT().CallContract(gomock.Any(), gomock.Any(), nil).Return(common.LeftPadBytes([]byte{2}, 32), nil)
+
+	err := s.eventHandler.PrefetchHandlers([]types.ResourceID{{1}})
+	s.NotNil(err)
+	_, err = s.eventHandler.HandleEvent(1, 2, 1, types.ResourceID{1}, []byte{}, []byte{}, common.Hash{}, 0)
+	s.Nil(err)
+
+	s.Equal([]common.Address{common.HexToAddress("0x2")}, s.handled)
+}
@@ -5,38 +5,90 @@ package listener
 
 import (
 	"context"
+	"fmt"
 	"math/big"
+	"sync"
 	"time"
 
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	"github.com/ChainSafe/chainbridge-core/util"
 
 	"github.com/ChainSafe/chainbridge-core/relayer/message"
 	"github.com/ChainSafe/chainbridge-core/store"
 	"github.com/ChainSafe/chainbridge-core/types"
 	"github.com/ethereum/go-ethereum/common"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/rs/zerolog/log"
 )
 
+// bridgeEventsBufferSize is the amount of decoded bridge events that can be waiting
+// in a subscription channel before listener blocks
+const bridgeEventsBufferSize = 100
+
 type EventHandler interface {
 	HandleEvent(sourceID, destID uint8, nonce uint64, resourceID types.ResourceID, calldata, handlerResponse []byte, depositTxHash common.Hash, depositBlock uint64) (*message.Message, error)
 }
+
+// HandlerPrefetcher is implemented by event handlers that can resolve handlers of all deposits
+// from a block range in a single request before deposits are handled one by one
+type HandlerPrefetcher interface {
+	PrefetchHandlers(resourceIDs []types.ResourceID) error
+}
+
 type ChainClient interface {
 	LatestBlock() (*big.Int, error)
-	FetchDepositLogs(ctx context.Context, address common.Address, startBlock *big.Int, endBlock *big.Int) ([]*evmclient.DepositLogsEnriched, error)
+	FetchEventLogs(ctx context.Context, contractAddress common.Address, event string, startBlock *big.Int, endBlock *big.Int) ([]ethereumTypes.Log, error)
+	FetchMultipleEventLogs(ctx context.Context, contractAddress common.Address, events []string, startBlock *big.Int, endBlock *big.Int) ([]ethereumTypes.Log, error)
 	CallContract(ctx context.Context, callArgs map[string]interface{}, blockNumber *big.Int) ([]byte, error)
 }
 
+type bridgeEventSubscription struct {
+	events map[util.EventSig]bool
+	ch     chan *bridge.Event
+}
+
 type EVMListener struct {
 	chainReader   ChainClient
 	eventHandler  EventHandler
 	bridgeAddress common.Address
+	adapter       bridge.ABIAdapter
+
+	subscriptions     []*bridgeEventSubscription
+	subscriptionsLock sync.RWMutex
 }
 
 // NewEVMListener creates an EVMListener that listens to deposit events on chain
 // and calls event handler when one occurs
 func NewEVMListener(chainReader ChainClient, handler EventHandler, bridgeAddress common.Address) *EVMListener {
-	return &EVMListener{chainReader: chainReader, eventHandler: handler, bridgeAddress: bridgeAddress}
+	return NewVersionedEVMListener(chainReader, handler, bridgeAddress, bridge.DefaultABIAdapter())
+}
+
+// NewVersionedEVMListener creates an EVMListener that decodes bridge events
+// with ABI adapter of the bridge version deployed at bridgeAddress
+func NewVersionedEVMListener(chainReader ChainClient, handler EventHandler, bridgeAddress common.Address, adapter bridge.ABIAdapter) *EVMListener {
+	return &EVMListener{chainReader: chainReader, eventHandler: handler, bridgeAddress: bridgeAddress, adapter: adapter}
+}
+
+// SubscribeToBridgeEvents returns a channel that receives decoded bridge events
+// with provided signatures as blocks are processed by the listener.
+// Events are sent after deposits from the same block range were handled.
+// Listener blocks if channel buffer is full, so subscribers must receive events continuously and
+// never block on work that waits for the listener, e.g. on further blocks being processed.
+func (l *EVMListener) SubscribeToBridgeEvents(events ...util.EventSig) <-chan *bridge.Event {
+	sub := &bridgeEventSubscription{
+		events: make(map[util.EventSig]bool),
+		ch:     make(chan *bridge.Event, bridgeEventsBufferSize),
+	}
+	for _, e := range events {
+		sub.events[e] = true
+	}
+
+	l.subscriptionsLock.Lock()
+	defer l.subscriptionsLock.Unlock()
+	l.subscriptions = append(l.subscriptions, sub)
+	return sub.ch
 }
 
 func (l *EVMListener) ListenToEvents(
@@ -77,16 +129,25 @@ func (l *EVMListener) ListenToEvents(
 					endBlock.Add(startBlock, big.NewInt(99))
 				}
 
-				logs, err := l.chainReader.FetchDepositLogs(context.Background(), l.bridgeAddress, startBlock, endBlock)
+				logs, err := l.fetchDepositLogs(startBlock, endBlock)
 				if err != nil {
 					// Filtering logs error really can appear only on wrong configuration or temporary network problem
 					// so i do no see any reason to break execution
 					log.Error().Err(err).Str("DomainID", string(domainID)).Msgf("Unable to filter logs")
 					continue
 				}
+				// bridge events are fetched before deposits are handled so that the same block range
+				// is queried again if fetching fails without resending its deposits
+				bridgeLogs, err := l.fetchBridgeEventLogs(startBlock, endBlock)
+				if err != nil {
+					log.Error().Err(err).Uint8("domainID", domainID).Msgf("Unable to filter bridge event logs")
+					time.Sleep(blockRetryInterval)
+					continue
+				}
+				l.prefetchHandlers(logs, domainID)
 				for _, eventLog := range logs {
 					log.Debug().Msgf("Deposit log found from sender: %s in block: %v with  destinationDomainId: %v, resourceID: %X, depositNonce: %v", eventLog.SenderAddress, eventLog.DepositBlock, eventLog.DestinationDomainID, eventLog.ResourceID[:], eventLog.DepositNonce)
-					m, err := l.eventHandler.HandleEvent(domainID, eventLog.DestinationDomainID, eventLog.DepositNonce, eventLog.ResourceID, eventLog.Data, eventLog.HandlerResponse, eventLog.DepositTxHash, eventLog.DepositBlock)
+					m, err := l.handleDeposit(domainID, eventLog)
 					if err != nil {
 						log.Error().Str("startBlock", startBlock.String()).Str("endBlock", endBlock.String()).Uint8("domainID", domainID).Msgf("%v", err)
 					} else {
@@ -94,13 +155,14 @@ func (l *EVMListener) ListenToEvents(
 						ch <- m
 					}
 				}
+				l.publishBridgeEvents(bridgeLogs, domainID, stopChn)
 				if startBlock.Int64()%20 == 0 || startBlock.Int64()/20 != endBlock.Int64()/20 {
 					// Logging process every 20 bocks to exclude spam
 					log.Debug().Int64("block", endBlock.Int64()/20*20).Uint8("domainID", domainID).Msg("Queried block for deposit events")
 				}
 				// TODO: We can store blocks to DB inside listener or make listener send something to channel each block to save it.
 				//Write to block store. Not a critical operation, no need to retry
-				err = blockstore.StoreBlock(endBlock, domainID)
+				err = blockstore.StoreBridgeBlock(endBlock, domainID, l.bridgeAddress.Hex())
 				if err != nil {
 					log.Error().Str("block", endBlock.String()).Err(err).Msg("Failed to write latest block to blockstore")
 				}
@@ -111,3 +173,123 @@ func (l *EVMListener) ListenToEvents(
 	}()
 	return ch
 }
+
+// subscribedEvents returns signatures of all events that have at least one subscriber
+func (l *EVMListener) subscribedEvents() []string {
+	l.subscriptionsLock.RLock()
+	defer l.subscriptionsLock.RUnlock()
+
+	var events []string
+	for _, e := range bridge.Events {
+		for _, sub := range l.subscriptions {
+			if sub.events[e] {
+				events = append(events, string(l.adapter.EventSig(e)))
+				break
+			}
+		}
+	}
+	return events
+}
+
+// fetchBridgeEventLogs fetches logs of bridge events that have subscribers from the provided block range
+func (l *EVMListener) fetchBridgeEventLogs(startBlock, endBlock *big.Int) ([]ethereumTypes.Log, error) {
+	events := l.subscribedEvents()
+	if len(events) == 0 {
+		return nil, nil
+	}
+
+	return l.chainReader.FetchMultipleEventLogs(context.Background(), l.bridgeAddress, events, startBlock, endBlock)
+}
+
+// publishBridgeEvents decodes bridge event logs and sends them to subscriptions interested in them
+func (l *EVMListener) publishBridgeEvents(logs []ethereumTypes.Log, domainID uint8, stopChn <-chan struct{}) {
+	l.subscriptionsLock.RLock()
+	subscriptions := make([]*bridgeEventSubscription, len(l.subscriptions))
+	copy(subscriptions, l.subscriptions)
+	l.subscriptionsLock.RUnlock()
+
+	for _, eventLog := range logs {
+		if eventLog.Removed {
+			continue
+		}
+
+		evt, err := l.adapter.UnpackEventLog(eventLog)
+		if err != nil {
+			log.Error().Err(err).Uint8("domainID", domainID).Str("txHash", eventLog.TxHash.Hex()).Msgf("Failed unpacking bridge event log")
+			continue
+		}
+
+		log.Debug().Uint8("domainID", domainID).Uint64("block", eventLog.BlockNumber).Msgf("Bridge event %s found", evt.Sig)
+		for _, sub := range subscriptions {
+			if !sub.events[evt.Sig] {
+				continue
+			}
+			select {
+			case sub.ch <- evt:
+			case <-stopChn:
+				return
+			}
+		}
+	}
+}
+
+// fetchDepositLogs fetches deposit logs of the bridge and decodes them with bridge ABI adapter.
+// Logs that can not be decoded are skipped.
+func (l *EVMListener) fetchDepositLogs(startBlock *big.Int, endBlock *big.Int) ([]*evmclient.DepositLogsEnriched, error) {
+	logs, err := l.chainReader.FetchEventLogs(context.Background(), l.bridgeAddress, string(l.adapter.DepositEvent()), startBlock, endBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	depositLogs := make([]*evmclient.DepositLogsEnriched, 0)
+	for _, eventLog := range logs {
+		dl, err := l.adapter.UnpackDepositLog(eventLog)
+		if err != nil {
+			log.Error().Msgf("failed unpacking deposit event log: %v", err)
+			continue
+		}
+		depositLogs = append(depositLogs, &evmclient.DepositLogsEnriched{
+			DepositLogs:   *dl,
+			DepositTxHash: eventLog.TxHash,
+			DepositBlock:  eventLog.BlockNumber,
+		})
+	}
+	return depositLogs, nil
+}
+
+// prefetchHandlers resolves handlers of deposit resources in a single request if event handler supports it.
+// Event handler falls back to resolving handlers for each deposit if prefetching fails.
+func (l *EVMListener) prefetchHandlers(logs []*evmclient.DepositLogsEnriched, domainID uint8) {
+	prefetcher, ok := l.eventHandler.(HandlerPrefetcher)
+	if !ok || len(logs) == 0 {
+		return
+	}
+
+	seen := make(map[types.ResourceID]bool)
+	resourceIDs := make([]types.ResourceID, 0)
+	for _, eventLog := range logs {
+		if seen[eventLog.ResourceID] {
+			continue
+		}
+		seen[eventLog.ResourceID] = true
+		resourceIDs = append(resourceIDs, eventLog.ResourceID)
+	}
+
+	err := prefetcher.PrefetchHandlers(resourceIDs)
+	if err != nil {
+		log.Warn().Err(err).Uint8("domainID", domainID).Msg("Failed prefetching deposit handlers")
+	}
+}
+
+// handleDeposit converts deposit log into message. Panic in event handler is recovered
+// and returned as error so a malformed deposit can not stop the listener.
+func (l *EVMListener) handleDeposit(domainID uint8, eventLog *evmclient.DepositLogsEnriched) (m *message.Message, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			m = nil
+			err = fmt.Errorf("recovered from panic while handling deposit %d in tx %s: %v", eventLog.DepositNonce, eventLog.DepositTxHash.Hex(), r)
+		}
+	}()
+
+	return l.eventHandler.HandleEvent(domainID, eventLog.DestinationDomainID, eventLog.DepositNonce, eventLog.ResourceID, eventLog.Data, eventLog.HandlerResponse, eventLog.DepositTxHash, eventLog.DepositBlock)
+}
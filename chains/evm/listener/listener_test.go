@@ -2,9 +2,19 @@ package listener_test
 
 import (
 	"errors"
-	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
 	"math/big"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/consts"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	"github.com/ChainSafe/chainbridge-core/store"
+	mock_store "github.com/ChainSafe/chainbridge-core/store/mock"
+	"github.com/ChainSafe/chainbridge-core/types"
+	"github.com/ChainSafe/chainbridge-core/util"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/ChainSafe/chainbridge-core/chains/evm/listener"
 	"github.com/ChainSafe/chainbridge-core/relayer/message"
@@ -16,8 +26,6 @@ import (
 	"github.com/stretchr/testify/suite"
 )
 
-var errIncorrectCalldataLen = errors.New("invalid calldata length: less than 84 bytes")
-
 type ListenerTestSuite struct {
 	suite.Suite
 	mockEventHandler *mock_listener.MockEventHandler
@@ -72,7 +80,7 @@ func (s *ListenerTestSuite) TestErc20HandleEvent() {
 		},
 	}
 
-	m, err := listener.Erc20EventHandler(sourceID, depositLog.DestinationDomainID, depositLog.DepositNonce, depositLog.ResourceID, depositLog.Data, depositLog.HandlerResponse)
+	m, err := listener.Erc20EventHandler(sourceID, depositLog.DestinationDomainID, depositLog.DepositNonce, depositLog.ResourceID, depositLog.Data, depositLog.HandlerResponse, common.Hash{}, 0)
 	s.Nil(err)
 	s.NotNil(m)
 	s.Equal(m, expected)
@@ -101,9 +109,9 @@ func (s *ListenerTestSuite) TestErc20HandleEventIncorrectCalldataLen() {
 
 	sourceID := uint8(1)
 
-	m, err := listener.Erc20EventHandler(sourceID, depositLog.DestinationDomainID, depositLog.DepositNonce, depositLog.ResourceID, depositLog.Data, depositLog.HandlerResponse)
+	m, err := listener.Erc20EventHandler(sourceID, depositLog.DestinationDomainID, depositLog.DepositNonce, depositLog.ResourceID, depositLog.Data, depositLog.HandlerResponse, common.Hash{}, 0)
 	s.Nil(m)
-	s.EqualError(err, errIncorrectCalldataLen.Error())
+	s.ErrorIs(err, listener.ErrMalformedCalldata)
 }
 
 func (s *ListenerTestSuite) TestErc721HandleEvent_WithMetadata_Sucess() {
@@ -146,7 +154,7 @@ func (s *ListenerTestSuite) TestErc721HandleEvent_WithMetadata_Sucess() {
 		},
 	}
 
-	m, err := listener.Erc721EventHandler(sourceID, depositLog.DestinationDomainID, depositLog.DepositNonce, depositLog.ResourceID, depositLog.Data, depositLog.HandlerResponse)
+	m, err := listener.Erc721EventHandler(sourceID, depositLog.DestinationDomainID, depositLog.DepositNonce, depositLog.ResourceID, depositLog.Data, depositLog.HandlerResponse, common.Hash{}, 0)
 	s.Nil(err)
 	s.NotNil(m)
 	s.Equal(expected, m)
@@ -189,7 +197,7 @@ func (s *ListenerTestSuite) TestErc721HandleEvent_WithoutMetadata_Success() {
 		},
 	}
 
-	m, err := listener.Erc721EventHandler(sourceID, depositLog.DestinationDomainID, depositLog.DepositNonce, depositLog.ResourceID, depositLog.Data, depositLog.HandlerResponse)
+	m, err := listener.Erc721EventHandler(sourceID, depositLog.DestinationDomainID, depositLog.DepositNonce, depositLog.ResourceID, depositLog.Data, depositLog.HandlerResponse, common.Hash{}, 0)
 	s.Nil(err)
 	s.NotNil(m)
 	s.Equal(expected, m)
@@ -214,7 +222,117 @@ func (s *ListenerTestSuite) TestErc721HandleEvent_IncorrectCalldataLen_Failure()
 
 	sourceID := uint8(1)
 
-	m, err := listener.Erc721EventHandler(sourceID, depositLog.DestinationDomainID, depositLog.DepositNonce, depositLog.ResourceID, depositLog.Data, depositLog.HandlerResponse)
+	m, err := listener.Erc721EventHandler(sourceID, depositLog.DestinationDomainID, depositLog.DepositNonce, depositLog.ResourceID, depositLog.Data, depositLog.HandlerResponse, common.Hash{}, 0)
 	s.Nil(m)
-	s.EqualError(err, errIncorrectCalldataLen.Error())
+	s.ErrorIs(err, listener.ErrMalformedCalldata)
+}
+
+func (s *ListenerTestSuite) TestListenToEvents_RecoversFromHandlerPanic() {
+	gomockController := gomock.NewController(s.T())
+	mockChainClient := mock_listener.NewMockChainClient(gomockController)
+	mockKeyValueReaderWriter := mock_store.NewMockKeyValueReaderWriter(gomockController)
+	bridgeABI, _ := abi.JSON(strings.NewReader(consts.BridgeABI))
+	depositLog := func(nonce uint64) ethereumTypes.Log {
+		data, err := bridgeABI.Events["Deposit"].Inputs.NonIndexed().Pack(uint8(2), [32]byte{1}, nonce, []byte{}, []byte{})
+		s.Nil(err)
+		return ethereumTypes.Log{Topics: []common.Hash{util.Deposit.GetTopic()}, Data: data}
+	}
+	expected := &message.Message{DepositNonce: 2}
+
+	mockChainClient.EXPECT().LatestBlock().Return(big.NewInt(10), nil).AnyTimes()
+	mockChainClient.EXPECT().FetchEventLogs(gomock.Any(), gomock.Any(), string(util.Deposit), big.NewInt(0), big.NewInt(10)).Return([]ethereumTypes.Log{depositLog(1), depositLog(2)}, nil)
+	mockKeyValueReaderWriter.EXPECT().SetByKey(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	gomock.InOrder(
+		s.mockEventHandler.EXPECT().HandleEvent(uint8(1), uint8(2), uint64(1), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(sourceID, destID uint8, nonce uint64, resourceID types.ResourceID, calldata, handlerResponse []byte, depositTxHash common.Hash, depositBlock uint64) (*message.Message, error) {
+				panic("malformed deposit")
+			}),
+		s.mockEventHandler.EXPECT().HandleEvent(uint8(1), uint8(2), uint64(2), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(expected, nil),
+	)
+
+	stop := make(chan struct{})
+	defer close(stop)
+	l := listener.NewEVMListener(mockChainClient, s.mockEventHandler, common.HexToAddress("0x1"))
+	ch := l.ListenToEvents(big.NewInt(0), big.NewInt(0), time.Millisecond, 1, store.NewBlockStore(mockKeyValueReaderWriter), stop, make(chan error))
+
+	select {
+	case m := <-ch:
+		s.Equal(expected, m)
+	case <-time.After(time.Second):
+		s.Fail("listener stopped after handler panic")
+	}
+}
+
+type prefetchingEventHandler struct {
+	*mock_listener.MockEventHandler
+	*mock_listener.MockHandlerPrefetcher
+}
+
+func (s *ListenerTestSuite) TestListenToEvents_PrefetchesHandlers() {
+	gomockController := gomock.NewController(s.T())
+	mockChainClient := mock_listener.NewMockChainClient(gomockController)
+	mockPrefetcher := mock_listener.NewMockHandlerPrefetcher(gomockController)
+	mockKeyValueReaderWriter := mock_store.NewMockKeyValueReaderWriter(gomockController)
+	bridgeABI, _ := abi.JSON(strings.NewReader(consts.BridgeABI))
+	depositLog := func(nonce uint64) ethereumTypes.Log {
+		data, err := bridgeABI.Events["Deposit"].Inputs.NonIndexed().Pack(uint8(2), [32]byte{1}, nonce, []byte{}, []byte{})
+		s.Nil(err)
+		return ethereumTypes.Log{Topics: []common.Hash{util.Deposit.GetTopic()}, Data: data}
+	}
+	expected := &message.Message{DepositNonce: 2}
+
+	mockChainClient.EXPECT().LatestBlock().Return(big.NewInt(10), nil).AnyTimes()
+	mockChainClient.EXPECT().FetchEventLogs(gomock.Any(), gomock.Any(), string(util.Deposit), big.NewInt(0), big.NewInt(10)).Return([]ethereumTypes.Log{depositLog(1), depositLog(2)}, nil)
+	mockKeyValueReaderWriter.EXPECT().SetByKey(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	gomock.InOrder(
+		mockPrefetcher.EXPECT().PrefetchHandlers([]types.ResourceID{{1}}).Return(nil),
+		s.mockEventHandler.EXPECT().HandleEvent(uint8(1), uint8(2), uint64(1), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&message.Message{DepositNonce: 1}, nil),
+		s.mockEventHandler.EXPECT().HandleEvent(uint8(1), uint8(2), uint64(2), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(expected, nil),
+	)
+
+	stop := make(chan struct{})
+	defer close(stop)
+	l := listener.NewEVMListener(mockChainClient, prefetchingEventHandler{s.mockEventHandler, mockPrefetcher}, common.HexToAddress("0x1"))
+	ch := l.ListenToEvents(big.NewInt(0), big.NewInt(0), time.Millisecond, 1, store.NewBlockStore(mockKeyValueReaderWriter), stop, make(chan error))
+
+	<-ch
+	select {
+	case m := <-ch:
+		s.Equal(expected, m)
+	case <-time.After(time.Second):
+		s.Fail("deposits not handled")
+	}
+}
+
+func (s *ListenerTestSuite) TestListenToEvents_BridgeEventsFetchFailed_RetriesBlockRange() {
+	gomockController := gomock.NewController(s.T())
+	mockChainClient := mock_listener.NewMockChainClient(gomockController)
+	mockKeyValueReaderWriter := mock_store.NewMockKeyValueReaderWriter(gomockController)
+	bridgeABI, _ := abi.JSON(strings.NewReader(consts.BridgeABI))
+	data, err := bridgeABI.Events["ProposalEvent"].Inputs.NonIndexed().Pack(uint8(2), uint64(1), uint8(1), [32]byte{1})
+	s.Nil(err)
+	proposalLog := ethereumTypes.Log{Topics: []common.Hash{util.ProposalEvent.GetTopic()}, Data: data}
+
+	mockChainClient.EXPECT().LatestBlock().Return(big.NewInt(10), nil).AnyTimes()
+	mockChainClient.EXPECT().FetchEventLogs(gomock.Any(), gomock.Any(), string(util.Deposit), big.NewInt(0), big.NewInt(10)).Return([]ethereumTypes.Log{}, nil).Times(2)
+	mockChainClient.EXPECT().FetchEventLogs(gomock.Any(), gomock.Any(), string(util.Deposit), gomock.Any(), gomock.Any()).Return([]ethereumTypes.Log{}, nil).AnyTimes()
+	gomock.InOrder(
+		mockChainClient.EXPECT().FetchMultipleEventLogs(gomock.Any(), gomock.Any(), []string{string(util.ProposalEvent)}, big.NewInt(0), big.NewInt(10)).Return(nil, errors.New("error")),
+		mockChainClient.EXPECT().FetchMultipleEventLogs(gomock.Any(), gomock.Any(), []string{string(util.ProposalEvent)}, big.NewInt(0), big.NewInt(10)).Return([]ethereumTypes.Log{proposalLog}, nil),
+	)
+	mockChainClient.EXPECT().FetchMultipleEventLogs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]ethereumTypes.Log{}, nil).AnyTimes()
+	mockKeyValueReaderWriter.EXPECT().SetByKey(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	stop := make(chan struct{})
+	defer close(stop)
+	l := listener.NewEVMListener(mockChainClient, s.mockEventHandler, common.HexToAddress("0x1"))
+	events := l.SubscribeToBridgeEvents(util.ProposalEvent)
+	l.ListenToEvents(big.NewInt(0), big.NewInt(0), time.Millisecond, 1, store.NewBlockStore(mockKeyValueReaderWriter), stop, make(chan error))
+
+	select {
+	case evt := <-events:
+		s.Equal(util.ProposalEvent, evt.Sig)
+	case <-time.After(time.Second):
+		s.Fail("bridge events of failed block range not published")
+	}
 }
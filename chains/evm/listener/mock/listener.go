@@ -1,21 +1,96 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: ./chains/evm/listener/listener.go
+// Source: chains/evm/listener/listener.go
 
 // Package mock_listener is a generated GoMock package.
 package mock_listener
 
 import (
 	context "context"
-	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
 	big "math/big"
 	reflect "reflect"
 
-	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	message "github.com/ChainSafe/chainbridge-core/relayer/message"
 	types "github.com/ChainSafe/chainbridge-core/types"
 	common "github.com/ethereum/go-ethereum/common"
+	types0 "github.com/ethereum/go-ethereum/core/types"
 	gomock "github.com/golang/mock/gomock"
 )
 
+// MockEventHandler is a mock of EventHandler interface.
+type MockEventHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventHandlerMockRecorder
+}
+
+// MockEventHandlerMockRecorder is the mock recorder for MockEventHandler.
+type MockEventHandlerMockRecorder struct {
+	mock *MockEventHandler
+}
+
+// NewMockEventHandler creates a new mock instance.
+func NewMockEventHandler(ctrl *gomock.Controller) *MockEventHandler {
+	mock := &MockEventHandler{ctrl: ctrl}
+	mock.recorder = &MockEventHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventHandler) EXPECT() *MockEventHandlerMockRecorder {
+	return m.recorder
+}
+
+// HandleEvent mocks base method.
+func (m *MockEventHandler) HandleEvent(sourceID, destID uint8, nonce uint64, resourceID types.ResourceID, calldata, handlerResponse []byte, depositTxHash common.Hash, depositBlock uint64) (*message.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandleEvent", sourceID, destID, nonce, resourceID, calldata, handlerResponse, depositTxHash, depositBlock)
+	ret0, _ := ret[0].(*message.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HandleEvent indicates an expected call of HandleEvent.
+func (mr *MockEventHandlerMockRecorder) HandleEvent(sourceID, destID, nonce, resourceID, calldata, handlerResponse, depositTxHash, depositBlock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleEvent", reflect.TypeOf((*MockEventHandler)(nil).HandleEvent), sourceID, destID, nonce, resourceID, calldata, handlerResponse, depositTxHash, depositBlock)
+}
+
+// MockHandlerPrefetcher is a mock of HandlerPrefetcher interface.
+type MockHandlerPrefetcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockHandlerPrefetcherMockRecorder
+}
+
+// MockHandlerPrefetcherMockRecorder is the mock recorder for MockHandlerPrefetcher.
+type MockHandlerPrefetcherMockRecorder struct {
+	mock *MockHandlerPrefetcher
+}
+
+// NewMockHandlerPrefetcher creates a new mock instance.
+func NewMockHandlerPrefetcher(ctrl *gomock.Controller) *MockHandlerPrefetcher {
+	mock := &MockHandlerPrefetcher{ctrl: ctrl}
+	mock.recorder = &MockHandlerPrefetcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHandlerPrefetcher) EXPECT() *MockHandlerPrefetcherMockRecorder {
+	return m.recorder
+}
+
+// PrefetchHandlers mocks base method.
+func (m *MockHandlerPrefetcher) PrefetchHandlers(resourceIDs []types.ResourceID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrefetchHandlers", resourceIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PrefetchHandlers indicates an expected call of PrefetchHandlers.
+func (mr *MockHandlerPrefetcherMockRecorder) PrefetchHandlers(resourceIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrefetchHandlers", reflect.TypeOf((*MockHandlerPrefetcher)(nil).PrefetchHandlers), resourceIDs)
+}
+
 // MockChainClient is a mock of ChainClient interface.
 type MockChainClient struct {
 	ctrl     *gomock.Controller
@@ -54,70 +129,47 @@ func (mr *MockChainClientMockRecorder) CallContract(ctx, callArgs, blockNumber i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallContract", reflect.TypeOf((*MockChainClient)(nil).CallContract), ctx, callArgs, blockNumber)
 }
 
-// FetchDepositLogs mocks base method.
-func (m *MockChainClient) FetchDepositLogs(ctx context.Context, address common.Address, startBlock, endBlock *big.Int) ([]*evmclient.DepositLogsEnriched, error) {
+// FetchEventLogs mocks base method.
+func (m *MockChainClient) FetchEventLogs(ctx context.Context, contractAddress common.Address, event string, startBlock, endBlock *big.Int) ([]types0.Log, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FetchDepositLogs", ctx, address, startBlock, endBlock)
-	ret0, _ := ret[0].([]*evmclient.DepositLogsEnriched)
+	ret := m.ctrl.Call(m, "FetchEventLogs", ctx, contractAddress, event, startBlock, endBlock)
+	ret0, _ := ret[0].([]types0.Log)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FetchDepositLogs indicates an expected call of FetchDepositLogs.
-func (mr *MockChainClientMockRecorder) FetchDepositLogs(ctx, address, startBlock, endBlock interface{}) *gomock.Call {
+// FetchEventLogs indicates an expected call of FetchEventLogs.
+func (mr *MockChainClientMockRecorder) FetchEventLogs(ctx, contractAddress, event, startBlock, endBlock interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchDepositLogs", reflect.TypeOf((*MockChainClient)(nil).FetchDepositLogs), ctx, address, startBlock, endBlock)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchEventLogs", reflect.TypeOf((*MockChainClient)(nil).FetchEventLogs), ctx, contractAddress, event, startBlock, endBlock)
 }
 
-// LatestBlock mocks base method.
-func (m *MockChainClient) LatestBlock() (*big.Int, error) {
+// FetchMultipleEventLogs mocks base method.
+func (m *MockChainClient) FetchMultipleEventLogs(ctx context.Context, contractAddress common.Address, events []string, startBlock, endBlock *big.Int) ([]types0.Log, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LatestBlock")
-	ret0, _ := ret[0].(*big.Int)
+	ret := m.ctrl.Call(m, "FetchMultipleEventLogs", ctx, contractAddress, events, startBlock, endBlock)
+	ret0, _ := ret[0].([]types0.Log)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// LatestBlock indicates an expected call of LatestBlock.
-func (mr *MockChainClientMockRecorder) LatestBlock() *gomock.Call {
+// FetchMultipleEventLogs indicates an expected call of FetchMultipleEventLogs.
+func (mr *MockChainClientMockRecorder) FetchMultipleEventLogs(ctx, contractAddress, events, startBlock, endBlock interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestBlock", reflect.TypeOf((*MockChainClient)(nil).LatestBlock))
-}
-
-// MockEventHandler is a mock of EventHandler interface.
-type MockEventHandler struct {
-	ctrl     *gomock.Controller
-	recorder *MockEventHandlerMockRecorder
-}
-
-// MockEventHandlerMockRecorder is the mock recorder for MockEventHandler.
-type MockEventHandlerMockRecorder struct {
-	mock *MockEventHandler
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchMultipleEventLogs", reflect.TypeOf((*MockChainClient)(nil).FetchMultipleEventLogs), ctx, contractAddress, events, startBlock, endBlock)
 }
 
-// NewMockEventHandler creates a new mock instance.
-func NewMockEventHandler(ctrl *gomock.Controller) *MockEventHandler {
-	mock := &MockEventHandler{ctrl: ctrl}
-	mock.recorder = &MockEventHandlerMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockEventHandler) EXPECT() *MockEventHandlerMockRecorder {
-	return m.recorder
-}
-
-// HandleEvent mocks base method.
-func (m *MockEventHandler) HandleEvent(sourceID, destID uint8, nonce uint64, resourceID types.ResourceID, calldata, handlerResponse []byte, depositTxHash common.Hash, depositBlock uint64) (*message.Message, error) {
+// LatestBlock mocks base method.
+func (m *MockChainClient) LatestBlock() (*big.Int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "HandleEvent", sourceID, destID, nonce, resourceID, calldata, handlerResponse)
-	ret0, _ := ret[0].(*message.Message)
+	ret := m.ctrl.Call(m, "LatestBlock")
+	ret0, _ := ret[0].(*big.Int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// HandleEvent indicates an expected call of HandleEvent.
-func (mr *MockEventHandlerMockRecorder) HandleEvent(sourceID, destID, nonce, resourceID, calldata, handlerResponse interface{}) *gomock.Call {
+// LatestBlock indicates an expected call of LatestBlock.
+func (mr *MockChainClientMockRecorder) LatestBlock() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleEvent", reflect.TypeOf((*MockEventHandler)(nil).HandleEvent), sourceID, destID, nonce, resourceID, calldata, handlerResponse)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestBlock", reflect.TypeOf((*MockChainClient)(nil).LatestBlock))
 }
@@ -0,0 +1,99 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package monitor
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+)
+
+var BalancePollPeriod = time.Minute
+
+type Metrics interface {
+	TrackRelayerBalance(domainID uint8, account common.Address, balance *big.Int, low bool)
+	TrackGasSpent(domainID uint8, spent *big.Int)
+}
+
+type BalanceClient interface {
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+}
+
+// BalanceMonitor periodically reads native balance of the relayer account and
+// warns when it drops below the low balance threshold
+type BalanceMonitor struct {
+	client    BalanceClient
+	account   common.Address
+	domainID  uint8
+	threshold *big.Int
+	metrics   Metrics
+	low       bool
+	lowLock   sync.RWMutex
+}
+
+// NewBalanceMonitor creates an instance of BalanceMonitor. Low balance warnings are disabled if threshold is nil.
+func NewBalanceMonitor(client BalanceClient, account common.Address, domainID uint8, threshold *big.Int) *BalanceMonitor {
+	return &BalanceMonitor{
+		client:    client,
+		account:   account,
+		domainID:  domainID,
+		threshold: threshold,
+	}
+}
+
+// SetMetrics makes monitor report relayer balance to metrics
+func (m *BalanceMonitor) SetMetrics(metrics Metrics) {
+	m.metrics = metrics
+}
+
+// Start checks balance immediately and then every BalancePollPeriod until stop channel is closed
+func (m *BalanceMonitor) Start(stop <-chan struct{}) {
+	for {
+		err := m.Check()
+		if err != nil {
+			log.Warn().Err(err).Uint8("domainID", m.domainID).Msg("Failed checking relayer balance")
+		}
+
+		select {
+		case <-stop:
+			return
+		case <-time.After(BalancePollPeriod):
+		}
+	}
+}
+
+// Check reads current balance of the relayer account, reports it to metrics and
+// logs warning if it is lower than threshold
+func (m *BalanceMonitor) Check() error {
+	balance, err := m.client.BalanceAt(context.Background(), m.account, nil)
+	if err != nil {
+		return err
+	}
+
+	low := m.threshold != nil && balance.Cmp(m.threshold) < 0
+	m.lowLock.Lock()
+	m.low = low
+	m.lowLock.Unlock()
+	if m.metrics != nil {
+		m.metrics.TrackRelayerBalance(m.domainID, m.account, balance, low)
+	}
+	if low {
+		log.Warn().Uint8("domainID", m.domainID).Str("account", m.account.Hex()).Str("balance", balance.String()).
+			Str("threshold", m.threshold.String()).Msg("Relayer balance is low")
+		return nil
+	}
+	log.Debug().Uint8("domainID", m.domainID).Str("account", m.account.Hex()).Str("balance", balance.String()).Msg("Relayer balance")
+	return nil
+}
+
+// IsLow returns true if balance was below threshold on the last check
+func (m *BalanceMonitor) IsLow() bool {
+	m.lowLock.RLock()
+	defer m.lowLock.RUnlock()
+	return m.low
+}
@@ -0,0 +1,71 @@
+package monitor_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/monitor"
+	mock_monitor "github.com/ChainSafe/chainbridge-core/chains/evm/monitor/mock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type BalanceMonitorTestSuite struct {
+	suite.Suite
+	mockClient  *mock_monitor.MockBalanceClient
+	mockMetrics *mock_monitor.MockMetrics
+	monitor     *monitor.BalanceMonitor
+}
+
+func TestRunBalanceMonitorTestSuite(t *testing.T) {
+	suite.Run(t, new(BalanceMonitorTestSuite))
+}
+
+func (s *BalanceMonitorTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.mockClient = mock_monitor.NewMockBalanceClient(gomockController)
+	s.mockMetrics = mock_monitor.NewMockMetrics(gomockController)
+	s.monitor = monitor.NewBalanceMonitor(s.mockClient, common.HexToAddress("0x1"), 1, big.NewInt(100))
+	s.monitor.SetMetrics(s.mockMetrics)
+}
+
+func (s *BalanceMonitorTestSuite) TestCheck_BalanceAboveThreshold() {
+	s.mockClient.EXPECT().BalanceAt(gomock.Any(), common.HexToAddress("0x1"), nil).Return(big.NewInt(150), nil)
+	s.mockMetrics.EXPECT().TrackRelayerBalance(uint8(1), common.HexToAddress("0x1"), big.NewInt(150), false)
+
+	err := s.monitor.Check()
+
+	s.Nil(err)
+	s.False(s.monitor.IsLow())
+}
+
+func (s *BalanceMonitorTestSuite) TestCheck_LowBalance() {
+	s.mockClient.EXPECT().BalanceAt(gomock.Any(), common.HexToAddress("0x1"), nil).Return(big.NewInt(50), nil)
+	s.mockMetrics.EXPECT().TrackRelayerBalance(uint8(1), common.HexToAddress("0x1"), big.NewInt(50), true)
+
+	err := s.monitor.Check()
+
+	s.Nil(err)
+	s.True(s.monitor.IsLow())
+}
+
+func (s *BalanceMonitorTestSuite) TestCheck_NoThreshold() {
+	m := monitor.NewBalanceMonitor(s.mockClient, common.HexToAddress("0x1"), 1, nil)
+	m.SetMetrics(s.mockMetrics)
+	s.mockClient.EXPECT().BalanceAt(gomock.Any(), common.HexToAddress("0x1"), nil).Return(big.NewInt(0), nil)
+	s.mockMetrics.EXPECT().TrackRelayerBalance(uint8(1), common.HexToAddress("0x1"), big.NewInt(0), false)
+
+	err := m.Check()
+
+	s.Nil(err)
+}
+
+func (s *BalanceMonitorTestSuite) TestCheck_BalanceReadFails() {
+	s.mockClient.EXPECT().BalanceAt(gomock.Any(), gomock.Any(), nil).Return(nil, errors.New("error"))
+
+	err := s.monitor.Check()
+
+	s.NotNil(err)
+}
@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: chains/evm/monitor/balance.go
+
+// Package mock_monitor is a generated GoMock package.
+package mock_monitor
+
+import (
+	context "context"
+	big "math/big"
+	reflect "reflect"
+
+	common "github.com/ethereum/go-ethereum/common"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockMetrics is a mock of Metrics interface.
+type MockMetrics struct {
+	ctrl     *gomock.Controller
+	recorder *MockMetricsMockRecorder
+}
+
+// MockMetricsMockRecorder is the mock recorder for MockMetrics.
+type MockMetricsMockRecorder struct {
+	mock *MockMetrics
+}
+
+// NewMockMetrics creates a new mock instance.
+func NewMockMetrics(ctrl *gomock.Controller) *MockMetrics {
+	mock := &MockMetrics{ctrl: ctrl}
+	mock.recorder = &MockMetricsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMetrics) EXPECT() *MockMetricsMockRecorder {
+	return m.recorder
+}
+
+// TrackGasSpent mocks base method.
+func (m *MockMetrics) TrackGasSpent(domainID uint8, spent *big.Int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "TrackGasSpent", domainID, spent)
+}
+
+// TrackGasSpent indicates an expected call of TrackGasSpent.
+func (mr *MockMetricsMockRecorder) TrackGasSpent(domainID, spent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackGasSpent", reflect.TypeOf((*MockMetrics)(nil).TrackGasSpent), domainID, spent)
+}
+
+// TrackRelayerBalance mocks base method.
+func (m *MockMetrics) TrackRelayerBalance(domainID uint8, account common.Address, balance *big.Int, low bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "TrackRelayerBalance", domainID, account, balance, low)
+}
+
+// TrackRelayerBalance indicates an expected call of TrackRelayerBalance.
+func (mr *MockMetricsMockRecorder) TrackRelayerBalance(domainID, account, balance, low interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackRelayerBalance", reflect.TypeOf((*MockMetrics)(nil).TrackRelayerBalance), domainID, account, balance, low)
+}
+
+// MockBalanceClient is a mock of BalanceClient interface.
+type MockBalanceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockBalanceClientMockRecorder
+}
+
+// MockBalanceClientMockRecorder is the mock recorder for MockBalanceClient.
+type MockBalanceClientMockRecorder struct {
+	mock *MockBalanceClient
+}
+
+// NewMockBalanceClient creates a new mock instance.
+func NewMockBalanceClient(ctrl *gomock.Controller) *MockBalanceClient {
+	mock := &MockBalanceClient{ctrl: ctrl}
+	mock.recorder = &MockBalanceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBalanceClient) EXPECT() *MockBalanceClientMockRecorder {
+	return m.recorder
+}
+
+// BalanceAt mocks base method.
+func (m *MockBalanceClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BalanceAt", ctx, account, blockNumber)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BalanceAt indicates an expected call of BalanceAt.
+func (mr *MockBalanceClientMockRecorder) BalanceAt(ctx, account, blockNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BalanceAt", reflect.TypeOf((*MockBalanceClient)(nil).BalanceAt), ctx, account, blockNumber)
+}
@@ -0,0 +1,105 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: chains/evm/monitor/spend.go
+
+// Package mock_monitor is a generated GoMock package.
+package mock_monitor
+
+import (
+	context "context"
+	big "math/big"
+	reflect "reflect"
+
+	common "github.com/ethereum/go-ethereum/common"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSpendClient is a mock of SpendClient interface.
+type MockSpendClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockSpendClientMockRecorder
+}
+
+// MockSpendClientMockRecorder is the mock recorder for MockSpendClient.
+type MockSpendClientMockRecorder struct {
+	mock *MockSpendClient
+}
+
+// NewMockSpendClient creates a new mock instance.
+func NewMockSpendClient(ctrl *gomock.Controller) *MockSpendClient {
+	mock := &MockSpendClient{ctrl: ctrl}
+	mock.recorder = &MockSpendClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSpendClient) EXPECT() *MockSpendClientMockRecorder {
+	return m.recorder
+}
+
+// EffectiveGasPrice mocks base method.
+func (m *MockSpendClient) EffectiveGasPrice(ctx context.Context, txHash common.Hash) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EffectiveGasPrice", ctx, txHash)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EffectiveGasPrice indicates an expected call of EffectiveGasPrice.
+func (mr *MockSpendClientMockRecorder) EffectiveGasPrice(ctx, txHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EffectiveGasPrice", reflect.TypeOf((*MockSpendClient)(nil).EffectiveGasPrice), ctx, txHash)
+}
+
+// MockGasSpendStore is a mock of GasSpendStore interface.
+type MockGasSpendStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockGasSpendStoreMockRecorder
+}
+
+// MockGasSpendStoreMockRecorder is the mock recorder for MockGasSpendStore.
+type MockGasSpendStoreMockRecorder struct {
+	mock *MockGasSpendStore
+}
+
+// NewMockGasSpendStore creates a new mock instance.
+func NewMockGasSpendStore(ctrl *gomock.Controller) *MockGasSpendStore {
+	mock := &MockGasSpendStore{ctrl: ctrl}
+	mock.recorder = &MockGasSpendStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGasSpendStore) EXPECT() *MockGasSpendStoreMockRecorder {
+	return m.recorder
+}
+
+// AddSpent mocks base method.
+func (m *MockGasSpendStore) AddSpent(day string, amount *big.Int) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSpent", day, amount)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddSpent indicates an expected call of AddSpent.
+func (mr *MockGasSpendStoreMockRecorder) AddSpent(day, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSpent", reflect.TypeOf((*MockGasSpendStore)(nil).AddSpent), day, amount)
+}
+
+// Spent mocks base method.
+func (m *MockGasSpendStore) Spent(day string) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Spent", day)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Spent indicates an expected call of Spent.
+func (mr *MockGasSpendStoreMockRecorder) Spent(day interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Spent", reflect.TypeOf((*MockGasSpendStore)(nil).Spent), day)
+}
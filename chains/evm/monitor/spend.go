@@ -0,0 +1,98 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package monitor
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/rs/zerolog/log"
+)
+
+type SpendClient interface {
+	EffectiveGasPrice(ctx context.Context, txHash common.Hash) (*big.Int, error)
+}
+
+type GasSpendStore interface {
+	AddSpent(day string, amount *big.Int) (*big.Int, error)
+	Spent(day string) (*big.Int, error)
+}
+
+// GasSpendTracker sums fees paid for mined relayer transactions per UTC day
+// and reports if daily gas budget is exhausted
+type GasSpendTracker struct {
+	client   SpendClient
+	store    GasSpendStore
+	domainID uint8
+	budget   *big.Int
+	metrics  Metrics
+}
+
+// NewGasSpendTracker creates an instance of GasSpendTracker. Budget is never exhausted if it is nil.
+func NewGasSpendTracker(client SpendClient, store GasSpendStore, domainID uint8, budget *big.Int) *GasSpendTracker {
+	return &GasSpendTracker{
+		client:   client,
+		store:    store,
+		domainID: domainID,
+		budget:   budget,
+	}
+}
+
+// SetMetrics makes tracker report fees spent to metrics
+func (t *GasSpendTracker) SetMetrics(metrics Metrics) {
+	t.metrics = metrics
+}
+
+// TrackSpend adds fee paid for the mined transaction to fees spent on the current day
+func (t *GasSpendTracker) TrackSpend(receipt *types.Receipt) {
+	fee, err := t.fee(receipt)
+	if err != nil {
+		log.Warn().Err(err).Uint8("domainID", t.domainID).Str("hash", receipt.TxHash.Hex()).Msg("Failed calculating transaction fee")
+		return
+	}
+
+	spent, err := t.store.AddSpent(today(), fee)
+	if err != nil {
+		log.Warn().Err(err).Uint8("domainID", t.domainID).Str("hash", receipt.TxHash.Hex()).Msg("Failed storing transaction fee")
+		return
+	}
+	if t.metrics != nil {
+		t.metrics.TrackGasSpent(t.domainID, fee)
+	}
+
+	log.Debug().Uint8("domainID", t.domainID).Str("hash", receipt.TxHash.Hex()).Str("fee", fee.String()).Str("dailySpent", spent.String()).Msg("Transaction fee spent")
+	if t.budget != nil && spent.Cmp(t.budget) >= 0 && new(big.Int).Sub(spent, fee).Cmp(t.budget) < 0 {
+		log.Warn().Uint8("domainID", t.domainID).Str("dailySpent", spent.String()).Str("budget", t.budget.String()).Msg("Daily gas budget exhausted")
+	}
+}
+
+// Exhausted returns true if fees spent on the current day reached daily budget
+func (t *GasSpendTracker) Exhausted() bool {
+	if t.budget == nil {
+		return false
+	}
+
+	spent, err := t.store.Spent(today())
+	if err != nil {
+		log.Warn().Err(err).Uint8("domainID", t.domainID).Msg("Failed reading daily gas spent")
+		return false
+	}
+	return spent.Cmp(t.budget) >= 0
+}
+
+// fee calculates fee paid for the transaction from gas used and effective gas price from its receipt
+func (t *GasSpendTracker) fee(receipt *types.Receipt) (*big.Int, error) {
+	gasPrice, err := t.client.EffectiveGasPrice(context.Background(), receipt.TxHash)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(receipt.GasUsed)), nil
+}
+
+func today() string {
+	return time.Now().UTC().Format("2006-01-02")
+}
@@ -0,0 +1,69 @@
+package monitor_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/monitor"
+	mock_monitor "github.com/ChainSafe/chainbridge-core/chains/evm/monitor/mock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type GasSpendTrackerTestSuite struct {
+	suite.Suite
+	mockClient  *mock_monitor.MockSpendClient
+	mockStore   *mock_monitor.MockGasSpendStore
+	mockMetrics *mock_monitor.MockMetrics
+	tracker     *monitor.GasSpendTracker
+}
+
+func TestRunGasSpendTrackerTestSuite(t *testing.T) {
+	suite.Run(t, new(GasSpendTrackerTestSuite))
+}
+
+func (s *GasSpendTrackerTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.mockClient = mock_monitor.NewMockSpendClient(gomockController)
+	s.mockStore = mock_monitor.NewMockGasSpendStore(gomockController)
+	s.mockMetrics = mock_monitor.NewMockMetrics(gomockController)
+	s.tracker = monitor.NewGasSpendTracker(s.mockClient, s.mockStore, 1, big.NewInt(1000))
+	s.tracker.SetMetrics(s.mockMetrics)
+}
+
+func (s *GasSpendTrackerTestSuite) TestTrackSpend_EffectiveGasPrice() {
+	receipt := &types.Receipt{TxHash: common.Hash{1}, GasUsed: 10, BlockNumber: big.NewInt(5)}
+	s.mockClient.EXPECT().EffectiveGasPrice(gomock.Any(), common.Hash{1}).Return(big.NewInt(7), nil)
+	s.mockStore.EXPECT().AddSpent(gomock.Any(), big.NewInt(70)).Return(big.NewInt(70), nil)
+	s.mockMetrics.EXPECT().TrackGasSpent(uint8(1), big.NewInt(70))
+
+	s.tracker.TrackSpend(receipt)
+}
+
+func (s *GasSpendTrackerTestSuite) TestTrackSpend_ReceiptNotFound() {
+	receipt := &types.Receipt{TxHash: common.Hash{1}, GasUsed: 10, BlockNumber: big.NewInt(5)}
+	s.mockClient.EXPECT().EffectiveGasPrice(gomock.Any(), common.Hash{1}).Return(nil, errors.New("not found"))
+
+	s.tracker.TrackSpend(receipt)
+}
+
+func (s *GasSpendTrackerTestSuite) TestExhausted_BudgetReached() {
+	s.mockStore.EXPECT().Spent(gomock.Any()).Return(big.NewInt(1000), nil)
+
+	s.True(s.tracker.Exhausted())
+}
+
+func (s *GasSpendTrackerTestSuite) TestExhausted_BudgetNotReached() {
+	s.mockStore.EXPECT().Spent(gomock.Any()).Return(big.NewInt(999), nil)
+
+	s.False(s.tracker.Exhausted())
+}
+
+func (s *GasSpendTrackerTestSuite) TestExhausted_NoBudget() {
+	tracker := monitor.NewGasSpendTracker(s.mockClient, s.mockStore, 1, nil)
+
+	s.False(tracker.Exhausted())
+}
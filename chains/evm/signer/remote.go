@@ -0,0 +1,168 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package signer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core"
+)
+
+// RemoteSigner signs with a key held by a remote signing service that serves eth_signTransaction,
+// eth_sign and eth_signTypedData JSON-RPC methods, e.g. web3signer or Clef.
+// Signatures returned by the service are verified to be made by the configured address.
+type RemoteSigner struct {
+	client  *rpc.Client
+	address common.Address
+}
+
+// NewRemoteSigner creates an instance of RemoteSigner that signs with key of address
+// held by signing service served at url
+func NewRemoteSigner(ctx context.Context, url string, address common.Address) (*RemoteSigner, error) {
+	client, err := rpc.DialContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	return &RemoteSigner{client: client, address: address}, nil
+}
+
+func (s *RemoteSigner) Address() common.Address {
+	return s.address
+}
+
+// Close closes connection to the signing service
+func (s *RemoteSigner) Close() {
+	s.client.Close()
+}
+
+func (s *RemoteSigner) SignTransaction(ctx context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	var res json.RawMessage
+	err := s.client.CallContext(ctx, &res, "eth_signTransaction", s.txArgs(tx, chainID))
+	if err != nil {
+		return nil, err
+	}
+	raw, err := decodeRawTransaction(res)
+	if err != nil {
+		return nil, err
+	}
+
+	signed := new(types.Transaction)
+	err = signed.UnmarshalBinary(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	txSigner := types.LatestSignerForChainID(chainID)
+	if txSigner.Hash(signed) != txSigner.Hash(tx) {
+		return nil, fmt.Errorf("remote signer signed transaction different from requested")
+	}
+	sender, err := types.Sender(txSigner, signed)
+	if err != nil {
+		return nil, err
+	}
+	if sender != s.address {
+		return nil, fmt.Errorf("remote signer signed transaction with %s instead of %s", sender.Hex(), s.address.Hex())
+	}
+	return signed, nil
+}
+
+func (s *RemoteSigner) SignText(ctx context.Context, data []byte) ([]byte, error) {
+	var sig hexutil.Bytes
+	err := s.client.CallContext(ctx, &sig, "eth_sign", s.address, hexutil.Bytes(data))
+	if err != nil {
+		return nil, err
+	}
+	return s.verifySignature(accounts.TextHash(data), sig)
+}
+
+func (s *RemoteSigner) SignTypedData(ctx context.Context, typedData core.TypedData) ([]byte, error) {
+	hash, err := TypedDataHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+
+	var sig hexutil.Bytes
+	err = s.client.CallContext(ctx, &sig, "eth_signTypedData", s.address, typedData)
+	if err != nil {
+		return nil, err
+	}
+	return s.verifySignature(hash, sig)
+}
+
+// txArgs converts transaction into arguments of eth_signTransaction call
+func (s *RemoteSigner) txArgs(tx *types.Transaction, chainID *big.Int) map[string]interface{} {
+	args := map[string]interface{}{
+		"from":  s.address,
+		"to":    tx.To(),
+		"gas":   hexutil.Uint64(tx.Gas()),
+		"value": (*hexutil.Big)(tx.Value()),
+		"data":  hexutil.Bytes(tx.Data()),
+		"nonce": hexutil.Uint64(tx.Nonce()),
+	}
+	if chainID != nil {
+		args["chainId"] = (*hexutil.Big)(chainID)
+	}
+	if tx.Type() == types.DynamicFeeTxType {
+		args["maxFeePerGas"] = (*hexutil.Big)(tx.GasFeeCap())
+		args["maxPriorityFeePerGas"] = (*hexutil.Big)(tx.GasTipCap())
+	} else {
+		args["gasPrice"] = (*hexutil.Big)(tx.GasPrice())
+	}
+	if tx.Type() != types.LegacyTxType {
+		args["accessList"] = tx.AccessList()
+	}
+	return args
+}
+
+// verifySignature checks that signature of hash was made by signer address and
+// returns the signature with V transformed from 27/28 to 0/1
+func (s *RemoteSigner) verifySignature(hash []byte, sig []byte) ([]byte, error) {
+	if len(sig) != crypto.SignatureLength {
+		return nil, fmt.Errorf("remote signer returned signature of invalid length %d", len(sig))
+	}
+	sig = common.CopyBytes(sig)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pub, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		return nil, err
+	}
+	if recovered := crypto.PubkeyToAddress(*pub); recovered != s.address {
+		return nil, fmt.Errorf("remote signer signed with %s instead of %s", recovered.Hex(), s.address.Hex())
+	}
+	return sig, nil
+}
+
+// decodeRawTransaction decodes raw signed transaction returned either directly
+// or as raw field of the result as returned by geth and Clef
+func decodeRawTransaction(res json.RawMessage) ([]byte, error) {
+	var raw hexutil.Bytes
+	if len(res) > 0 && res[0] == '"' {
+		err := json.Unmarshal(res, &raw)
+		return raw, err
+	}
+
+	var signed struct {
+		Raw hexutil.Bytes `json:"raw"`
+	}
+	err := json.Unmarshal(res, &signed)
+	if err != nil {
+		return nil, err
+	}
+	if len(signed.Raw) == 0 {
+		return nil, fmt.Errorf("remote signer returned no signed transaction")
+	}
+	return signed.Raw, nil
+}
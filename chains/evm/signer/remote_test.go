@@ -0,0 +1,237 @@
+package signer_test
+
+import (
+	"context"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/signer"
+	"github.com/ChainSafe/chainbridge-core/crypto/secp256k1"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core"
+	"github.com/stretchr/testify/suite"
+)
+
+type signTxArgs struct {
+	To                   *common.Address `json:"to"`
+	Gas                  hexutil.Uint64  `json:"gas"`
+	GasPrice             *hexutil.Big    `json:"gasPrice"`
+	MaxFeePerGas         *hexutil.Big    `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big    `json:"maxPriorityFeePerGas"`
+	Value                *hexutil.Big    `json:"value"`
+	Data                 hexutil.Bytes   `json:"data"`
+	Nonce                hexutil.Uint64  `json:"nonce"`
+	ChainID              *hexutil.Big    `json:"chainId"`
+}
+
+type signedTx struct {
+	Raw hexutil.Bytes `json:"raw"`
+}
+
+// testSignerService serves signing methods of web3signer and Clef with its key
+type testSignerService struct {
+	kp          *secp256k1.Keypair
+	rawInObject bool
+}
+
+func (s *testSignerService) SignTransaction(args signTxArgs) (interface{}, error) {
+	var tx *types.Transaction
+	if args.MaxFeePerGas != nil {
+		tx = types.NewTx(&types.DynamicFeeTx{
+			ChainID:   args.ChainID.ToInt(),
+			Nonce:     uint64(args.Nonce),
+			To:        args.To,
+			GasFeeCap: args.MaxFeePerGas.ToInt(),
+			GasTipCap: args.MaxPriorityFeePerGas.ToInt(),
+			Gas:       uint64(args.Gas),
+			Value:     args.Value.ToInt(),
+			Data:      args.Data,
+		})
+	} else {
+		tx = types.NewTx(&types.LegacyTx{
+			Nonce:    uint64(args.Nonce),
+			To:       args.To,
+			GasPrice: args.GasPrice.ToInt(),
+			Gas:      uint64(args.Gas),
+			Value:    args.Value.ToInt(),
+			Data:     args.Data,
+		})
+	}
+
+	signed, err := types.SignTx(tx, types.LatestSignerForChainID(args.ChainID.ToInt()), s.kp.PrivateKey())
+	if err != nil {
+		return nil, err
+	}
+	raw, err := signed.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	if s.rawInObject {
+		return signedTx{Raw: raw}, nil
+	}
+	return hexutil.Bytes(raw), nil
+}
+
+func (s *testSignerService) Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error) {
+	return s.sign(accounts.TextHash(data))
+}
+
+func (s *testSignerService) SignTypedData(address common.Address, typedData core.TypedData) (hexutil.Bytes, error) {
+	hash, err := signer.TypedDataHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+	return s.sign(hash)
+}
+
+// sign returns signature with V as 27/28 as returned by signing services
+func (s *testSignerService) sign(hash []byte) (hexutil.Bytes, error) {
+	sig, err := crypto.Sign(hash, s.kp.PrivateKey())
+	if err != nil {
+		return nil, err
+	}
+	sig[crypto.RecoveryIDOffset] += 27
+	return sig, nil
+}
+
+type RemoteSignerTestSuite struct {
+	suite.Suite
+	kp           *secp256k1.Keypair
+	service      *testSignerService
+	server       *httptest.Server
+	localSigner  *signer.LocalSigner
+	remoteSigner *signer.RemoteSigner
+}
+
+func TestRunRemoteSignerTestSuite(t *testing.T) {
+	suite.Run(t, new(RemoteSignerTestSuite))
+}
+
+func (s *RemoteSignerTestSuite) SetupSuite()    {}
+func (s *RemoteSignerTestSuite) TearDownSuite() {}
+func (s *RemoteSignerTestSuite) SetupTest() {
+	s.kp, _ = secp256k1.NewKeypairFromPrivateKey(common.Hex2Bytes("e8e0f5427111dee651e63a6f1029da6929ebf7d2d61cefaf166cebefdf2c012e"))
+	s.service = &testSignerService{kp: s.kp}
+	rpcServer := rpc.NewServer()
+	_ = rpcServer.RegisterName("eth", s.service)
+	s.server = httptest.NewServer(rpcServer)
+	s.localSigner = signer.NewLocalSigner(s.kp)
+	s.remoteSigner = s.newRemoteSigner(s.kp.CommonAddress())
+}
+func (s *RemoteSignerTestSuite) TearDownTest() {
+	s.remoteSigner.Close()
+	s.server.Close()
+}
+
+func (s *RemoteSignerTestSuite) newRemoteSigner(address common.Address) *signer.RemoteSigner {
+	remoteSigner, err := signer.NewRemoteSigner(context.Background(), s.server.URL, address)
+	s.Nil(err)
+	return remoteSigner
+}
+
+func (s *RemoteSignerTestSuite) TestSignTransaction_DynamicFeeTx() {
+	to := common.HexToAddress("0x04005C8A516292af163b1AFe3D855b9f4f4631B5")
+	tx := types.NewTx(&types.DynamicFeeTx{
+		Nonce:     3,
+		To:        &to,
+		GasFeeCap: big.NewInt(200),
+		GasTipCap: big.NewInt(2),
+		Gas:       21000,
+		Value:     big.NewInt(1),
+		Data:      []byte{1, 2, 3},
+	})
+
+	signed, err := s.remoteSigner.SignTransaction(context.Background(), tx, big.NewInt(5))
+	s.Nil(err)
+	expected, err := s.localSigner.SignTransaction(context.Background(), tx, big.NewInt(5))
+	s.Nil(err)
+
+	s.Equal(expected.Hash(), signed.Hash())
+}
+
+func (s *RemoteSignerTestSuite) TestSignTransaction_RawInResultObject() {
+	s.service.rawInObject = true
+	to := common.HexToAddress("0x04005C8A516292af163b1AFe3D855b9f4f4631B5")
+	tx := types.NewTx(&types.LegacyTx{
+		Nonce:    3,
+		To:       &to,
+		GasPrice: big.NewInt(100),
+		Gas:      21000,
+		Value:    big.NewInt(0),
+	})
+
+	signed, err := s.remoteSigner.SignTransaction(context.Background(), tx, big.NewInt(5))
+	s.Nil(err)
+	expected, err := s.localSigner.SignTransaction(context.Background(), tx, big.NewInt(5))
+	s.Nil(err)
+
+	s.Equal(expected.Hash(), signed.Hash())
+}
+
+func (s *RemoteSignerTestSuite) TestSignTransaction_SignedWithOtherKey() {
+	remoteSigner := s.newRemoteSigner(common.HexToAddress("0x1"))
+	defer remoteSigner.Close()
+	tx := types.NewTx(&types.LegacyTx{GasPrice: big.NewInt(100), Gas: 21000, Value: big.NewInt(0)})
+
+	_, err := remoteSigner.SignTransaction(context.Background(), tx, big.NewInt(5))
+
+	s.NotNil(err)
+}
+
+func (s *RemoteSignerTestSuite) TestSignText() {
+	sig, err := s.remoteSigner.SignText(context.Background(), []byte{1, 2, 3})
+	s.Nil(err)
+	expected, err := s.localSigner.SignText(context.Background(), []byte{1, 2, 3})
+	s.Nil(err)
+
+	s.Equal(expected, sig)
+}
+
+func (s *RemoteSignerTestSuite) TestSignText_SignedWithOtherKey() {
+	remoteSigner := s.newRemoteSigner(common.HexToAddress("0x1"))
+	defer remoteSigner.Close()
+
+	_, err := remoteSigner.SignText(context.Background(), []byte{1, 2, 3})
+
+	s.NotNil(err)
+}
+
+func (s *RemoteSignerTestSuite) TestSignTypedData() {
+	typedData := core.TypedData{
+		Types: core.Types{
+			"EIP712Domain": []core.Type{
+				{Name: "name", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+			},
+			"Request": []core.Type{
+				{Name: "from", Type: "address"},
+				{Name: "nonce", Type: "uint256"},
+				{Name: "data", Type: "bytes"},
+			},
+		},
+		PrimaryType: "Request",
+		Domain: core.TypedDataDomain{
+			Name:    "Test",
+			ChainId: math.NewHexOrDecimal256(5),
+		},
+		Message: core.TypedDataMessage{
+			"from":  s.kp.Address(),
+			"nonce": math.NewHexOrDecimal256(1),
+			"data":  hexutil.Bytes{1, 2, 3},
+		},
+	}
+
+	sig, err := s.remoteSigner.SignTypedData(context.Background(), typedData)
+	s.Nil(err)
+	expected, err := s.localSigner.SignTypedData(context.Background(), typedData)
+	s.Nil(err)
+
+	s.Equal(expected, sig)
+}
@@ -0,0 +1,79 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package signer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ChainSafe/chainbridge-core/crypto/secp256k1"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core"
+)
+
+// Signer signs relayer transactions and messages with a key
+// held either in process or by a separate signing service
+type Signer interface {
+	// Address returns address of the signing key
+	Address() common.Address
+	// SignTransaction returns transaction signed for chain with chainID.
+	// Transaction is signed with homestead signer if chainID is nil.
+	SignTransaction(ctx context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+	// SignText signs data prefixed as EIP-191 personal message and returns
+	// signature in the [R || S || V] format where V is 0 or 1
+	SignText(ctx context.Context, data []byte) ([]byte, error)
+	// SignTypedData signs EIP-712 typed data and returns
+	// signature in the [R || S || V] format where V is 0 or 1
+	SignTypedData(ctx context.Context, typedData core.TypedData) ([]byte, error)
+}
+
+// LocalSigner signs with a private key loaded into the relayer process
+type LocalSigner struct {
+	kp *secp256k1.Keypair
+}
+
+// NewLocalSigner creates an instance of LocalSigner that signs with provided keypair
+func NewLocalSigner(kp *secp256k1.Keypair) *LocalSigner {
+	return &LocalSigner{kp: kp}
+}
+
+func (s *LocalSigner) Address() common.Address {
+	return s.kp.CommonAddress()
+}
+
+func (s *LocalSigner) SignTransaction(ctx context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return types.SignTx(tx, types.LatestSignerForChainID(chainID), s.kp.PrivateKey())
+}
+
+func (s *LocalSigner) SignText(ctx context.Context, data []byte) ([]byte, error) {
+	return crypto.Sign(accounts.TextHash(data), s.kp.PrivateKey())
+}
+
+func (s *LocalSigner) SignTypedData(ctx context.Context, typedData core.TypedData) ([]byte, error) {
+	hash, err := TypedDataHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.Sign(hash, s.kp.PrivateKey())
+}
+
+// TypedDataHash returns EIP-712 hash of typed data that is signed by SignTypedData
+func TypedDataHash(typedData core.TypedData) ([]byte, error) {
+	domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	if err != nil {
+		return nil, err
+	}
+
+	typedDataHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	rawData := []byte(fmt.Sprintf("\x19\x01%s%s", string(domainSeparator), string(typedDataHash)))
+	return crypto.Keccak256(rawData), nil
+}
@@ -0,0 +1,179 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: chains/evm/sweeper/sweeper.go
+
+// Package mock_sweeper is a generated GoMock package.
+package mock_sweeper
+
+import (
+	big "math/big"
+	reflect "reflect"
+
+	transactor "github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	message "github.com/ChainSafe/chainbridge-core/relayer/message"
+	common "github.com/ethereum/go-ethereum/common"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockChainClient is a mock of ChainClient interface.
+type MockChainClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockChainClientMockRecorder
+}
+
+// MockChainClientMockRecorder is the mock recorder for MockChainClient.
+type MockChainClientMockRecorder struct {
+	mock *MockChainClient
+}
+
+// NewMockChainClient creates a new mock instance.
+func NewMockChainClient(ctrl *gomock.Controller) *MockChainClient {
+	mock := &MockChainClient{ctrl: ctrl}
+	mock.recorder = &MockChainClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChainClient) EXPECT() *MockChainClientMockRecorder {
+	return m.recorder
+}
+
+// LatestBlock mocks base method.
+func (m *MockChainClient) LatestBlock() (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestBlock")
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestBlock indicates an expected call of LatestBlock.
+func (mr *MockChainClientMockRecorder) LatestBlock() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestBlock", reflect.TypeOf((*MockChainClient)(nil).LatestBlock))
+}
+
+// RelayerAddresses mocks base method.
+func (m *MockChainClient) RelayerAddresses() []common.Address {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RelayerAddresses")
+	ret0, _ := ret[0].([]common.Address)
+	return ret0
+}
+
+// RelayerAddresses indicates an expected call of RelayerAddresses.
+func (mr *MockChainClientMockRecorder) RelayerAddresses() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelayerAddresses", reflect.TypeOf((*MockChainClient)(nil).RelayerAddresses))
+}
+
+// MockBridgeContract is a mock of BridgeContract interface.
+type MockBridgeContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockBridgeContractMockRecorder
+}
+
+// MockBridgeContractMockRecorder is the mock recorder for MockBridgeContract.
+type MockBridgeContractMockRecorder struct {
+	mock *MockBridgeContract
+}
+
+// NewMockBridgeContract creates a new mock instance.
+func NewMockBridgeContract(ctrl *gomock.Controller) *MockBridgeContract {
+	mock := &MockBridgeContract{ctrl: ctrl}
+	mock.recorder = &MockBridgeContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBridgeContract) EXPECT() *MockBridgeContractMockRecorder {
+	return m.recorder
+}
+
+// CancelProposal mocks base method.
+func (m *MockBridgeContract) CancelProposal(source uint8, depositNonce uint64, dataHash common.Hash, opts transactor.TransactOptions) (*common.Hash, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelProposal", source, depositNonce, dataHash, opts)
+	ret0, _ := ret[0].(*common.Hash)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelProposal indicates an expected call of CancelProposal.
+func (mr *MockBridgeContractMockRecorder) CancelProposal(source, depositNonce, dataHash, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelProposal", reflect.TypeOf((*MockBridgeContract)(nil).CancelProposal), source, depositNonce, dataHash, opts)
+}
+
+// ContractAddress mocks base method.
+func (m *MockBridgeContract) ContractAddress() *common.Address {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContractAddress")
+	ret0, _ := ret[0].(*common.Address)
+	return ret0
+}
+
+// ContractAddress indicates an expected call of ContractAddress.
+func (mr *MockBridgeContractMockRecorder) ContractAddress() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContractAddress", reflect.TypeOf((*MockBridgeContract)(nil).ContractAddress))
+}
+
+// GetExpiry mocks base method.
+func (m *MockBridgeContract) GetExpiry() (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpiry")
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpiry indicates an expected call of GetExpiry.
+func (mr *MockBridgeContractMockRecorder) GetExpiry() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiry", reflect.TypeOf((*MockBridgeContract)(nil).GetExpiry))
+}
+
+// GetProposal mocks base method.
+func (m *MockBridgeContract) GetProposal(source uint8, depositNonce uint64, dataHash common.Hash) (message.ProposalStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProposal", source, depositNonce, dataHash)
+	ret0, _ := ret[0].(message.ProposalStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProposal indicates an expected call of GetProposal.
+func (mr *MockBridgeContractMockRecorder) GetProposal(source, depositNonce, dataHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProposal", reflect.TypeOf((*MockBridgeContract)(nil).GetProposal), source, depositNonce, dataHash)
+}
+
+// IsAdmin mocks base method.
+func (m *MockBridgeContract) IsAdmin(address common.Address) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsAdmin", address)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsAdmin indicates an expected call of IsAdmin.
+func (mr *MockBridgeContractMockRecorder) IsAdmin(address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAdmin", reflect.TypeOf((*MockBridgeContract)(nil).IsAdmin), address)
+}
+
+// IsRelayer mocks base method.
+func (m *MockBridgeContract) IsRelayer(relayerAddress common.Address) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsRelayer", relayerAddress)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsRelayer indicates an expected call of IsRelayer.
+func (mr *MockBridgeContractMockRecorder) IsRelayer(relayerAddress interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsRelayer", reflect.TypeOf((*MockBridgeContract)(nil).IsRelayer), relayerAddress)
+}
@@ -0,0 +1,393 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package sweeper
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/voter"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/voter/proposal"
+	"github.com/ChainSafe/chainbridge-core/config/chain"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/store"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+var (
+	// SweepPeriod is the time between checks of tracked proposals
+	SweepPeriod = time.Minute
+)
+
+type ChainClient interface {
+	LatestBlock() (*big.Int, error)
+	RelayerAddresses() []common.Address
+}
+
+type BridgeContract interface {
+	GetProposal(source uint8, depositNonce uint64, dataHash common.Hash) (message.ProposalStatus, error)
+	CancelProposal(source uint8, depositNonce uint64, dataHash common.Hash, opts transactor.TransactOptions) (*common.Hash, error)
+	GetExpiry() (uint64, error)
+	IsRelayer(relayerAddress common.Address) (bool, error)
+	IsAdmin(address common.Address) (bool, error)
+	ContractAddress() *common.Address
+}
+
+type trackedProposal struct {
+	proposal  *proposal.Proposal
+	dataHash  common.Hash
+	expiresAt *big.Int // block after which proposal can be cancelled, nil until proposal is read from bridge
+}
+
+// Sweeper tracks proposals relayer voted for and cancels them once they stay
+// active past bridge expiry. If relayer is not allowed to cancel proposals an alert is raised instead.
+// Tracked proposals are persisted so that they are swept also after relayer restart. Their IDs are
+// appended to a log in tracking order that is read on start and compacted from its head.
+type Sweeper struct {
+	db             store.KeyValueReaderWriter
+	prefix         string
+	client         ChainClient
+	bridgeContract BridgeContract
+	alerter        voter.Alerter
+	config         *chain.EVMConfig
+	domainID       uint8
+
+	expiry    *big.Int
+	proposals map[common.Hash]*trackedProposal
+	lock      sync.Mutex
+}
+
+// NewSweeper creates an instance of Sweeper that tracks proposals persisted in db by previous runs
+func NewSweeper(db store.KeyValueReaderWriter, client ChainClient, bridgeContract BridgeContract, alerter voter.Alerter, config *chain.EVMConfig) *Sweeper {
+	s := &Sweeper{
+		db:             db,
+		prefix:         fmt.Sprintf("chain:%d:bridge:%s:sweeper", *config.GeneralChainConfig.Id, strings.ToLower(bridgeContract.ContractAddress().Hex())),
+		client:         client,
+		bridgeContract: bridgeContract,
+		alerter:        alerter,
+		config:         config,
+		domainID:       *config.GeneralChainConfig.Id,
+		proposals:      make(map[common.Hash]*trackedProposal),
+	}
+	err := s.load()
+	if err != nil {
+		log.Error().Err(err).Uint8("domainID", s.domainID).Msg("Failed loading tracked proposals")
+	}
+	return s
+}
+
+// Record starts tracking proposal relayer voted for
+func (s *Sweeper) Record(p *proposal.Proposal) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	id := p.GetID()
+	if _, ok := s.proposals[id]; ok {
+		return
+	}
+	s.proposals[id] = &trackedProposal{
+		proposal: p,
+		dataHash: p.GetDataHash(),
+	}
+
+	err := s.persist(id, p)
+	if err != nil {
+		log.Error().Err(err).Uint8("domainID", s.domainID).Uint8("src", p.Source).Uint64("nonce", p.DepositNonce).Msg("Failed persisting tracked proposal")
+	}
+}
+
+// Len returns amount of tracked proposals
+func (s *Sweeper) Len() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return len(s.proposals)
+}
+
+// Start sweeps tracked proposals every SweepPeriod until stop channel is closed
+func (s *Sweeper) Start(stop <-chan struct{}) {
+	ticker := time.NewTicker(SweepPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			s.Sweep()
+		}
+	}
+}
+
+// Sweep stops tracking proposals that are no longer active and cancels
+// tracked proposals that are past expiry
+func (s *Sweeper) Sweep() {
+	if s.expiry == nil {
+		expiry, err := s.bridgeContract.GetExpiry()
+		if err != nil {
+			log.Warn().Err(err).Uint8("domainID", s.domainID).Msg("Failed reading proposal expiry")
+			return
+		}
+		s.expiry = new(big.Int).SetUint64(expiry)
+	}
+
+	head, err := s.client.LatestBlock()
+	if err != nil {
+		log.Warn().Err(err).Uint8("domainID", s.domainID).Msg("Failed reading latest block")
+		return
+	}
+
+	for id, p := range s.trackedProposals() {
+		if p.expiresAt != nil && head.Cmp(p.expiresAt) <= 0 {
+			continue
+		}
+
+		ps, err := s.bridgeContract.GetProposal(p.proposal.Source, p.proposal.DepositNonce, p.dataHash)
+		if err != nil {
+			log.Warn().Err(err).Uint8("domainID", s.domainID).Uint64("nonce", p.proposal.DepositNonce).Msg("Failed reading proposal status")
+			continue
+		}
+		if ps.Status != message.ProposalStatusActive && ps.Status != message.ProposalStatusPassed {
+			s.remove(id)
+			continue
+		}
+
+		p.expiresAt = new(big.Int).Add(ps.ProposedBlock, s.expiry)
+		if head.Cmp(p.expiresAt) <= 0 {
+			continue
+		}
+
+		s.cancel(p, ps)
+		s.remove(id)
+	}
+}
+
+// cancel cancels expired proposal if relayer is allowed to or raises an alert
+func (s *Sweeper) cancel(p *trackedProposal, ps message.ProposalStatus) {
+	logger := log.With().Uint8("domainID", s.domainID).Uint8("src", p.proposal.Source).Uint64("nonce", p.proposal.DepositNonce).Str("dataHash", p.dataHash.Hex()).Logger()
+
+	allowed, err := s.canCancel()
+	if err != nil {
+		logger.Warn().Err(err).Msg("Failed checking proposal cancellation rights")
+	}
+	if allowed {
+		hash, err := s.bridgeContract.CancelProposal(p.proposal.Source, p.proposal.DepositNonce, p.dataHash, transactor.TransactOptions{})
+		if err == nil {
+			logger.Info().Str("hash", hash.Hex()).Msg("Cancelled expired proposal")
+			return
+		}
+		logger.Error().Err(err).Msg("Failed cancelling expired proposal")
+
+		// proposal might have been cancelled by another relayer meanwhile
+		current, err := s.bridgeContract.GetProposal(p.proposal.Source, p.proposal.DepositNonce, p.dataHash)
+		if err == nil && current.Status == message.ProposalStatusCanceled {
+			return
+		}
+	}
+
+	s.alerter.Alert(&voter.Alert{
+		Kind:     voter.AlertExpiredProposal,
+		Severity: voter.SeverityMedium,
+		DomainID: s.domainID,
+		Message:  "Proposal expired and was not cancelled",
+		Details: map[string]interface{}{
+			"source":        p.proposal.Source,
+			"depositNonce":  p.proposal.DepositNonce,
+			"depositTxHash": p.proposal.DepositTxHash.Hex(),
+			"dataHash":      p.dataHash.Hex(),
+			"status":        message.StatusMap[ps.Status],
+			"yesVotes":      ps.YesVotesTotal,
+			"proposedBlock": ps.ProposedBlock.String(),
+		},
+	})
+}
+
+// canCancel returns true if all relayer keys are bridge relayers or admins
+// as cancellation is sent with any key of the relayer key pool
+func (s *Sweeper) canCancel() (bool, error) {
+	for _, address := range s.client.RelayerAddresses() {
+		allowed, err := s.canCancelWith(address)
+		if err != nil || !allowed {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func (s *Sweeper) canCancelWith(address common.Address) (bool, error) {
+	isRelayer, err := s.bridgeContract.IsRelayer(address)
+	if err != nil || isRelayer {
+		return isRelayer, err
+	}
+	return s.bridgeContract.IsAdmin(address)
+}
+
+func (s *Sweeper) trackedProposals() map[common.Hash]*trackedProposal {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	proposals := make(map[common.Hash]*trackedProposal, len(s.proposals))
+	for id, p := range s.proposals {
+		proposals[id] = p
+	}
+	return proposals
+}
+
+func (s *Sweeper) remove(id common.Hash) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.proposals, id)
+
+	err := s.db.DeleteByKey(s.proposalKey(id))
+	if err == nil {
+		err = s.compact()
+	}
+	if err != nil {
+		log.Error().Err(err).Uint8("domainID", s.domainID).Str("id", id.Hex()).Msg("Failed removing tracked proposal")
+	}
+}
+
+// load tracks proposals persisted by previous runs
+func (s *Sweeper) load() error {
+	head, tail, err := s.bounds()
+	if err != nil {
+		return err
+	}
+
+	for ; head.Cmp(tail) < 0; head.Add(head, big.NewInt(1)) {
+		id, err := s.logEntry(head)
+		if err != nil {
+			return err
+		}
+		if _, ok := s.proposals[id]; ok {
+			continue
+		}
+
+		v, err := s.db.GetByKey(s.proposalKey(id))
+		if err != nil {
+			if errors.Is(err, leveldb.ErrNotFound) {
+				continue
+			}
+			return err
+		}
+		var p proposal.Proposal
+		err = gob.NewDecoder(bytes.NewReader(v)).Decode(&p)
+		if err != nil {
+			return err
+		}
+		s.proposals[id] = &trackedProposal{
+			proposal: &p,
+			dataHash: p.GetDataHash(),
+		}
+	}
+	if len(s.proposals) > 0 {
+		log.Info().Uint8("domainID", s.domainID).Int("proposals", len(s.proposals)).Msg("Loaded tracked proposals")
+	}
+	return nil
+}
+
+// persist stores tracked proposal and appends its ID to the log
+func (s *Sweeper) persist(id common.Hash, p *proposal.Proposal) error {
+	// payload is not needed for sweeping and holds interface values gob can not encode
+	stored := *p
+	stored.Payload = nil
+	var value bytes.Buffer
+	err := gob.NewEncoder(&value).Encode(&stored)
+	if err != nil {
+		return err
+	}
+	err = s.db.SetByKey(s.proposalKey(id), value.Bytes())
+	if err != nil {
+		return err
+	}
+
+	tail, err := s.getIndex("tail")
+	if err != nil {
+		return err
+	}
+	err = s.db.SetByKey(s.logKey(tail), id.Bytes())
+	if err != nil {
+		return err
+	}
+	return s.db.SetByKey(s.indexKey("tail"), tail.Add(tail, big.NewInt(1)).Bytes())
+}
+
+// compact removes log entries of proposals that are no longer tracked from the head of the log
+func (s *Sweeper) compact() error {
+	head, tail, err := s.bounds()
+	if err != nil {
+		return err
+	}
+
+	for ; head.Cmp(tail) < 0; head.Add(head, big.NewInt(1)) {
+		id, err := s.logEntry(head)
+		if err != nil {
+			return err
+		}
+		if _, ok := s.proposals[id]; ok {
+			return nil
+		}
+
+		err = s.db.DeleteByKey(s.logKey(head))
+		if err != nil {
+			return err
+		}
+		err = s.db.SetByKey(s.indexKey("head"), big.NewInt(0).Add(head, big.NewInt(1)).Bytes())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Sweeper) logEntry(index *big.Int) (common.Hash, error) {
+	v, err := s.db.GetByKey(s.logKey(index))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(v), nil
+}
+
+func (s *Sweeper) bounds() (*big.Int, *big.Int, error) {
+	head, err := s.getIndex("head")
+	if err != nil {
+		return nil, nil, err
+	}
+	tail, err := s.getIndex("tail")
+	if err != nil {
+		return nil, nil, err
+	}
+	return head, tail, nil
+}
+
+func (s *Sweeper) getIndex(name string) (*big.Int, error) {
+	v, err := s.db.GetByKey(s.indexKey(name))
+	if err != nil {
+		if errors.Is(err, leveldb.ErrNotFound) {
+			return big.NewInt(0), nil
+		}
+		return nil, err
+	}
+	return big.NewInt(0).SetBytes(v), nil
+}
+
+func (s *Sweeper) indexKey(name string) []byte {
+	return []byte(fmt.Sprintf("%s:log:%s", s.prefix, name))
+}
+
+func (s *Sweeper) logKey(index *big.Int) []byte {
+	return []byte(fmt.Sprintf("%s:log:entry:%s", s.prefix, index.String()))
+}
+
+func (s *Sweeper) proposalKey(id common.Hash) []byte {
+	return []byte(fmt.Sprintf("%s:proposal:%s", s.prefix, id.Hex()))
+}
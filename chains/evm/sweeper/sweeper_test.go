@@ -0,0 +1,237 @@
+package sweeper_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/sweeper"
+	mock_sweeper "github.com/ChainSafe/chainbridge-core/chains/evm/sweeper/mock"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/voter"
+	mock_voter "github.com/ChainSafe/chainbridge-core/chains/evm/voter/mock"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/voter/proposal"
+	"github.com/ChainSafe/chainbridge-core/config/chain"
+	"github.com/ChainSafe/chainbridge-core/lvldb"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type SweeperTestSuite struct {
+	suite.Suite
+	mockClient         *mock_sweeper.MockChainClient
+	mockBridgeContract *mock_sweeper.MockBridgeContract
+	mockAlerter        *mock_voter.MockAlerter
+	db                 *lvldb.LVLDB
+	config             *chain.EVMConfig
+	bridgeAddress      common.Address
+	sweeper            *sweeper.Sweeper
+	proposal           *proposal.Proposal
+	relayer            common.Address
+}
+
+func TestRunSweeperTestSuite(t *testing.T) {
+	suite.Run(t, new(SweeperTestSuite))
+}
+
+func (s *SweeperTestSuite) SetupSuite()    {}
+func (s *SweeperTestSuite) TearDownSuite() {}
+func (s *SweeperTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.mockClient = mock_sweeper.NewMockChainClient(gomockController)
+	s.mockBridgeContract = mock_sweeper.NewMockBridgeContract(gomockController)
+	s.mockAlerter = mock_voter.NewMockAlerter(gomockController)
+	s.bridgeAddress = common.HexToAddress("0xABCD")
+	s.mockBridgeContract.EXPECT().ContractAddress().Return(&s.bridgeAddress).AnyTimes()
+	db, err := lvldb.NewLvlDB(s.T().TempDir())
+	s.Nil(err)
+	s.db = db
+	id := uint8(1)
+	s.config = &chain.EVMConfig{
+		GeneralChainConfig: chain.GeneralChainConfig{Id: &id},
+		GasLimit:           big.NewInt(1000),
+	}
+	s.sweeper = sweeper.NewSweeper(s.db, s.mockClient, s.mockBridgeContract, s.mockAlerter, s.config)
+	s.proposal = proposal.NewProposal(2, 1, 5, [32]byte{1}, []byte{1, 2, 3}, common.HexToAddress("0x1"), common.HexToAddress("0x2"), common.Hash{}, 0)
+	s.relayer = common.HexToAddress("0x3")
+	s.sweeper.Record(s.proposal)
+}
+func (s *SweeperTestSuite) TearDownTest() {
+	s.db.Close()
+}
+
+func (s *SweeperTestSuite) expectProposal(status uint8, proposedBlock int64) {
+	s.mockBridgeContract.EXPECT().GetProposal(uint8(2), uint64(5), s.proposal.GetDataHash()).Return(message.ProposalStatus{
+		Status:        status,
+		YesVotes:      big.NewInt(1),
+		YesVotesTotal: 1,
+		ProposedBlock: big.NewInt(proposedBlock),
+	}, nil)
+}
+
+func (s *SweeperTestSuite) TestSweep_ExpiryReadFails() {
+	s.mockBridgeContract.EXPECT().GetExpiry().Return(uint64(0), errors.New("error"))
+
+	s.sweeper.Sweep()
+
+	s.Equal(1, s.sweeper.Len())
+}
+
+func (s *SweeperTestSuite) TestSweep_ExecutedProposalRemoved() {
+	s.mockBridgeContract.EXPECT().GetExpiry().Return(uint64(100), nil)
+	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(50), nil)
+	s.expectProposal(message.ProposalStatusExecuted, 10)
+
+	s.sweeper.Sweep()
+
+	s.Equal(0, s.sweeper.Len())
+}
+
+func (s *SweeperTestSuite) TestSweep_ActiveProposalNotExpired() {
+	s.mockBridgeContract.EXPECT().GetExpiry().Return(uint64(100), nil)
+	gomock.InOrder(
+		s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(50), nil),
+		s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(110), nil),
+	)
+	s.expectProposal(message.ProposalStatusActive, 10)
+
+	s.sweeper.Sweep()
+	// proposal is not read again until its expiry block
+	s.sweeper.Sweep()
+
+	s.Equal(1, s.sweeper.Len())
+}
+
+func (s *SweeperTestSuite) TestSweep_ExpiredProposalCancelled() {
+	s.mockBridgeContract.EXPECT().GetExpiry().Return(uint64(100), nil)
+	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(111), nil)
+	s.expectProposal(message.ProposalStatusActive, 10)
+	s.mockClient.EXPECT().RelayerAddresses().Return([]common.Address{s.relayer})
+	s.mockBridgeContract.EXPECT().IsRelayer(s.relayer).Return(true, nil)
+	s.mockBridgeContract.EXPECT().CancelProposal(uint8(2), uint64(5), s.proposal.GetDataHash(), gomock.Any()).Return(&common.Hash{}, nil)
+
+	s.sweeper.Sweep()
+
+	s.Equal(0, s.sweeper.Len())
+}
+
+func (s *SweeperTestSuite) TestSweep_ExpiredProposalCancelledByAdmin() {
+	s.mockBridgeContract.EXPECT().GetExpiry().Return(uint64(100), nil)
+	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(111), nil)
+	s.expectProposal(message.ProposalStatusPassed, 10)
+	s.mockClient.EXPECT().RelayerAddresses().Return([]common.Address{s.relayer})
+	s.mockBridgeContract.EXPECT().IsRelayer(s.relayer).Return(false, nil)
+	s.mockBridgeContract.EXPECT().IsAdmin(s.relayer).Return(true, nil)
+	s.mockBridgeContract.EXPECT().CancelProposal(uint8(2), uint64(5), s.proposal.GetDataHash(), gomock.Any()).Return(&common.Hash{}, nil)
+
+	s.sweeper.Sweep()
+
+	s.Equal(0, s.sweeper.Len())
+}
+
+func (s *SweeperTestSuite) TestSweep_NoCancellationRights() {
+	s.mockBridgeContract.EXPECT().GetExpiry().Return(uint64(100), nil)
+	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(111), nil)
+	s.expectProposal(message.ProposalStatusActive, 10)
+	s.mockClient.EXPECT().RelayerAddresses().Return([]common.Address{s.relayer})
+	s.mockBridgeContract.EXPECT().IsRelayer(s.relayer).Return(false, nil)
+	s.mockBridgeContract.EXPECT().IsAdmin(s.relayer).Return(false, nil)
+	s.mockAlerter.EXPECT().Alert(gomock.Any()).Do(func(a *voter.Alert) {
+		s.Equal(voter.AlertExpiredProposal, a.Kind)
+		s.Equal(uint64(5), a.Details["depositNonce"])
+	})
+
+	s.sweeper.Sweep()
+
+	s.Equal(0, s.sweeper.Len())
+}
+
+func (s *SweeperTestSuite) TestSweep_PoolKeyWithoutCancellationRights() {
+	s.mockBridgeContract.EXPECT().GetExpiry().Return(uint64(100), nil)
+	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(111), nil)
+	s.expectProposal(message.ProposalStatusActive, 10)
+	s.mockClient.EXPECT().RelayerAddresses().Return([]common.Address{s.relayer, common.HexToAddress("0x2")})
+	s.mockBridgeContract.EXPECT().IsRelayer(s.relayer).Return(true, nil)
+	s.mockBridgeContract.EXPECT().IsRelayer(common.HexToAddress("0x2")).Return(false, nil)
+	s.mockBridgeContract.EXPECT().IsAdmin(common.HexToAddress("0x2")).Return(false, nil)
+	s.mockAlerter.EXPECT().Alert(gomock.Any())
+
+	s.sweeper.Sweep()
+
+	s.Equal(0, s.sweeper.Len())
+}
+
+func (s *SweeperTestSuite) TestSweep_CancellationFailed() {
+	s.mockBridgeContract.EXPECT().GetExpiry().Return(uint64(100), nil)
+	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(111), nil)
+	s.expectProposal(message.ProposalStatusActive, 10)
+	s.mockClient.EXPECT().RelayerAddresses().Return([]common.Address{s.relayer})
+	s.mockBridgeContract.EXPECT().IsRelayer(s.relayer).Return(true, nil)
+	s.mockBridgeContract.EXPECT().CancelProposal(uint8(2), uint64(5), s.proposal.GetDataHash(), gomock.Any()).Return(nil, errors.New("reverted"))
+	s.expectProposal(message.ProposalStatusActive, 10)
+	s.mockAlerter.EXPECT().Alert(gomock.Any())
+
+	s.sweeper.Sweep()
+
+	s.Equal(0, s.sweeper.Len())
+}
+
+func (s *SweeperTestSuite) TestSweep_CancelledByOtherRelayer() {
+	s.mockBridgeContract.EXPECT().GetExpiry().Return(uint64(100), nil)
+	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(111), nil)
+	s.expectProposal(message.ProposalStatusActive, 10)
+	s.mockClient.EXPECT().RelayerAddresses().Return([]common.Address{s.relayer})
+	s.mockBridgeContract.EXPECT().IsRelayer(s.relayer).Return(true, nil)
+	s.mockBridgeContract.EXPECT().CancelProposal(uint8(2), uint64(5), s.proposal.GetDataHash(), gomock.Any()).Return(nil, errors.New("reverted"))
+	s.expectProposal(message.ProposalStatusCanceled, 10)
+
+	s.sweeper.Sweep()
+
+	s.Equal(0, s.sweeper.Len())
+}
+
+func (s *SweeperTestSuite) TestNewSweeper_LoadsTrackedProposals() {
+	other := proposal.NewProposal(2, 1, 6, [32]byte{1}, []byte{4}, common.HexToAddress("0x1"), common.HexToAddress("0x2"), common.Hash{}, 0)
+	other.Payload = []interface{}{big.NewInt(1)}
+	s.sweeper.Record(other)
+
+	restarted := sweeper.NewSweeper(s.db, s.mockClient, s.mockBridgeContract, s.mockAlerter, s.config)
+
+	s.Equal(2, restarted.Len())
+	s.mockBridgeContract.EXPECT().GetExpiry().Return(uint64(100), nil)
+	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(50), nil)
+	s.expectProposal(message.ProposalStatusExecuted, 10)
+	s.mockBridgeContract.EXPECT().GetProposal(uint8(2), uint64(6), other.GetDataHash()).Return(message.ProposalStatus{
+		Status:        message.ProposalStatusActive,
+		YesVotes:      big.NewInt(1),
+		YesVotesTotal: 1,
+		ProposedBlock: big.NewInt(10),
+	}, nil)
+
+	restarted.Sweep()
+
+	s.Equal(1, restarted.Len())
+	s.Equal(1, sweeper.NewSweeper(s.db, s.mockClient, s.mockBridgeContract, s.mockAlerter, s.config).Len())
+}
+
+func (s *SweeperTestSuite) TestNewSweeper_RemovedProposalsNotLoaded() {
+	s.mockBridgeContract.EXPECT().GetExpiry().Return(uint64(100), nil)
+	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(50), nil)
+	s.expectProposal(message.ProposalStatusExecuted, 10)
+	s.sweeper.Sweep()
+
+	restarted := sweeper.NewSweeper(s.db, s.mockClient, s.mockBridgeContract, s.mockAlerter, s.config)
+
+	s.Equal(0, restarted.Len())
+}
+
+func (s *SweeperTestSuite) TestNewSweeper_ProposalsOfOtherBridgeNotLoaded() {
+	otherBridge := mock_sweeper.NewMockBridgeContract(gomock.NewController(s.T()))
+	otherAddress := common.HexToAddress("0xDCBA")
+	otherBridge.EXPECT().ContractAddress().Return(&otherAddress)
+
+	restarted := sweeper.NewSweeper(s.db, s.mockClient, otherBridge, s.mockAlerter, s.config)
+
+	s.Equal(0, restarted.Len())
+}
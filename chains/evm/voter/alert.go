@@ -0,0 +1,54 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package voter
+
+import (
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+type AlertSeverity string
+
+const (
+	SeverityHigh   AlertSeverity = "high"
+	SeverityMedium AlertSeverity = "medium"
+)
+
+const (
+	AlertDataHashMismatch = "DataHashMismatch"
+	AlertExpiredProposal  = "ExpiredProposal"
+	AlertDroppedMessage   = "DroppedMessage"
+)
+
+// Alert is an event that requires investigation by relayer operators
+type Alert struct {
+	Kind     string
+	Severity AlertSeverity
+	DomainID uint8
+	Message  string
+	Details  map[string]interface{}
+}
+
+// Alerter delivers alerts to relayer operators
+type Alerter interface {
+	Alert(a *Alert)
+}
+
+// LogAlerter is an Alerter that writes alerts to relayer logs and should be used
+// when no other alerting is configured
+type LogAlerter struct{}
+
+func (l *LogAlerter) Alert(a *Alert) {
+	level := zerolog.WarnLevel
+	if a.Severity == SeverityHigh {
+		level = zerolog.ErrorLevel
+	}
+
+	log.WithLevel(level).
+		Str("alert", a.Kind).
+		Str("severity", string(a.Severity)).
+		Uint8("domainID", a.DomainID).
+		Fields(a.Details).
+		Msg(a.Message)
+}
@@ -0,0 +1,199 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package voter
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/multicall"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/voter/proposal"
+	"github.com/ChainSafe/chainbridge-core/config/chain"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/rs/zerolog/log"
+)
+
+type Multicall interface {
+	Aggregate(calls []multicall.Call, opts transactor.TransactOptions) (*common.Hash, error)
+}
+
+type BatchBridgeContract interface {
+	VoteProposal(proposal *proposal.Proposal, opts transactor.TransactOptions) (*common.Hash, error)
+	ContractAddress() *common.Address
+	ABIAdapter() bridge.ABIAdapter
+}
+
+// BatchReceiptClient waits for receipts of batch transactions
+type BatchReceiptClient interface {
+	WaitAndReturnTxReceipt(h common.Hash) (*types.Receipt, error)
+}
+
+var errBatcherStopped = errors.New("vote batcher stopped")
+
+type voteResult struct {
+	hash *common.Hash
+	err  error
+}
+
+type voteRequest struct {
+	proposal *proposal.Proposal
+	result   chan voteResult
+}
+
+// VoteBatcher collects votes for proposals over vote batch window and sends them in a single
+// transaction through multicall contract. Multicall contract has to be registered as a trusted
+// forwarder on the bridge as it forwards each vote call with the transaction sender appended.
+// Gas limit of batch transaction is estimated. If batch transaction fails or reverts proposals
+// are voted for one by one.
+type VoteBatcher struct {
+	multicall      Multicall
+	bridgeContract BatchBridgeContract
+	client         BatchReceiptClient
+	config         *chain.EVMConfig
+	domainID       uint8
+	requests       chan *voteRequest
+	stopped        chan struct{}
+}
+
+// NewVoteBatcher creates an instance of VoteBatcher that sends batches through multicall
+func NewVoteBatcher(multicall Multicall, bridgeContract BatchBridgeContract, client BatchReceiptClient, config *chain.EVMConfig) *VoteBatcher {
+	return &VoteBatcher{
+		multicall:      multicall,
+		bridgeContract: bridgeContract,
+		client:         client,
+		config:         config,
+		domainID:       *config.GeneralChainConfig.Id,
+		requests:       make(chan *voteRequest),
+		stopped:        make(chan struct{}),
+	}
+}
+
+// Vote adds vote for proposal to the current batch and blocks until the vote is sent.
+// Proposal is voted for directly if batcher does not accept the vote within vote batch window
+// as it is not started and error is returned if batcher stops before the vote is sent.
+func (b *VoteBatcher) Vote(prop *proposal.Proposal) (*common.Hash, error) {
+	req := &voteRequest{proposal: prop, result: make(chan voteResult, 1)}
+	select {
+	case b.requests <- req:
+	case <-b.stopped:
+		return nil, errBatcherStopped
+	case <-time.After(b.config.VoteBatchWindow):
+		log.Warn().Uint8("domainID", b.domainID).Uint64("nonce", prop.DepositNonce).Msg("Vote batcher not running, voting directly")
+		b.voteSingle(req)
+		res := <-req.result
+		return res.hash, res.err
+	}
+
+	select {
+	case res := <-req.result:
+		return res.hash, res.err
+	case <-b.stopped:
+		return nil, errBatcherStopped
+	}
+}
+
+// Start collects votes into batches and sends each batch once it reaches vote batch size
+// or vote batch window passes since its first vote, until stop channel is closed.
+// Votes of the batch that was not sent yet fail once batcher is stopped.
+func (b *VoteBatcher) Start(stop <-chan struct{}) {
+	var batch []*voteRequest
+	defer func() {
+		close(b.stopped)
+		for _, req := range batch {
+			req.result <- voteResult{err: errBatcherStopped}
+		}
+	}()
+
+	var window <-chan time.Time
+	for {
+		select {
+		case <-stop:
+			return
+		case req := <-b.requests:
+			batch = append(batch, req)
+			if len(batch) == 1 {
+				window = time.After(b.config.VoteBatchWindow)
+			}
+			if len(batch) < b.config.VoteBatchSize {
+				continue
+			}
+		case <-window:
+		}
+
+		go b.send(batch)
+		batch = nil
+		window = nil
+	}
+}
+
+// send votes for all proposals in batch with a single transaction
+// and falls back to single votes if it fails or reverts
+func (b *VoteBatcher) send(batch []*voteRequest) {
+	if len(batch) == 1 {
+		b.voteSingle(batch[0])
+		return
+	}
+
+	references := make([]string, len(batch))
+	for i, req := range batch {
+		references[i] = req.proposal.GetID().Hex()
+	}
+	hash, err := b.sendMulticall(batch, transactor.TransactOptions{References: references})
+	if err != nil {
+		log.Warn().Err(err).Uint8("domainID", b.domainID).Int("votes", len(batch)).Msg("Batched votes failed, falling back to single votes")
+		b.voteSingles(batch)
+		return
+	}
+
+	receipt, err := b.client.WaitAndReturnTxReceipt(*hash)
+	if err != nil {
+		if receipt != nil {
+			log.Warn().Err(err).Uint8("domainID", b.domainID).Str("hash", hash.Hex()).Int("votes", len(batch)).Msg("Batched votes reverted, falling back to single votes")
+			b.voteSingles(batch)
+			return
+		}
+		for _, req := range batch {
+			req.result <- voteResult{err: err}
+		}
+		return
+	}
+
+	log.Info().Uint8("domainID", b.domainID).Str("hash", hash.Hex()).Int("votes", len(batch)).Msg("Sent batched votes")
+	for _, req := range batch {
+		req.result <- voteResult{hash: hash}
+	}
+}
+
+// sendMulticall sends vote calls through multicall contract which appends transaction sender to each call
+func (b *VoteBatcher) sendMulticall(batch []*voteRequest, opts transactor.TransactOptions) (*common.Hash, error) {
+	calls := make([]multicall.Call, len(batch))
+	for i, req := range batch {
+		input, err := b.bridgeContract.ABIAdapter().PackVoteProposal(req.proposal)
+		if err != nil {
+			return nil, err
+		}
+		calls[i] = multicall.Call{
+			Target:   *b.bridgeContract.ContractAddress(),
+			CallData: input,
+		}
+	}
+	return b.multicall.Aggregate(calls, opts)
+}
+
+func (b *VoteBatcher) voteSingles(batch []*voteRequest) {
+	for _, req := range batch {
+		b.voteSingle(req)
+	}
+}
+
+func (b *VoteBatcher) voteSingle(req *voteRequest) {
+	hash, err := b.bridgeContract.VoteProposal(req.proposal, transactor.TransactOptions{
+		GasLimit:   b.config.HandlerGasLimit(req.proposal.HandlerAddress.Hex()),
+		References: []string{req.proposal.GetID().Hex()},
+	})
+	req.result <- voteResult{hash: hash, err: err}
+}
@@ -0,0 +1,206 @@
+package voter_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/multicall"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/voter"
+	mock_voter "github.com/ChainSafe/chainbridge-core/chains/evm/voter/mock"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/voter/proposal"
+	"github.com/ChainSafe/chainbridge-core/config/chain"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type VoteBatcherTestSuite struct {
+	suite.Suite
+	batcher            *voter.VoteBatcher
+	mockMulticall      *mock_voter.MockMulticall
+	mockBridgeContract *mock_voter.MockBatchBridgeContract
+	mockClient         *mock_voter.MockBatchReceiptClient
+	bridgeAddress      common.Address
+	config             *chain.EVMConfig
+	stop               chan struct{}
+}
+
+func TestRunVoteBatcherTestSuite(t *testing.T) {
+	suite.Run(t, new(VoteBatcherTestSuite))
+}
+
+func (s *VoteBatcherTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.mockMulticall = mock_voter.NewMockMulticall(gomockController)
+	s.mockBridgeContract = mock_voter.NewMockBatchBridgeContract(gomockController)
+	s.mockClient = mock_voter.NewMockBatchReceiptClient(gomockController)
+	s.bridgeAddress = common.HexToAddress("0x3162226db165D8eA0f51720CA2bbf44Db2105ADF")
+	s.mockBridgeContract.EXPECT().ABIAdapter().Return(bridge.DefaultABIAdapter()).AnyTimes()
+	s.mockBridgeContract.EXPECT().ContractAddress().Return(&s.bridgeAddress).AnyTimes()
+
+	id := uint8(1)
+	s.config = &chain.EVMConfig{
+		GeneralChainConfig: chain.GeneralChainConfig{Id: &id},
+		GasLimit:           big.NewInt(100000),
+		VoteBatchSize:      2,
+		VoteBatchWindow:    50 * time.Millisecond,
+	}
+	s.batcher = voter.NewVoteBatcher(s.mockMulticall, s.mockBridgeContract, s.mockClient, s.config)
+	s.stop = make(chan struct{})
+	go s.batcher.Start(s.stop)
+}
+
+func (s *VoteBatcherTestSuite) TearDownTest() {
+	close(s.stop)
+}
+
+func (s *VoteBatcherTestSuite) vote(props ...*proposal.Proposal) ([]*common.Hash, []error) {
+	hashes := make([]*common.Hash, len(props))
+	errs := make([]error, len(props))
+	done := make(chan int)
+	for i, p := range props {
+		go func(i int, p *proposal.Proposal) {
+			hashes[i], errs[i] = s.batcher.Vote(p)
+			done <- i
+		}(i, p)
+	}
+	for range props {
+		<-done
+	}
+	return hashes, errs
+}
+
+func (s *VoteBatcherTestSuite) TestVote_FullBatchSentInSingleTransaction() {
+	props := []*proposal.Proposal{
+		{Source: 2, DepositNonce: 1, Data: []byte{1}},
+		{Source: 2, DepositNonce: 2, Data: []byte{2}},
+	}
+	batchHash := common.HexToHash("0xabcd")
+	s.mockMulticall.EXPECT().Aggregate(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(calls []multicall.Call, opts transactor.TransactOptions) (*common.Hash, error) {
+			s.Zero(opts.GasLimit)
+			s.ElementsMatch([]string{props[0].GetID().Hex(), props[1].GetID().Hex()}, opts.References)
+			s.Len(calls, 2)
+			for _, c := range calls {
+				s.Equal(s.bridgeAddress, c.Target)
+				call, err := bridge.DefaultABIAdapter().UnpackVoteProposal(c.CallData)
+				s.Nil(err)
+				s.Equal(uint8(2), call.Source)
+			}
+			return &batchHash, nil
+		})
+	s.mockClient.EXPECT().WaitAndReturnTxReceipt(batchHash).Return(&types.Receipt{Status: types.ReceiptStatusSuccessful}, nil)
+
+	hashes, errs := s.vote(props...)
+
+	for i := range props {
+		s.Nil(errs[i])
+		s.Equal(batchHash, *hashes[i])
+	}
+}
+
+func (s *VoteBatcherTestSuite) TestVote_SingleVoteSentAfterWindow() {
+	hash := common.HexToHash("0x1234")
+	prop := &proposal.Proposal{Source: 2, DepositNonce: 1}
+	s.mockBridgeContract.EXPECT().VoteProposal(prop, transactor.TransactOptions{
+		References: []string{prop.GetID().Hex()},
+	}).Return(&hash, nil)
+
+	hashes, errs := s.vote(prop)
+
+	s.Nil(errs[0])
+	s.Equal(hash, *hashes[0])
+}
+
+func (s *VoteBatcherTestSuite) TestVote_BatchFailure_FallsBackToSingleVotes() {
+	props := []*proposal.Proposal{
+		{Source: 2, DepositNonce: 1, Data: []byte{1}},
+		{Source: 2, DepositNonce: 2, Data: []byte{2}},
+	}
+	hash := common.HexToHash("0x1234")
+	s.mockMulticall.EXPECT().Aggregate(gomock.Any(), gomock.Any()).Return(nil, errors.New("reverted"))
+	s.mockBridgeContract.EXPECT().VoteProposal(props[0], gomock.Any()).Return(&hash, nil)
+	s.mockBridgeContract.EXPECT().VoteProposal(props[1], gomock.Any()).Return(nil, errors.New("error"))
+
+	_, errs := s.vote(props...)
+
+	s.Nil(errs[0])
+	s.NotNil(errs[1])
+}
+
+func (s *VoteBatcherTestSuite) TestVote_BatchReverted_FallsBackToSingleVotes() {
+	props := []*proposal.Proposal{
+		{Source: 2, DepositNonce: 1, Data: []byte{1}},
+		{Source: 2, DepositNonce: 2, Data: []byte{2}},
+	}
+	batchHash := common.HexToHash("0xabcd")
+	hash := common.HexToHash("0x1234")
+	s.mockMulticall.EXPECT().Aggregate(gomock.Any(), gomock.Any()).Return(&batchHash, nil)
+	s.mockClient.EXPECT().WaitAndReturnTxReceipt(batchHash).Return(&types.Receipt{Status: types.ReceiptStatusFailed}, errors.New("reverted"))
+	s.mockBridgeContract.EXPECT().VoteProposal(props[0], gomock.Any()).Return(&hash, nil)
+	s.mockBridgeContract.EXPECT().VoteProposal(props[1], gomock.Any()).Return(&hash, nil)
+
+	hashes, errs := s.vote(props...)
+
+	for i := range props {
+		s.Nil(errs[i])
+		s.Equal(hash, *hashes[i])
+	}
+}
+
+func (s *VoteBatcherTestSuite) TestVote_BatchReceiptNotFound_VotesFail() {
+	props := []*proposal.Proposal{
+		{Source: 2, DepositNonce: 1, Data: []byte{1}},
+		{Source: 2, DepositNonce: 2, Data: []byte{2}},
+	}
+	batchHash := common.HexToHash("0xabcd")
+	s.mockMulticall.EXPECT().Aggregate(gomock.Any(), gomock.Any()).Return(&batchHash, nil)
+	s.mockClient.EXPECT().WaitAndReturnTxReceipt(batchHash).Return(nil, errors.New("tx did not appear"))
+
+	_, errs := s.vote(props...)
+
+	s.NotNil(errs[0])
+	s.NotNil(errs[1])
+}
+
+func (s *VoteBatcherTestSuite) TestVote_BatcherNotStarted_VotesDirectly() {
+	batcher := voter.NewVoteBatcher(s.mockMulticall, s.mockBridgeContract, s.mockClient, s.config)
+	hash := common.HexToHash("0x1234")
+	prop := &proposal.Proposal{Source: 2, DepositNonce: 1}
+	s.mockBridgeContract.EXPECT().VoteProposal(prop, gomock.Any()).Return(&hash, nil)
+
+	res, err := batcher.Vote(prop)
+
+	s.Nil(err)
+	s.Equal(hash, *res)
+}
+
+func (s *VoteBatcherTestSuite) TestVote_BatcherStopped_PendingVotesFail() {
+	s.config.VoteBatchWindow = time.Hour
+	s.config.VoteBatchSize = 5
+	batcher := voter.NewVoteBatcher(s.mockMulticall, s.mockBridgeContract, s.mockClient, s.config)
+	stop := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		batcher.Start(stop)
+		close(stopped)
+	}()
+	res := make(chan error)
+	go func() {
+		_, err := batcher.Vote(&proposal.Proposal{Source: 2, DepositNonce: 1})
+		res <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	close(stop)
+	<-stopped
+
+	s.NotNil(<-res)
+	_, err := batcher.Vote(&proposal.Proposal{Source: 2, DepositNonce: 2})
+	s.NotNil(err)
+}
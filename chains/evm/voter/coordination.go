@@ -0,0 +1,99 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package voter
+
+import (
+	"bytes"
+	"sort"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/voter/proposal"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rs/zerolog/log"
+)
+
+// RankRelayers orders relayers deterministically for proposal by
+// hash of proposal ID and relayer address
+func RankRelayers(propID common.Hash, relayers []common.Address) []common.Address {
+	type rankedRelayer struct {
+		address common.Address
+		rank    common.Hash
+	}
+
+	ranked := make([]rankedRelayer, len(relayers))
+	for i, r := range relayers {
+		ranked[i] = rankedRelayer{address: r, rank: crypto.Keccak256Hash(propID[:], r.Bytes())}
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		return bytes.Compare(ranked[i].rank[:], ranked[j].rank[:]) < 0
+	})
+
+	out := make([]common.Address, len(ranked))
+	for i, r := range ranked {
+		out[i] = r.address
+	}
+	return out
+}
+
+// shouldVoteRanked decides if relayer should vote for proposal based on its rank among bridge relayers.
+// First threshold relayers vote immediately while others vote only if proposal is still not
+// finalised after waiting timeout for each rank they are below threshold, so that lower ranked
+// relayers vote one after another. Relayer with a key pool is ranked by its best ranked key.
+func (v *EVMVoter) shouldVoteRanked(prop *proposal.Proposal, timeout time.Duration) (bool, error) {
+	keys := v.client.RelayerAddresses()
+	state, err := v.bridgeContract.ProposalState(keys, prop)
+	if err != nil {
+		return false, err
+	}
+	if state.HasVoted || isFinalised(state.Status) {
+		return false, nil
+	}
+
+	relayers, err := v.bridgeRelayers()
+	if err != nil {
+		return false, err
+	}
+
+	rank := len(relayers)
+	isKey := make(map[common.Address]bool, len(keys))
+	for _, k := range keys {
+		isKey[k] = true
+	}
+	for i, r := range RankRelayers(prop.GetID(), relayers) {
+		if isKey[r] {
+			rank = i
+			break
+		}
+	}
+	if rank < int(state.Threshold) {
+		return true, nil
+	}
+
+	wait := time.Duration(rank-int(state.Threshold)+1) * timeout
+	log.Debug().Uint8("src", prop.Source).Uint64("nonce", prop.DepositNonce).Int("rank", rank).Msgf("Waiting %s for votes of higher ranked relayers", wait)
+	Sleep(wait)
+
+	ps, err := v.bridgeContract.ProposalStatus(prop)
+	if err != nil {
+		return false, err
+	}
+	return !isFinalised(ps), nil
+}
+
+// bridgeRelayers returns relayers registered on the bridge
+func (v *EVMVoter) bridgeRelayers() ([]common.Address, error) {
+	if v.relayers != nil {
+		return v.relayers.Relayers()
+	}
+	return v.bridgeContract.GetRelayers()
+}
+
+// isFinalised returns true if proposal does not need more votes
+func isFinalised(ps message.ProposalStatus) bool {
+	return ps.Status == message.ProposalStatusPassed ||
+		ps.Status == message.ProposalStatusExecuted ||
+		ps.Status == message.ProposalStatusCanceled
+}
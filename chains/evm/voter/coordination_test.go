@@ -0,0 +1,168 @@
+package voter_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/consts"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/voter"
+	mock_voter "github.com/ChainSafe/chainbridge-core/chains/evm/voter/mock"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/voter/proposal"
+	"github.com/ChainSafe/chainbridge-core/config/chain"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type RankedVotingTestSuite struct {
+	suite.Suite
+	voter              *voter.EVMVoter
+	mockMessageHandler *mock_voter.MockMessageHandler
+	mockClient         *mock_voter.MockChainClient
+	mockBridgeContract *mock_voter.MockBridgeContract
+	chainConfig        *chain.EVMConfig
+	proposal           *proposal.Proposal
+	relayers           []common.Address
+	ranked             []common.Address
+	slept              []time.Duration
+}
+
+func TestRunRankedVotingTestSuite(t *testing.T) {
+	suite.Run(t, new(RankedVotingTestSuite))
+}
+
+func (s *RankedVotingTestSuite) SetupSuite()    {}
+func (s *RankedVotingTestSuite) TearDownSuite() {}
+func (s *RankedVotingTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.mockMessageHandler = mock_voter.NewMockMessageHandler(gomockController)
+	s.mockClient = mock_voter.NewMockChainClient(gomockController)
+	s.mockBridgeContract = mock_voter.NewMockBridgeContract(gomockController)
+	s.voter = voter.NewVoter(
+		s.mockMessageHandler,
+		s.mockClient,
+		s.mockBridgeContract,
+		1,
+	)
+	s.chainConfig = &chain.EVMConfig{
+		GasLimit:         big.NewInt(consts.DefaultGasLimit),
+		VoteCoordination: chain.VoteCoordinationRanked,
+		VoteTimeout:      time.Minute,
+	}
+	s.proposal = proposal.NewProposal(2, 1, 5, [32]byte{1}, []byte{1, 2, 3}, common.HexToAddress("0x1"), common.HexToAddress("0x2"), common.Hash{}, 0)
+	s.relayers = []common.Address{common.HexToAddress("0xa"), common.HexToAddress("0xb"), common.HexToAddress("0xc")}
+	s.ranked = voter.RankRelayers(s.proposal.GetID(), s.relayers)
+	s.slept = nil
+	voter.Sleep = func(d time.Duration) {
+		s.slept = append(s.slept, d)
+	}
+
+	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(s.proposal, nil).AnyTimes()
+	s.mockBridgeContract.EXPECT().GetRelayers().Return(s.relayers, nil).AnyTimes()
+}
+func (s *RankedVotingTestSuite) TearDownTest() {}
+
+func (s *RankedVotingTestSuite) expectState(status uint8) *gomock.Call {
+	return s.mockBridgeContract.EXPECT().ProposalState(gomock.Any(), gomock.Any()).Return(&bridge.ProposalState{
+		Status:    message.ProposalStatus{Status: status},
+		Threshold: 2,
+	}, nil)
+}
+
+func (s *RankedVotingTestSuite) expectVote() {
+	s.mockBridgeContract.EXPECT().SimulateVoteProposal(gomock.Any()).Return(nil)
+	s.mockBridgeContract.EXPECT().VoteProposal(gomock.Any(), gomock.Any()).Return(&common.Hash{}, nil)
+}
+
+func (s *RankedVotingTestSuite) TestRankRelayers_Deterministic() {
+	reversed := []common.Address{s.relayers[2], s.relayers[1], s.relayers[0]}
+
+	s.Equal(s.ranked, voter.RankRelayers(s.proposal.GetID(), reversed))
+	s.ElementsMatch(s.relayers, s.ranked)
+}
+
+func (s *RankedVotingTestSuite) TestVoteProposal_TopRankedVotesImmediately() {
+	s.mockClient.EXPECT().RelayerAddresses().Return([]common.Address{s.ranked[1]}).AnyTimes()
+	s.expectState(message.ProposalStatusActive).Times(2)
+	s.expectVote()
+
+	err := s.voter.VoteProposal(&message.Message{}, s.chainConfig)
+
+	s.Nil(err)
+	s.Empty(s.slept)
+}
+
+func (s *RankedVotingTestSuite) TestVoteProposal_LowRankedVotesAfterTimeout() {
+	s.mockClient.EXPECT().RelayerAddresses().Return([]common.Address{s.ranked[2]}).AnyTimes()
+	s.expectState(message.ProposalStatusActive).Times(2)
+	s.mockBridgeContract.EXPECT().ProposalStatus(gomock.Any()).Return(message.ProposalStatus{Status: message.ProposalStatusActive}, nil)
+	s.expectVote()
+
+	err := s.voter.VoteProposal(&message.Message{}, s.chainConfig)
+
+	s.Nil(err)
+	s.Equal([]time.Duration{time.Minute}, s.slept)
+}
+
+func (s *RankedVotingTestSuite) TestVoteProposal_RankedByBestRankedPoolKey() {
+	s.mockClient.EXPECT().RelayerAddresses().Return([]common.Address{s.ranked[2], s.ranked[1]}).AnyTimes()
+	s.expectState(message.ProposalStatusActive).Times(2)
+	s.expectVote()
+
+	err := s.voter.VoteProposal(&message.Message{}, s.chainConfig)
+
+	s.Nil(err)
+	s.Empty(s.slept)
+}
+
+func (s *RankedVotingTestSuite) TestVoteProposal_LowRankedSkipsPassedProposal() {
+	s.mockClient.EXPECT().RelayerAddresses().Return([]common.Address{s.ranked[2]}).AnyTimes()
+	s.expectState(message.ProposalStatusActive).Times(2)
+	s.mockBridgeContract.EXPECT().ProposalStatus(gomock.Any()).Return(message.ProposalStatus{Status: message.ProposalStatusPassed}, nil)
+
+	err := s.voter.VoteProposal(&message.Message{}, s.chainConfig)
+
+	s.Nil(err)
+	s.Equal([]time.Duration{time.Minute}, s.slept)
+}
+
+func (s *RankedVotingTestSuite) TestVoteProposal_FinalisedProposalNotVoted() {
+	s.mockClient.EXPECT().RelayerAddresses().Return([]common.Address{s.ranked[0]}).AnyTimes()
+	s.expectState(message.ProposalStatusExecuted)
+
+	err := s.voter.VoteProposal(&message.Message{}, s.chainConfig)
+
+	s.Nil(err)
+	s.Empty(s.slept)
+}
+
+func (s *RankedVotingTestSuite) TestVoteProposal_WaitStaggeredByRank() {
+	s.mockClient.EXPECT().RelayerAddresses().Return([]common.Address{s.ranked[2]}).AnyTimes()
+	s.mockBridgeContract.EXPECT().ProposalState(gomock.Any(), gomock.Any()).Return(&bridge.ProposalState{
+		Status:    message.ProposalStatus{Status: message.ProposalStatusActive},
+		Threshold: 1,
+	}, nil).Times(2)
+	s.mockBridgeContract.EXPECT().ProposalStatus(gomock.Any()).Return(message.ProposalStatus{Status: message.ProposalStatusPassed}, nil)
+
+	err := s.voter.VoteProposal(&message.Message{}, s.chainConfig)
+
+	s.Nil(err)
+	s.Equal([]time.Duration{2 * time.Minute}, s.slept)
+}
+
+func (s *RankedVotingTestSuite) TestVoteProposal_RelayersReadFromProvider() {
+	mockProvider := mock_voter.NewMockRelayerProvider(gomock.NewController(s.T()))
+	s.voter.SetRelayerProvider(mockProvider)
+	mockProvider.EXPECT().Relayers().Return([]common.Address{s.ranked[0]}, nil)
+	s.mockClient.EXPECT().RelayerAddresses().Return([]common.Address{s.ranked[0]}).AnyTimes()
+	s.expectState(message.ProposalStatusActive).Times(2)
+	s.expectVote()
+
+	err := s.voter.VoteProposal(&message.Message{}, s.chainConfig)
+
+	s.Nil(err)
+	s.Empty(s.slept)
+}
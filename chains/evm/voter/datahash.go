@@ -0,0 +1,255 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package voter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/voter/proposal"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/util"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// voteBackfillBlocks is the number of latest blocks from which ProposalVote logs are read on start
+	voteBackfillBlocks = 10000
+	// voteBackfillRange is the number of blocks from which logs are read in a single request
+	voteBackfillRange = 1000
+)
+
+// ErrDataHashMismatch is returned if other relayers voted for the same deposit
+// with proposal data different from the data computed by this relayer
+var ErrDataHashMismatch = errors.New("proposal data hash mismatch")
+
+type proposalKey struct {
+	source uint8
+	nonce  uint64
+}
+
+type votedDataHashes struct {
+	// voteTxs maps voted data hash to transaction hash of the first vote for it
+	voteTxs map[common.Hash]common.Hash
+	updated time.Time
+}
+
+// VoteLogFetcher reads bridge event logs from chain
+type VoteLogFetcher interface {
+	LatestBlock() (*big.Int, error)
+	FetchEventLogs(ctx context.Context, contractAddress common.Address, event string, startBlock *big.Int, endBlock *big.Int) ([]ethereumTypes.Log, error)
+}
+
+// ProposalVoteTracker records data hashes relayers voted for on the bridge
+// by source domain and deposit nonce. It is safe for concurrent use.
+type ProposalVoteTracker struct {
+	events        <-chan *bridge.Event
+	fetcher       VoteLogFetcher
+	bridgeAddress common.Address
+	adapter       bridge.ABIAdapter
+	votes         map[proposalKey]*votedDataHashes
+	ttl           time.Duration
+	lastPrune     time.Time
+	lock          sync.Mutex
+}
+
+// NewProposalVoteTracker creates a ProposalVoteTracker. Events channel should receive
+// bridge ProposalVote events from the destination chain listener. Votes cast before
+// the tracker started are read from ProposalVote logs of the latest blocks with fetcher.
+func NewProposalVoteTracker(events <-chan *bridge.Event, fetcher VoteLogFetcher, bridgeAddress common.Address, adapter bridge.ABIAdapter) *ProposalVoteTracker {
+	return &ProposalVoteTracker{
+		events:        events,
+		fetcher:       fetcher,
+		bridgeAddress: bridgeAddress,
+		adapter:       adapter,
+		votes:         make(map[proposalKey]*votedDataHashes),
+		ttl:           pendingVotesTTL,
+		lastPrune:     time.Now(),
+	}
+}
+
+// Start reads votes from the latest blocks and records ProposalVote events until stop channel is closed
+func (t *ProposalVoteTracker) Start(stop <-chan struct{}) {
+	go t.backfill(stop)
+
+	for {
+		select {
+		case <-stop:
+			return
+		case evt := <-t.events:
+			t.recordEvent(evt)
+		}
+	}
+}
+
+// backfill records votes from ProposalVote logs of the last voteBackfillBlocks blocks
+func (t *ProposalVoteTracker) backfill(stop <-chan struct{}) {
+	head, err := t.fetcher.LatestBlock()
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed reading latest block, votes cast before start are not tracked")
+		return
+	}
+
+	start := new(big.Int).Sub(head, big.NewInt(voteBackfillBlocks))
+	if start.Sign() < 0 {
+		start = big.NewInt(0)
+	}
+	for start.Cmp(head) <= 0 {
+		select {
+		case <-stop:
+			return
+		default:
+		}
+
+		end := new(big.Int).Add(start, big.NewInt(voteBackfillRange-1))
+		if end.Cmp(head) > 0 {
+			end = head
+		}
+		logs, err := t.fetcher.FetchEventLogs(context.Background(), t.bridgeAddress, string(t.adapter.EventSig(util.ProposalVote)), start, end)
+		if err != nil {
+			log.Warn().Err(err).Str("startBlock", start.String()).Str("endBlock", end.String()).Msg("Failed reading proposal votes")
+			return
+		}
+		for _, l := range logs {
+			evt, err := t.adapter.UnpackEventLog(l)
+			if err != nil {
+				continue
+			}
+			t.recordEvent(evt)
+		}
+		start = new(big.Int).Add(end, big.NewInt(1))
+	}
+}
+
+func (t *ProposalVoteTracker) recordEvent(evt *bridge.Event) {
+	if evt.Sig != util.ProposalVote {
+		return
+	}
+	vote, ok := evt.Data.(*bridge.ProposalVote)
+	if !ok {
+		return
+	}
+	t.Record(vote.OriginDomainID, vote.DepositNonce, vote.DataHash, evt.Log.TxHash)
+}
+
+// Record stores data hash voted for in voteTx
+func (t *ProposalVoteTracker) Record(source uint8, nonce uint64, dataHash common.Hash, voteTx common.Hash) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	now := time.Now()
+	if now.Sub(t.lastPrune) >= t.ttl {
+		for k, v := range t.votes {
+			if now.Sub(v.updated) > t.ttl {
+				delete(t.votes, k)
+			}
+		}
+		t.lastPrune = now
+	}
+
+	key := proposalKey{source: source, nonce: nonce}
+	v, ok := t.votes[key]
+	if !ok {
+		v = &votedDataHashes{voteTxs: make(map[common.Hash]common.Hash)}
+		t.votes[key] = v
+	}
+	if _, ok := v.voteTxs[dataHash]; !ok {
+		v.voteTxs[dataHash] = voteTx
+	}
+	v.updated = now
+}
+
+// VotedDataHashes returns data hashes voted for deposit mapped to
+// transaction hash of the first vote for each of them
+func (t *ProposalVoteTracker) VotedDataHashes(source uint8, nonce uint64) map[common.Hash]common.Hash {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	hashes := make(map[common.Hash]common.Hash)
+	v, ok := t.votes[proposalKey{source: source, nonce: nonce}]
+	if !ok {
+		return hashes
+	}
+	for dataHash, tx := range v.voteTxs {
+		hashes[dataHash] = tx
+	}
+	return hashes
+}
+
+// checkDataHash reads proposals voted for the same deposit with a different data hash
+// from the bridge and refuses voting if any of them has votes. An alert with both
+// proposal payloads is emitted on mismatch.
+func (v *EVMVoter) checkDataHash(prop *proposal.Proposal) error {
+	if v.votedDataHashes == nil {
+		return nil
+	}
+
+	dataHash := prop.GetDataHash()
+	for otherHash, voteTx := range v.votedDataHashes.VotedDataHashes(prop.Source, prop.DepositNonce) {
+		if otherHash == dataHash {
+			continue
+		}
+
+		ps, err := v.bridgeContract.GetProposal(prop.Source, prop.DepositNonce, otherHash)
+		if err != nil {
+			return err
+		}
+		if ps.Status == message.ProposalStatusInactive {
+			continue
+		}
+
+		v.alertDataHashMismatch(prop, otherHash, voteTx, ps)
+		return fmt.Errorf("%w: deposit %d from domain %d voted with data hash %s instead of %s", ErrDataHashMismatch, prop.DepositNonce, prop.Source, otherHash.Hex(), dataHash.Hex())
+	}
+	return nil
+}
+
+func (v *EVMVoter) alertDataHashMismatch(prop *proposal.Proposal, otherHash common.Hash, voteTx common.Hash, ps message.ProposalStatus) {
+	details := map[string]interface{}{
+		"source":        prop.Source,
+		"depositNonce":  prop.DepositNonce,
+		"depositTxHash": prop.DepositTxHash.Hex(),
+		"resourceID":    hexutil.Encode(prop.ResourceId[:]),
+		"handler":       prop.HandlerAddress.Hex(),
+		"dataHash":      prop.GetDataHash().Hex(),
+		"data":          hexutil.Encode(prop.Data),
+		"otherDataHash": otherHash.Hex(),
+		"otherVoteTx":   voteTx.Hex(),
+		"otherStatus":   message.StatusMap[ps.Status],
+		"otherYesVotes": ps.YesVotesTotal,
+	}
+
+	call, err := v.voteProposalCall(voteTx)
+	if err != nil {
+		log.Warn().Err(err).Str("txHash", voteTx.Hex()).Msg("Failed reading payload of mismatched proposal vote")
+	} else {
+		details["otherResourceID"] = hexutil.Encode(call.ResourceID[:])
+		details["otherData"] = hexutil.Encode(call.Data)
+	}
+
+	v.alerter.Alert(&Alert{
+		Kind:     AlertDataHashMismatch,
+		Severity: SeverityHigh,
+		DomainID: v.domainID,
+		Message:  "Relayers voted for deposit with different proposal data",
+		Details:  details,
+	})
+}
+
+// voteProposalCall decodes arguments of voteProposal transaction
+func (v *EVMVoter) voteProposalCall(txHash common.Hash) (*bridge.VoteProposalCall, error) {
+	tx, _, err := v.client.TransactionByHash(context.Background(), txHash)
+	if err != nil {
+		return nil, err
+	}
+	return v.bridgeContract.ABIAdapter().UnpackVoteProposal(tx.Data())
+}
@@ -0,0 +1,252 @@
+package voter_test
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/consts"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/voter"
+	mock_voter "github.com/ChainSafe/chainbridge-core/chains/evm/voter/mock"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/voter/proposal"
+	"github.com/ChainSafe/chainbridge-core/config/chain"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/util"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type DataHashTestSuite struct {
+	suite.Suite
+	voter              *voter.EVMVoter
+	mockMessageHandler *mock_voter.MockMessageHandler
+	mockClient         *mock_voter.MockChainClient
+	mockBridgeContract *mock_voter.MockBridgeContract
+	mockTracker        *mock_voter.MockDataHashTracker
+	mockAlerter        *mock_voter.MockAlerter
+	chainConfig        *chain.EVMConfig
+	proposal           *proposal.Proposal
+}
+
+func TestRunDataHashTestSuite(t *testing.T) {
+	suite.Run(t, new(DataHashTestSuite))
+}
+
+func (s *DataHashTestSuite) SetupSuite()    {}
+func (s *DataHashTestSuite) TearDownSuite() {}
+func (s *DataHashTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.mockMessageHandler = mock_voter.NewMockMessageHandler(gomockController)
+	s.mockClient = mock_voter.NewMockChainClient(gomockController)
+	s.mockBridgeContract = mock_voter.NewMockBridgeContract(gomockController)
+	s.mockTracker = mock_voter.NewMockDataHashTracker(gomockController)
+	s.mockAlerter = mock_voter.NewMockAlerter(gomockController)
+	s.voter = voter.NewVoter(
+		s.mockMessageHandler,
+		s.mockClient,
+		s.mockBridgeContract,
+		1,
+	)
+	s.voter.SetDataHashTracker(s.mockTracker, s.mockAlerter)
+	s.chainConfig = &chain.EVMConfig{GasLimit: big.NewInt(consts.DefaultGasLimit)}
+	s.proposal = proposal.NewProposal(2, 1, 5, [32]byte{1}, []byte{1, 2, 3}, common.HexToAddress("0x1"), common.HexToAddress("0x2"), common.Hash{}, 10)
+	voter.Sleep = func(d time.Duration) {}
+
+	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(s.proposal, nil)
+	s.mockClient.EXPECT().RelayerAddresses().Return([]common.Address{{}})
+	s.mockBridgeContract.EXPECT().ProposalState(gomock.Any(), gomock.Any()).Return(&bridge.ProposalState{
+		Status:    message.ProposalStatus{Status: message.ProposalStatusActive},
+		Threshold: 2,
+	}, nil)
+}
+func (s *DataHashTestSuite) TearDownTest() {}
+
+func (s *DataHashTestSuite) expectVote() {
+	s.mockClient.EXPECT().RelayerAddresses().Return([]common.Address{{}})
+	s.mockBridgeContract.EXPECT().ProposalState(gomock.Any(), gomock.Any()).Return(&bridge.ProposalState{
+		Status:    message.ProposalStatus{Status: message.ProposalStatusActive},
+		Threshold: 2,
+	}, nil)
+	s.mockBridgeContract.EXPECT().SimulateVoteProposal(gomock.Any()).Return(nil)
+	s.mockBridgeContract.EXPECT().VoteProposal(gomock.Any(), gomock.Any()).Return(&common.Hash{}, nil)
+}
+
+func (s *DataHashTestSuite) TestVoteProposal_NoOtherVotes() {
+	s.mockTracker.EXPECT().VotedDataHashes(uint8(2), uint64(5)).Return(map[common.Hash]common.Hash{})
+	s.expectVote()
+
+	err := s.voter.VoteProposal(&message.Message{}, s.chainConfig)
+
+	s.Nil(err)
+}
+
+func (s *DataHashTestSuite) TestVoteProposal_SameDataHash() {
+	s.mockTracker.EXPECT().VotedDataHashes(uint8(2), uint64(5)).Return(map[common.Hash]common.Hash{
+		s.proposal.GetDataHash(): common.HexToHash("0xa"),
+	})
+	s.expectVote()
+
+	err := s.voter.VoteProposal(&message.Message{}, s.chainConfig)
+
+	s.Nil(err)
+}
+
+func (s *DataHashTestSuite) TestVoteProposal_OtherProposalInactive() {
+	otherHash := common.HexToHash("0xb")
+	s.mockTracker.EXPECT().VotedDataHashes(uint8(2), uint64(5)).Return(map[common.Hash]common.Hash{
+		otherHash: common.HexToHash("0xa"),
+	})
+	s.mockBridgeContract.EXPECT().GetProposal(uint8(2), uint64(5), otherHash).Return(message.ProposalStatus{Status: message.ProposalStatusInactive}, nil)
+	s.expectVote()
+
+	err := s.voter.VoteProposal(&message.Message{}, s.chainConfig)
+
+	s.Nil(err)
+}
+
+func (s *DataHashTestSuite) TestVoteProposal_GetProposalError() {
+	otherHash := common.HexToHash("0xb")
+	s.mockTracker.EXPECT().VotedDataHashes(uint8(2), uint64(5)).Return(map[common.Hash]common.Hash{
+		otherHash: common.HexToHash("0xa"),
+	})
+	s.mockBridgeContract.EXPECT().GetProposal(uint8(2), uint64(5), otherHash).Return(message.ProposalStatus{}, errors.New("error"))
+
+	err := s.voter.VoteProposal(&message.Message{}, s.chainConfig)
+
+	s.NotNil(err)
+}
+
+func (s *DataHashTestSuite) TestVoteProposal_DataHashMismatch() {
+	otherProposal := *s.proposal
+	otherProposal.Data = []byte{6, 6, 6}
+	otherHash := otherProposal.GetDataHash()
+	voteTx := common.HexToHash("0xa")
+	calldata, err := bridge.DefaultABIAdapter().PackVoteProposal(&otherProposal)
+	s.Nil(err)
+
+	s.mockTracker.EXPECT().VotedDataHashes(uint8(2), uint64(5)).Return(map[common.Hash]common.Hash{
+		otherHash: voteTx,
+	})
+	s.mockBridgeContract.EXPECT().GetProposal(uint8(2), uint64(5), otherHash).Return(message.ProposalStatus{Status: message.ProposalStatusActive, YesVotesTotal: 1}, nil)
+	s.mockClient.EXPECT().TransactionByHash(gomock.Any(), voteTx).Return(
+		ethereumTypes.NewTransaction(1, common.HexToAddress("0x2"), big.NewInt(0), 0, big.NewInt(0), calldata), false, nil,
+	)
+	s.mockBridgeContract.EXPECT().ABIAdapter().Return(bridge.DefaultABIAdapter())
+	s.mockAlerter.EXPECT().Alert(gomock.Any()).Do(func(a *voter.Alert) {
+		s.Equal(voter.AlertDataHashMismatch, a.Kind)
+		s.Equal(voter.SeverityHigh, a.Severity)
+		s.Equal(hexutil.Encode([]byte{1, 2, 3}), a.Details["data"])
+		s.Equal(hexutil.Encode([]byte{6, 6, 6}), a.Details["otherData"])
+	})
+
+	err = s.voter.VoteProposal(&message.Message{}, s.chainConfig)
+
+	s.ErrorIs(err, voter.ErrDataHashMismatch)
+}
+
+func (s *DataHashTestSuite) TestVoteProposal_DataHashMismatchVoteTxNotFound() {
+	otherHash := common.HexToHash("0xb")
+	voteTx := common.HexToHash("0xa")
+	s.mockTracker.EXPECT().VotedDataHashes(uint8(2), uint64(5)).Return(map[common.Hash]common.Hash{
+		otherHash: voteTx,
+	})
+	s.mockBridgeContract.EXPECT().GetProposal(uint8(2), uint64(5), otherHash).Return(message.ProposalStatus{Status: message.ProposalStatusPassed}, nil)
+	s.mockClient.EXPECT().TransactionByHash(gomock.Any(), voteTx).Return(nil, false, errors.New("not found"))
+	s.mockAlerter.EXPECT().Alert(gomock.Any())
+
+	err := s.voter.VoteProposal(&message.Message{}, s.chainConfig)
+
+	s.ErrorIs(err, voter.ErrDataHashMismatch)
+}
+
+type ProposalVoteTrackerTestSuite struct {
+	suite.Suite
+	events      chan *bridge.Event
+	mockFetcher *mock_voter.MockVoteLogFetcher
+	tracker     *voter.ProposalVoteTracker
+}
+
+func TestRunProposalVoteTrackerTestSuite(t *testing.T) {
+	suite.Run(t, new(ProposalVoteTrackerTestSuite))
+}
+
+func (s *ProposalVoteTrackerTestSuite) SetupSuite()    {}
+func (s *ProposalVoteTrackerTestSuite) TearDownSuite() {}
+func (s *ProposalVoteTrackerTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.mockFetcher = mock_voter.NewMockVoteLogFetcher(gomockController)
+	s.events = make(chan *bridge.Event)
+	s.tracker = voter.NewProposalVoteTracker(s.events, s.mockFetcher, common.HexToAddress("0x2"), bridge.DefaultABIAdapter())
+}
+func (s *ProposalVoteTrackerTestSuite) TearDownTest() {}
+
+func (s *ProposalVoteTrackerTestSuite) TestStart_RecordsProposalVotes() {
+	s.mockFetcher.EXPECT().LatestBlock().Return(big.NewInt(0), nil).AnyTimes()
+	s.mockFetcher.EXPECT().FetchEventLogs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]ethereumTypes.Log{}, nil).AnyTimes()
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		s.tracker.Start(stop)
+		close(done)
+	}()
+
+	s.events <- &bridge.Event{
+		Sig:  util.ProposalVote,
+		Log:  ethereumTypes.Log{TxHash: common.HexToHash("0xa")},
+		Data: &bridge.ProposalVote{OriginDomainID: 2, DepositNonce: 5, DataHash: common.HexToHash("0x1")},
+	}
+	s.events <- &bridge.Event{
+		Sig:  util.ProposalVote,
+		Log:  ethereumTypes.Log{TxHash: common.HexToHash("0xb")},
+		Data: &bridge.ProposalVote{OriginDomainID: 2, DepositNonce: 5, DataHash: common.HexToHash("0x1")},
+	}
+	s.events <- &bridge.Event{
+		Sig:  util.ProposalVote,
+		Log:  ethereumTypes.Log{TxHash: common.HexToHash("0xc")},
+		Data: &bridge.ProposalVote{OriginDomainID: 2, DepositNonce: 5, DataHash: common.HexToHash("0x2")},
+	}
+	s.events <- &bridge.Event{
+		Sig:  util.ProposalVote,
+		Log:  ethereumTypes.Log{TxHash: common.HexToHash("0xd")},
+		Data: &bridge.ProposalVote{OriginDomainID: 3, DepositNonce: 5, DataHash: common.HexToHash("0x3")},
+	}
+	close(stop)
+	<-done
+
+	s.Equal(map[common.Hash]common.Hash{
+		common.HexToHash("0x1"): common.HexToHash("0xa"),
+		common.HexToHash("0x2"): common.HexToHash("0xc"),
+	}, s.tracker.VotedDataHashes(2, 5))
+	s.Equal(map[common.Hash]common.Hash{}, s.tracker.VotedDataHashes(2, 6))
+}
+
+func (s *ProposalVoteTrackerTestSuite) TestStart_RecordsVotesFromLatestBlocks() {
+	bridgeABI, _ := abi.JSON(strings.NewReader(consts.BridgeABI))
+	data, err := bridgeABI.Events["ProposalVote"].Inputs.NonIndexed().Pack(uint8(2), uint64(5), uint8(1), common.HexToHash("0x1"))
+	s.Nil(err)
+	voteLog := ethereumTypes.Log{Topics: []common.Hash{util.ProposalVote.GetTopic()}, Data: data, TxHash: common.HexToHash("0xa")}
+	s.mockFetcher.EXPECT().LatestBlock().Return(big.NewInt(11500), nil)
+	gomock.InOrder(
+		s.mockFetcher.EXPECT().FetchEventLogs(gomock.Any(), common.HexToAddress("0x2"), string(util.ProposalVote), big.NewInt(1500), big.NewInt(2499)).Return([]ethereumTypes.Log{voteLog}, nil),
+		s.mockFetcher.EXPECT().FetchEventLogs(gomock.Any(), common.HexToAddress("0x2"), string(util.ProposalVote), gomock.Any(), gomock.Any()).Return([]ethereumTypes.Log{}, nil).Times(9),
+		s.mockFetcher.EXPECT().FetchEventLogs(gomock.Any(), common.HexToAddress("0x2"), string(util.ProposalVote), big.NewInt(11500), big.NewInt(11500)).Return([]ethereumTypes.Log{}, nil),
+	)
+	stop := make(chan struct{})
+	defer close(stop)
+
+	go s.tracker.Start(stop)
+
+	s.Eventually(func() bool {
+		return len(s.tracker.VotedDataHashes(2, 5)) == 1
+	}, time.Second, time.Millisecond)
+	s.Equal(map[common.Hash]common.Hash{
+		common.HexToHash("0x1"): common.HexToHash("0xa"),
+	}, s.tracker.VotedDataHashes(2, 5))
+}
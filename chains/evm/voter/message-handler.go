@@ -7,6 +7,7 @@ import (
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/voter/proposal"
 	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rs/zerolog/log"
 	"math/big"
@@ -47,6 +48,17 @@ func (mh *EVMMessageHandler) HandleMessage(m *message.Message) (*proposal.Propos
 	return prop, nil
 }
 
+// HandlesResource checks if resourceID is registered on the bridge contract
+// with a handler that has a message handler function registered
+func (mh *EVMMessageHandler) HandlesResource(resourceID types.ResourceID) (bool, error) {
+	addr, err := mh.bridgeContract.GetHandlerAddressForResourceID(resourceID)
+	if err != nil {
+		return false, err
+	}
+	_, ok := mh.handlers[addr]
+	return ok, nil
+}
+
 func (mh *EVMMessageHandler) MatchAddressWithHandlerFunc(addr common.Address) (MessageHandlerFunc, error) {
 	h, ok := mh.handlers[addr]
 	if !ok {
@@ -86,7 +98,7 @@ func ERC20MessageHandler(msg *message.Message, handlerAddr, bridgeAddress common
 	recipientLen := big.NewInt(int64(len(recipient))).Bytes()
 	data = append(data, common.LeftPadBytes(recipientLen, 32)...) // length of recipient (uint256)
 	data = append(data, recipient...)                             // recipient ([]byte)
-	return proposal.NewProposal(msg.Source, msg.DepositNonce, msg.ResourceId, data, handlerAddr, bridgeAddress, msg.DepositTxHash, msg.DepositBlock), nil
+	return proposal.NewProposal(msg.Source, msg.Destination, msg.DepositNonce, msg.ResourceId, data, handlerAddr, bridgeAddress, msg.DepositTxHash, msg.DepositBlock), nil
 }
 
 func ERC721MessageHandler(msg *message.Message, handlerAddr, bridgeAddress common.Address) (*proposal.Proposal, error) {
@@ -113,7 +125,7 @@ func ERC721MessageHandler(msg *message.Message, handlerAddr, bridgeAddress commo
 	metadataLen := big.NewInt(int64(len(metadata))).Bytes()
 	data.Write(common.LeftPadBytes(metadataLen, 32))
 	data.Write(metadata)
-	return proposal.NewProposal(msg.Source, msg.DepositNonce, msg.ResourceId, data.Bytes(), handlerAddr, bridgeAddress, msg.DepositTxHash, msg.DepositBlock), nil
+	return proposal.NewProposal(msg.Source, msg.Destination, msg.DepositNonce, msg.ResourceId, data.Bytes(), handlerAddr, bridgeAddress, msg.DepositTxHash, msg.DepositBlock), nil
 }
 
 func GenericMessageHandler(msg *message.Message, handlerAddr, bridgeAddress common.Address) (*proposal.Proposal, error) {
@@ -128,5 +140,5 @@ func GenericMessageHandler(msg *message.Message, handlerAddr, bridgeAddress comm
 	metadataLen := big.NewInt(int64(len(metadata))).Bytes()
 	data.Write(common.LeftPadBytes(metadataLen, 32)) // length of metadata (uint256)
 	data.Write(metadata)
-	return proposal.NewProposal(msg.Source, msg.DepositNonce, msg.ResourceId, data.Bytes(), handlerAddr, bridgeAddress, msg.DepositTxHash, msg.DepositBlock), nil
+	return proposal.NewProposal(msg.Source, msg.Destination, msg.DepositNonce, msg.ResourceId, data.Bytes(), handlerAddr, bridgeAddress, msg.DepositTxHash, msg.DepositBlock), nil
 }
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/ChainSafe/chainbridge-core/chains/evm/voter (interfaces: ChainClient,MessageHandler,BridgeContract)
+// Source: github.com/ChainSafe/chainbridge-core/chains/evm/voter (interfaces: ChainClient,MessageHandler,BridgeContract,Voter,PausableBridge,MessageQueue,MessageVerifier,SourceChainClient,DepositEventHandler,DataHashTracker,Alerter,ProposalRecorder,BatchVoter,Multicall,BatchBridgeContract,BatchReceiptClient,SentTxStore,GasBudget,VoteLogFetcher,Revoter,RelayerGetter,RelayerProvider)
 
 // Package mock_voter is a generated GoMock package.
 package mock_voter
@@ -9,13 +9,18 @@ import (
 	big "math/big"
 	reflect "reflect"
 
+	bridge "github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
+	multicall "github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/multicall"
 	evmclient "github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
 	transactor "github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	voter "github.com/ChainSafe/chainbridge-core/chains/evm/voter"
 	proposal "github.com/ChainSafe/chainbridge-core/chains/evm/voter/proposal"
+	chain "github.com/ChainSafe/chainbridge-core/config/chain"
 	message "github.com/ChainSafe/chainbridge-core/relayer/message"
+	types "github.com/ChainSafe/chainbridge-core/types"
+	ethereum "github.com/ethereum/go-ethereum"
 	common "github.com/ethereum/go-ethereum/common"
-	types "github.com/ethereum/go-ethereum/core/types"
-	rpc "github.com/ethereum/go-ethereum/rpc"
+	types0 "github.com/ethereum/go-ethereum/core/types"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -42,6 +47,21 @@ func (m *MockChainClient) EXPECT() *MockChainClientMockRecorder {
 	return m.recorder
 }
 
+// BatchCallContract mocks base method.
+func (m *MockChainClient) BatchCallContract(arg0 context.Context, arg1 []map[string]interface{}, arg2 *big.Int) ([][]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchCallContract", arg0, arg1, arg2)
+	ret0, _ := ret[0].([][]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchCallContract indicates an expected call of BatchCallContract.
+func (mr *MockChainClientMockRecorder) BatchCallContract(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchCallContract", reflect.TypeOf((*MockChainClient)(nil).BatchCallContract), arg0, arg1, arg2)
+}
+
 // CallContract mocks base method.
 func (m *MockChainClient) CallContract(arg0 context.Context, arg1 map[string]interface{}, arg2 *big.Int) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -72,6 +92,21 @@ func (mr *MockChainClientMockRecorder) CodeAt(arg0, arg1, arg2 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CodeAt", reflect.TypeOf((*MockChainClient)(nil).CodeAt), arg0, arg1, arg2)
 }
 
+// EstimateGas mocks base method.
+func (m *MockChainClient) EstimateGas(arg0 context.Context, arg1 ethereum.CallMsg) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EstimateGas", arg0, arg1)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EstimateGas indicates an expected call of EstimateGas.
+func (mr *MockChainClientMockRecorder) EstimateGas(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateGas", reflect.TypeOf((*MockChainClient)(nil).EstimateGas), arg0, arg1)
+}
+
 // From mocks base method.
 func (m *MockChainClient) From() common.Address {
 	m.ctrl.T.Helper()
@@ -87,10 +122,10 @@ func (mr *MockChainClientMockRecorder) From() *gomock.Call {
 }
 
 // GetTransactionByHash mocks base method.
-func (m *MockChainClient) GetTransactionByHash(arg0 common.Hash) (*types.Transaction, bool, error) {
+func (m *MockChainClient) GetTransactionByHash(arg0 common.Hash) (*types0.Transaction, bool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetTransactionByHash", arg0)
-	ret0, _ := ret[0].(*types.Transaction)
+	ret0, _ := ret[0].(*types0.Transaction)
 	ret1, _ := ret[1].(bool)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
@@ -114,18 +149,32 @@ func (mr *MockChainClientMockRecorder) LockNonce() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockNonce", reflect.TypeOf((*MockChainClient)(nil).LockNonce))
 }
 
-// RelayerAddress mocks base method.
-func (m *MockChainClient) RelayerAddress() common.Address {
+// RelayerAddresses mocks base method.
+func (m *MockChainClient) RelayerAddresses() []common.Address {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RelayerAddress")
-	ret0, _ := ret[0].(common.Address)
+	ret := m.ctrl.Call(m, "RelayerAddresses")
+	ret0, _ := ret[0].([]common.Address)
+	return ret0
+}
+
+// RelayerAddresses indicates an expected call of RelayerAddresses.
+func (mr *MockChainClientMockRecorder) RelayerAddresses() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelayerAddresses", reflect.TypeOf((*MockChainClient)(nil).RelayerAddresses))
+}
+
+// SendRawTransaction mocks base method.
+func (m *MockChainClient) SendRawTransaction(arg0 context.Context, arg1 []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendRawTransaction", arg0, arg1)
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// RelayerAddress indicates an expected call of RelayerAddress.
-func (mr *MockChainClientMockRecorder) RelayerAddress() *gomock.Call {
+// SendRawTransaction indicates an expected call of SendRawTransaction.
+func (mr *MockChainClientMockRecorder) SendRawTransaction(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelayerAddress", reflect.TypeOf((*MockChainClient)(nil).RelayerAddress))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendRawTransaction", reflect.TypeOf((*MockChainClient)(nil).SendRawTransaction), arg0, arg1)
 }
 
 // SignAndSendTransaction mocks base method.
@@ -143,11 +192,26 @@ func (mr *MockChainClientMockRecorder) SignAndSendTransaction(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignAndSendTransaction", reflect.TypeOf((*MockChainClient)(nil).SignAndSendTransaction), arg0, arg1)
 }
 
+// SignTransaction mocks base method.
+func (m *MockChainClient) SignTransaction(arg0 context.Context, arg1 evmclient.CommonTransaction) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignTransaction", arg0, arg1)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignTransaction indicates an expected call of SignTransaction.
+func (mr *MockChainClientMockRecorder) SignTransaction(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignTransaction", reflect.TypeOf((*MockChainClient)(nil).SignTransaction), arg0, arg1)
+}
+
 // SubscribePendingTransactions mocks base method.
-func (m *MockChainClient) SubscribePendingTransactions(arg0 context.Context, arg1 chan<- common.Hash) (*rpc.ClientSubscription, error) {
+func (m *MockChainClient) SubscribePendingTransactions(arg0 context.Context, arg1 chan<- common.Hash) (ethereum.Subscription, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SubscribePendingTransactions", arg0, arg1)
-	ret0, _ := ret[0].(*rpc.ClientSubscription)
+	ret0, _ := ret[0].(ethereum.Subscription)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -159,10 +223,10 @@ func (mr *MockChainClientMockRecorder) SubscribePendingTransactions(arg0, arg1 i
 }
 
 // TransactionByHash mocks base method.
-func (m *MockChainClient) TransactionByHash(arg0 context.Context, arg1 common.Hash) (*types.Transaction, bool, error) {
+func (m *MockChainClient) TransactionByHash(arg0 context.Context, arg1 common.Hash) (*types0.Transaction, bool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "TransactionByHash", arg0, arg1)
-	ret0, _ := ret[0].(*types.Transaction)
+	ret0, _ := ret[0].(*types0.Transaction)
 	ret1, _ := ret[1].(bool)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
@@ -174,6 +238,21 @@ func (mr *MockChainClientMockRecorder) TransactionByHash(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionByHash", reflect.TypeOf((*MockChainClient)(nil).TransactionByHash), arg0, arg1)
 }
 
+// TransactionReceipt mocks base method.
+func (m *MockChainClient) TransactionReceipt(arg0 context.Context, arg1 common.Hash) (*types0.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransactionReceipt", arg0, arg1)
+	ret0, _ := ret[0].(*types0.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransactionReceipt indicates an expected call of TransactionReceipt.
+func (mr *MockChainClientMockRecorder) TransactionReceipt(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionReceipt", reflect.TypeOf((*MockChainClient)(nil).TransactionReceipt), arg0, arg1)
+}
+
 // UnlockNonce mocks base method.
 func (m *MockChainClient) UnlockNonce() {
 	m.ctrl.T.Helper()
@@ -215,11 +294,23 @@ func (mr *MockChainClientMockRecorder) UnsafeNonce() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsafeNonce", reflect.TypeOf((*MockChainClient)(nil).UnsafeNonce))
 }
 
+// UnsafeResetNonce mocks base method.
+func (m *MockChainClient) UnsafeResetNonce() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UnsafeResetNonce")
+}
+
+// UnsafeResetNonce indicates an expected call of UnsafeResetNonce.
+func (mr *MockChainClientMockRecorder) UnsafeResetNonce() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsafeResetNonce", reflect.TypeOf((*MockChainClient)(nil).UnsafeResetNonce))
+}
+
 // WaitAndReturnTxReceipt mocks base method.
-func (m *MockChainClient) WaitAndReturnTxReceipt(arg0 common.Hash) (*types.Receipt, error) {
+func (m *MockChainClient) WaitAndReturnTxReceipt(arg0 common.Hash) (*types0.Receipt, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "WaitAndReturnTxReceipt", arg0)
-	ret0, _ := ret[0].(*types.Receipt)
+	ret0, _ := ret[0].(*types0.Receipt)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -291,34 +382,92 @@ func (m *MockBridgeContract) EXPECT() *MockBridgeContractMockRecorder {
 	return m.recorder
 }
 
-// GetThreshold mocks base method.
-func (m *MockBridgeContract) GetThreshold() (byte, error) {
+// ABIAdapter mocks base method.
+func (m *MockBridgeContract) ABIAdapter() bridge.ABIAdapter {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetThreshold")
-	ret0, _ := ret[0].(byte)
+	ret := m.ctrl.Call(m, "ABIAdapter")
+	ret0, _ := ret[0].(bridge.ABIAdapter)
+	return ret0
+}
+
+// ABIAdapter indicates an expected call of ABIAdapter.
+func (mr *MockBridgeContractMockRecorder) ABIAdapter() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ABIAdapter", reflect.TypeOf((*MockBridgeContract)(nil).ABIAdapter))
+}
+
+// ContractAddress mocks base method.
+func (m *MockBridgeContract) ContractAddress() *common.Address {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContractAddress")
+	ret0, _ := ret[0].(*common.Address)
+	return ret0
+}
+
+// ContractAddress indicates an expected call of ContractAddress.
+func (mr *MockBridgeContractMockRecorder) ContractAddress() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContractAddress", reflect.TypeOf((*MockBridgeContract)(nil).ContractAddress))
+}
+
+// GetHandlerAddressForResourceID mocks base method.
+func (m *MockBridgeContract) GetHandlerAddressForResourceID(arg0 types.ResourceID) (common.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHandlerAddressForResourceID", arg0)
+	ret0, _ := ret[0].(common.Address)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetThreshold indicates an expected call of GetThreshold.
-func (mr *MockBridgeContractMockRecorder) GetThreshold() *gomock.Call {
+// GetHandlerAddressForResourceID indicates an expected call of GetHandlerAddressForResourceID.
+func (mr *MockBridgeContractMockRecorder) GetHandlerAddressForResourceID(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetThreshold", reflect.TypeOf((*MockBridgeContract)(nil).GetThreshold))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHandlerAddressForResourceID", reflect.TypeOf((*MockBridgeContract)(nil).GetHandlerAddressForResourceID), arg0)
 }
 
-// IsProposalVotedBy mocks base method.
-func (m *MockBridgeContract) IsProposalVotedBy(arg0 common.Address, arg1 *proposal.Proposal) (bool, error) {
+// GetProposal mocks base method.
+func (m *MockBridgeContract) GetProposal(arg0 byte, arg1 uint64, arg2 common.Hash) (message.ProposalStatus, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsProposalVotedBy", arg0, arg1)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "GetProposal", arg0, arg1, arg2)
+	ret0, _ := ret[0].(message.ProposalStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProposal indicates an expected call of GetProposal.
+func (mr *MockBridgeContractMockRecorder) GetProposal(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProposal", reflect.TypeOf((*MockBridgeContract)(nil).GetProposal), arg0, arg1, arg2)
+}
+
+// GetRelayers mocks base method.
+func (m *MockBridgeContract) GetRelayers() ([]common.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRelayers")
+	ret0, _ := ret[0].([]common.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRelayers indicates an expected call of GetRelayers.
+func (mr *MockBridgeContractMockRecorder) GetRelayers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelayers", reflect.TypeOf((*MockBridgeContract)(nil).GetRelayers))
+}
+
+// ProposalState mocks base method.
+func (m *MockBridgeContract) ProposalState(arg0 []common.Address, arg1 *proposal.Proposal) (*bridge.ProposalState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProposalState", arg0, arg1)
+	ret0, _ := ret[0].(*bridge.ProposalState)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// IsProposalVotedBy indicates an expected call of IsProposalVotedBy.
-func (mr *MockBridgeContractMockRecorder) IsProposalVotedBy(arg0, arg1 interface{}) *gomock.Call {
+// ProposalState indicates an expected call of ProposalState.
+func (mr *MockBridgeContractMockRecorder) ProposalState(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsProposalVotedBy", reflect.TypeOf((*MockBridgeContract)(nil).IsProposalVotedBy), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProposalState", reflect.TypeOf((*MockBridgeContract)(nil).ProposalState), arg0, arg1)
 }
 
 // ProposalStatus mocks base method.
@@ -364,3 +513,829 @@ func (mr *MockBridgeContractMockRecorder) VoteProposal(arg0, arg1 interface{}) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoteProposal", reflect.TypeOf((*MockBridgeContract)(nil).VoteProposal), arg0, arg1)
 }
+
+// MockVoter is a mock of Voter interface.
+type MockVoter struct {
+	ctrl     *gomock.Controller
+	recorder *MockVoterMockRecorder
+}
+
+// MockVoterMockRecorder is the mock recorder for MockVoter.
+type MockVoterMockRecorder struct {
+	mock *MockVoter
+}
+
+// NewMockVoter creates a new mock instance.
+func NewMockVoter(ctrl *gomock.Controller) *MockVoter {
+	mock := &MockVoter{ctrl: ctrl}
+	mock.recorder = &MockVoterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVoter) EXPECT() *MockVoterMockRecorder {
+	return m.recorder
+}
+
+// VoteProposal mocks base method.
+func (m *MockVoter) VoteProposal(arg0 *message.Message, arg1 *chain.EVMConfig) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VoteProposal", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VoteProposal indicates an expected call of VoteProposal.
+func (mr *MockVoterMockRecorder) VoteProposal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoteProposal", reflect.TypeOf((*MockVoter)(nil).VoteProposal), arg0, arg1)
+}
+
+// MockPausableBridge is a mock of PausableBridge interface.
+type MockPausableBridge struct {
+	ctrl     *gomock.Controller
+	recorder *MockPausableBridgeMockRecorder
+}
+
+// MockPausableBridgeMockRecorder is the mock recorder for MockPausableBridge.
+type MockPausableBridgeMockRecorder struct {
+	mock *MockPausableBridge
+}
+
+// NewMockPausableBridge creates a new mock instance.
+func NewMockPausableBridge(ctrl *gomock.Controller) *MockPausableBridge {
+	mock := &MockPausableBridge{ctrl: ctrl}
+	mock.recorder = &MockPausableBridgeMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPausableBridge) EXPECT() *MockPausableBridgeMockRecorder {
+	return m.recorder
+}
+
+// ContractAddress mocks base method.
+func (m *MockPausableBridge) ContractAddress() *common.Address {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContractAddress")
+	ret0, _ := ret[0].(*common.Address)
+	return ret0
+}
+
+// ContractAddress indicates an expected call of ContractAddress.
+func (mr *MockPausableBridgeMockRecorder) ContractAddress() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContractAddress", reflect.TypeOf((*MockPausableBridge)(nil).ContractAddress))
+}
+
+// IsPaused mocks base method.
+func (m *MockPausableBridge) IsPaused() (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsPaused")
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsPaused indicates an expected call of IsPaused.
+func (mr *MockPausableBridgeMockRecorder) IsPaused() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPaused", reflect.TypeOf((*MockPausableBridge)(nil).IsPaused))
+}
+
+// MockMessageQueue is a mock of MessageQueue interface.
+type MockMessageQueue struct {
+	ctrl     *gomock.Controller
+	recorder *MockMessageQueueMockRecorder
+}
+
+// MockMessageQueueMockRecorder is the mock recorder for MockMessageQueue.
+type MockMessageQueueMockRecorder struct {
+	mock *MockMessageQueue
+}
+
+// NewMockMessageQueue creates a new mock instance.
+func NewMockMessageQueue(ctrl *gomock.Controller) *MockMessageQueue {
+	mock := &MockMessageQueue{ctrl: ctrl}
+	mock.recorder = &MockMessageQueueMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMessageQueue) EXPECT() *MockMessageQueueMockRecorder {
+	return m.recorder
+}
+
+// Peek mocks base method.
+func (m *MockMessageQueue) Peek(arg0 byte, arg1 string) (*message.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Peek", arg0, arg1)
+	ret0, _ := ret[0].(*message.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Peek indicates an expected call of Peek.
+func (mr *MockMessageQueueMockRecorder) Peek(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Peek", reflect.TypeOf((*MockMessageQueue)(nil).Peek), arg0, arg1)
+}
+
+// Push mocks base method.
+func (m *MockMessageQueue) Push(arg0 byte, arg1 string, arg2 *message.Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Push", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Push indicates an expected call of Push.
+func (mr *MockMessageQueueMockRecorder) Push(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Push", reflect.TypeOf((*MockMessageQueue)(nil).Push), arg0, arg1, arg2)
+}
+
+// Remove mocks base method.
+func (m *MockMessageQueue) Remove(arg0 byte, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockMessageQueueMockRecorder) Remove(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockMessageQueue)(nil).Remove), arg0, arg1)
+}
+
+// MockMessageVerifier is a mock of MessageVerifier interface.
+type MockMessageVerifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockMessageVerifierMockRecorder
+}
+
+// MockMessageVerifierMockRecorder is the mock recorder for MockMessageVerifier.
+type MockMessageVerifierMockRecorder struct {
+	mock *MockMessageVerifier
+}
+
+// NewMockMessageVerifier creates a new mock instance.
+func NewMockMessageVerifier(ctrl *gomock.Controller) *MockMessageVerifier {
+	mock := &MockMessageVerifier{ctrl: ctrl}
+	mock.recorder = &MockMessageVerifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMessageVerifier) EXPECT() *MockMessageVerifierMockRecorder {
+	return m.recorder
+}
+
+// Verify mocks base method.
+func (m *MockMessageVerifier) Verify(arg0 *message.Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockMessageVerifierMockRecorder) Verify(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockMessageVerifier)(nil).Verify), arg0)
+}
+
+// MockSourceChainClient is a mock of SourceChainClient interface.
+type MockSourceChainClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockSourceChainClientMockRecorder
+}
+
+// MockSourceChainClientMockRecorder is the mock recorder for MockSourceChainClient.
+type MockSourceChainClientMockRecorder struct {
+	mock *MockSourceChainClient
+}
+
+// NewMockSourceChainClient creates a new mock instance.
+func NewMockSourceChainClient(ctrl *gomock.Controller) *MockSourceChainClient {
+	mock := &MockSourceChainClient{ctrl: ctrl}
+	mock.recorder = &MockSourceChainClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSourceChainClient) EXPECT() *MockSourceChainClientMockRecorder {
+	return m.recorder
+}
+
+// FetchEventLogs mocks base method.
+func (m *MockSourceChainClient) FetchEventLogs(arg0 context.Context, arg1 common.Address, arg2 string, arg3, arg4 *big.Int) ([]types0.Log, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchEventLogs", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]types0.Log)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchEventLogs indicates an expected call of FetchEventLogs.
+func (mr *MockSourceChainClientMockRecorder) FetchEventLogs(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchEventLogs", reflect.TypeOf((*MockSourceChainClient)(nil).FetchEventLogs), arg0, arg1, arg2, arg3, arg4)
+}
+
+// LatestBlock mocks base method.
+func (m *MockSourceChainClient) LatestBlock() (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestBlock")
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestBlock indicates an expected call of LatestBlock.
+func (mr *MockSourceChainClientMockRecorder) LatestBlock() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestBlock", reflect.TypeOf((*MockSourceChainClient)(nil).LatestBlock))
+}
+
+// TransactionReceipt mocks base method.
+func (m *MockSourceChainClient) TransactionReceipt(arg0 context.Context, arg1 common.Hash) (*types0.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransactionReceipt", arg0, arg1)
+	ret0, _ := ret[0].(*types0.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransactionReceipt indicates an expected call of TransactionReceipt.
+func (mr *MockSourceChainClientMockRecorder) TransactionReceipt(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionReceipt", reflect.TypeOf((*MockSourceChainClient)(nil).TransactionReceipt), arg0, arg1)
+}
+
+// MockDepositEventHandler is a mock of DepositEventHandler interface.
+type MockDepositEventHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockDepositEventHandlerMockRecorder
+}
+
+// MockDepositEventHandlerMockRecorder is the mock recorder for MockDepositEventHandler.
+type MockDepositEventHandlerMockRecorder struct {
+	mock *MockDepositEventHandler
+}
+
+// NewMockDepositEventHandler creates a new mock instance.
+func NewMockDepositEventHandler(ctrl *gomock.Controller) *MockDepositEventHandler {
+	mock := &MockDepositEventHandler{ctrl: ctrl}
+	mock.recorder = &MockDepositEventHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDepositEventHandler) EXPECT() *MockDepositEventHandlerMockRecorder {
+	return m.recorder
+}
+
+// HandleEvent mocks base method.
+func (m *MockDepositEventHandler) HandleEvent(arg0, arg1 byte, arg2 uint64, arg3 types.ResourceID, arg4, arg5 []byte, arg6 common.Hash, arg7 uint64) (*message.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandleEvent", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+	ret0, _ := ret[0].(*message.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HandleEvent indicates an expected call of HandleEvent.
+func (mr *MockDepositEventHandlerMockRecorder) HandleEvent(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleEvent", reflect.TypeOf((*MockDepositEventHandler)(nil).HandleEvent), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+}
+
+// MockDataHashTracker is a mock of DataHashTracker interface.
+type MockDataHashTracker struct {
+	ctrl     *gomock.Controller
+	recorder *MockDataHashTrackerMockRecorder
+}
+
+// MockDataHashTrackerMockRecorder is the mock recorder for MockDataHashTracker.
+type MockDataHashTrackerMockRecorder struct {
+	mock *MockDataHashTracker
+}
+
+// NewMockDataHashTracker creates a new mock instance.
+func NewMockDataHashTracker(ctrl *gomock.Controller) *MockDataHashTracker {
+	mock := &MockDataHashTracker{ctrl: ctrl}
+	mock.recorder = &MockDataHashTrackerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDataHashTracker) EXPECT() *MockDataHashTrackerMockRecorder {
+	return m.recorder
+}
+
+// VotedDataHashes mocks base method.
+func (m *MockDataHashTracker) VotedDataHashes(arg0 byte, arg1 uint64) map[common.Hash]common.Hash {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VotedDataHashes", arg0, arg1)
+	ret0, _ := ret[0].(map[common.Hash]common.Hash)
+	return ret0
+}
+
+// VotedDataHashes indicates an expected call of VotedDataHashes.
+func (mr *MockDataHashTrackerMockRecorder) VotedDataHashes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VotedDataHashes", reflect.TypeOf((*MockDataHashTracker)(nil).VotedDataHashes), arg0, arg1)
+}
+
+// MockAlerter is a mock of Alerter interface.
+type MockAlerter struct {
+	ctrl     *gomock.Controller
+	recorder *MockAlerterMockRecorder
+}
+
+// MockAlerterMockRecorder is the mock recorder for MockAlerter.
+type MockAlerterMockRecorder struct {
+	mock *MockAlerter
+}
+
+// NewMockAlerter creates a new mock instance.
+func NewMockAlerter(ctrl *gomock.Controller) *MockAlerter {
+	mock := &MockAlerter{ctrl: ctrl}
+	mock.recorder = &MockAlerterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAlerter) EXPECT() *MockAlerterMockRecorder {
+	return m.recorder
+}
+
+// Alert mocks base method.
+func (m *MockAlerter) Alert(arg0 *voter.Alert) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Alert", arg0)
+}
+
+// Alert indicates an expected call of Alert.
+func (mr *MockAlerterMockRecorder) Alert(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Alert", reflect.TypeOf((*MockAlerter)(nil).Alert), arg0)
+}
+
+// MockProposalRecorder is a mock of ProposalRecorder interface.
+type MockProposalRecorder struct {
+	ctrl     *gomock.Controller
+	recorder *MockProposalRecorderMockRecorder
+}
+
+// MockProposalRecorderMockRecorder is the mock recorder for MockProposalRecorder.
+type MockProposalRecorderMockRecorder struct {
+	mock *MockProposalRecorder
+}
+
+// NewMockProposalRecorder creates a new mock instance.
+func NewMockProposalRecorder(ctrl *gomock.Controller) *MockProposalRecorder {
+	mock := &MockProposalRecorder{ctrl: ctrl}
+	mock.recorder = &MockProposalRecorderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProposalRecorder) EXPECT() *MockProposalRecorderMockRecorder {
+	return m.recorder
+}
+
+// Record mocks base method.
+func (m *MockProposalRecorder) Record(arg0 *proposal.Proposal) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Record", arg0)
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockProposalRecorderMockRecorder) Record(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockProposalRecorder)(nil).Record), arg0)
+}
+
+// MockBatchVoter is a mock of BatchVoter interface.
+type MockBatchVoter struct {
+	ctrl     *gomock.Controller
+	recorder *MockBatchVoterMockRecorder
+}
+
+// MockBatchVoterMockRecorder is the mock recorder for MockBatchVoter.
+type MockBatchVoterMockRecorder struct {
+	mock *MockBatchVoter
+}
+
+// NewMockBatchVoter creates a new mock instance.
+func NewMockBatchVoter(ctrl *gomock.Controller) *MockBatchVoter {
+	mock := &MockBatchVoter{ctrl: ctrl}
+	mock.recorder = &MockBatchVoterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBatchVoter) EXPECT() *MockBatchVoterMockRecorder {
+	return m.recorder
+}
+
+// Vote mocks base method.
+func (m *MockBatchVoter) Vote(arg0 *proposal.Proposal) (*common.Hash, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Vote", arg0)
+	ret0, _ := ret[0].(*common.Hash)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Vote indicates an expected call of Vote.
+func (mr *MockBatchVoterMockRecorder) Vote(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Vote", reflect.TypeOf((*MockBatchVoter)(nil).Vote), arg0)
+}
+
+// MockMulticall is a mock of Multicall interface.
+type MockMulticall struct {
+	ctrl     *gomock.Controller
+	recorder *MockMulticallMockRecorder
+}
+
+// MockMulticallMockRecorder is the mock recorder for MockMulticall.
+type MockMulticallMockRecorder struct {
+	mock *MockMulticall
+}
+
+// NewMockMulticall creates a new mock instance.
+func NewMockMulticall(ctrl *gomock.Controller) *MockMulticall {
+	mock := &MockMulticall{ctrl: ctrl}
+	mock.recorder = &MockMulticallMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMulticall) EXPECT() *MockMulticallMockRecorder {
+	return m.recorder
+}
+
+// Aggregate mocks base method.
+func (m *MockMulticall) Aggregate(arg0 []multicall.Call, arg1 transactor.TransactOptions) (*common.Hash, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Aggregate", arg0, arg1)
+	ret0, _ := ret[0].(*common.Hash)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Aggregate indicates an expected call of Aggregate.
+func (mr *MockMulticallMockRecorder) Aggregate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Aggregate", reflect.TypeOf((*MockMulticall)(nil).Aggregate), arg0, arg1)
+}
+
+// MockBatchBridgeContract is a mock of BatchBridgeContract interface.
+type MockBatchBridgeContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockBatchBridgeContractMockRecorder
+}
+
+// MockBatchBridgeContractMockRecorder is the mock recorder for MockBatchBridgeContract.
+type MockBatchBridgeContractMockRecorder struct {
+	mock *MockBatchBridgeContract
+}
+
+// NewMockBatchBridgeContract creates a new mock instance.
+func NewMockBatchBridgeContract(ctrl *gomock.Controller) *MockBatchBridgeContract {
+	mock := &MockBatchBridgeContract{ctrl: ctrl}
+	mock.recorder = &MockBatchBridgeContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBatchBridgeContract) EXPECT() *MockBatchBridgeContractMockRecorder {
+	return m.recorder
+}
+
+// ABIAdapter mocks base method.
+func (m *MockBatchBridgeContract) ABIAdapter() bridge.ABIAdapter {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ABIAdapter")
+	ret0, _ := ret[0].(bridge.ABIAdapter)
+	return ret0
+}
+
+// ABIAdapter indicates an expected call of ABIAdapter.
+func (mr *MockBatchBridgeContractMockRecorder) ABIAdapter() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ABIAdapter", reflect.TypeOf((*MockBatchBridgeContract)(nil).ABIAdapter))
+}
+
+// ContractAddress mocks base method.
+func (m *MockBatchBridgeContract) ContractAddress() *common.Address {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContractAddress")
+	ret0, _ := ret[0].(*common.Address)
+	return ret0
+}
+
+// ContractAddress indicates an expected call of ContractAddress.
+func (mr *MockBatchBridgeContractMockRecorder) ContractAddress() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContractAddress", reflect.TypeOf((*MockBatchBridgeContract)(nil).ContractAddress))
+}
+
+// VoteProposal mocks base method.
+func (m *MockBatchBridgeContract) VoteProposal(arg0 *proposal.Proposal, arg1 transactor.TransactOptions) (*common.Hash, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VoteProposal", arg0, arg1)
+	ret0, _ := ret[0].(*common.Hash)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VoteProposal indicates an expected call of VoteProposal.
+func (mr *MockBatchBridgeContractMockRecorder) VoteProposal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoteProposal", reflect.TypeOf((*MockBatchBridgeContract)(nil).VoteProposal), arg0, arg1)
+}
+
+// MockBatchReceiptClient is a mock of BatchReceiptClient interface.
+type MockBatchReceiptClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockBatchReceiptClientMockRecorder
+}
+
+// MockBatchReceiptClientMockRecorder is the mock recorder for MockBatchReceiptClient.
+type MockBatchReceiptClientMockRecorder struct {
+	mock *MockBatchReceiptClient
+}
+
+// NewMockBatchReceiptClient creates a new mock instance.
+func NewMockBatchReceiptClient(ctrl *gomock.Controller) *MockBatchReceiptClient {
+	mock := &MockBatchReceiptClient{ctrl: ctrl}
+	mock.recorder = &MockBatchReceiptClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBatchReceiptClient) EXPECT() *MockBatchReceiptClientMockRecorder {
+	return m.recorder
+}
+
+// WaitAndReturnTxReceipt mocks base method.
+func (m *MockBatchReceiptClient) WaitAndReturnTxReceipt(arg0 common.Hash) (*types0.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitAndReturnTxReceipt", arg0)
+	ret0, _ := ret[0].(*types0.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitAndReturnTxReceipt indicates an expected call of WaitAndReturnTxReceipt.
+func (mr *MockBatchReceiptClientMockRecorder) WaitAndReturnTxReceipt(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitAndReturnTxReceipt", reflect.TypeOf((*MockBatchReceiptClient)(nil).WaitAndReturnTxReceipt), arg0)
+}
+
+// MockSentTxStore is a mock of SentTxStore interface.
+type MockSentTxStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockSentTxStoreMockRecorder
+}
+
+// MockSentTxStoreMockRecorder is the mock recorder for MockSentTxStore.
+type MockSentTxStoreMockRecorder struct {
+	mock *MockSentTxStore
+}
+
+// NewMockSentTxStore creates a new mock instance.
+func NewMockSentTxStore(ctrl *gomock.Controller) *MockSentTxStore {
+	mock := &MockSentTxStore{ctrl: ctrl}
+	mock.recorder = &MockSentTxStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSentTxStore) EXPECT() *MockSentTxStoreMockRecorder {
+	return m.recorder
+}
+
+// IsTracked mocks base method.
+func (m *MockSentTxStore) IsTracked(arg0 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsTracked", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsTracked indicates an expected call of IsTracked.
+func (mr *MockSentTxStoreMockRecorder) IsTracked(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTracked", reflect.TypeOf((*MockSentTxStore)(nil).IsTracked), arg0)
+}
+
+// MockGasBudget is a mock of GasBudget interface.
+type MockGasBudget struct {
+	ctrl     *gomock.Controller
+	recorder *MockGasBudgetMockRecorder
+}
+
+// MockGasBudgetMockRecorder is the mock recorder for MockGasBudget.
+type MockGasBudgetMockRecorder struct {
+	mock *MockGasBudget
+}
+
+// NewMockGasBudget creates a new mock instance.
+func NewMockGasBudget(ctrl *gomock.Controller) *MockGasBudget {
+	mock := &MockGasBudget{ctrl: ctrl}
+	mock.recorder = &MockGasBudgetMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGasBudget) EXPECT() *MockGasBudgetMockRecorder {
+	return m.recorder
+}
+
+// Exhausted mocks base method.
+func (m *MockGasBudget) Exhausted() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exhausted")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Exhausted indicates an expected call of Exhausted.
+func (mr *MockGasBudgetMockRecorder) Exhausted() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exhausted", reflect.TypeOf((*MockGasBudget)(nil).Exhausted))
+}
+
+// MockVoteLogFetcher is a mock of VoteLogFetcher interface.
+type MockVoteLogFetcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockVoteLogFetcherMockRecorder
+}
+
+// MockVoteLogFetcherMockRecorder is the mock recorder for MockVoteLogFetcher.
+type MockVoteLogFetcherMockRecorder struct {
+	mock *MockVoteLogFetcher
+}
+
+// NewMockVoteLogFetcher creates a new mock instance.
+func NewMockVoteLogFetcher(ctrl *gomock.Controller) *MockVoteLogFetcher {
+	mock := &MockVoteLogFetcher{ctrl: ctrl}
+	mock.recorder = &MockVoteLogFetcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVoteLogFetcher) EXPECT() *MockVoteLogFetcherMockRecorder {
+	return m.recorder
+}
+
+// FetchEventLogs mocks base method.
+func (m *MockVoteLogFetcher) FetchEventLogs(arg0 context.Context, arg1 common.Address, arg2 string, arg3, arg4 *big.Int) ([]types0.Log, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchEventLogs", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]types0.Log)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchEventLogs indicates an expected call of FetchEventLogs.
+func (mr *MockVoteLogFetcherMockRecorder) FetchEventLogs(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchEventLogs", reflect.TypeOf((*MockVoteLogFetcher)(nil).FetchEventLogs), arg0, arg1, arg2, arg3, arg4)
+}
+
+// LatestBlock mocks base method.
+func (m *MockVoteLogFetcher) LatestBlock() (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestBlock")
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestBlock indicates an expected call of LatestBlock.
+func (mr *MockVoteLogFetcherMockRecorder) LatestBlock() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestBlock", reflect.TypeOf((*MockVoteLogFetcher)(nil).LatestBlock))
+}
+
+// MockRevoter is a mock of Revoter interface.
+type MockRevoter struct {
+	ctrl     *gomock.Controller
+	recorder *MockRevoterMockRecorder
+}
+
+// MockRevoterMockRecorder is the mock recorder for MockRevoter.
+type MockRevoterMockRecorder struct {
+	mock *MockRevoter
+}
+
+// NewMockRevoter creates a new mock instance.
+func NewMockRevoter(ctrl *gomock.Controller) *MockRevoter {
+	mock := &MockRevoter{ctrl: ctrl}
+	mock.recorder = &MockRevoterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRevoter) EXPECT() *MockRevoterMockRecorder {
+	return m.recorder
+}
+
+// Revote mocks base method.
+func (m *MockRevoter) Revote(arg0 []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revote", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revote indicates an expected call of Revote.
+func (mr *MockRevoterMockRecorder) Revote(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revote", reflect.TypeOf((*MockRevoter)(nil).Revote), arg0)
+}
+
+// MockRelayerGetter is a mock of RelayerGetter interface.
+type MockRelayerGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockRelayerGetterMockRecorder
+}
+
+// MockRelayerGetterMockRecorder is the mock recorder for MockRelayerGetter.
+type MockRelayerGetterMockRecorder struct {
+	mock *MockRelayerGetter
+}
+
+// NewMockRelayerGetter creates a new mock instance.
+func NewMockRelayerGetter(ctrl *gomock.Controller) *MockRelayerGetter {
+	mock := &MockRelayerGetter{ctrl: ctrl}
+	mock.recorder = &MockRelayerGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRelayerGetter) EXPECT() *MockRelayerGetterMockRecorder {
+	return m.recorder
+}
+
+// GetRelayers mocks base method.
+func (m *MockRelayerGetter) GetRelayers() ([]common.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRelayers")
+	ret0, _ := ret[0].([]common.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRelayers indicates an expected call of GetRelayers.
+func (mr *MockRelayerGetterMockRecorder) GetRelayers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelayers", reflect.TypeOf((*MockRelayerGetter)(nil).GetRelayers))
+}
+
+// MockRelayerProvider is a mock of RelayerProvider interface.
+type MockRelayerProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockRelayerProviderMockRecorder
+}
+
+// MockRelayerProviderMockRecorder is the mock recorder for MockRelayerProvider.
+type MockRelayerProviderMockRecorder struct {
+	mock *MockRelayerProvider
+}
+
+// NewMockRelayerProvider creates a new mock instance.
+func NewMockRelayerProvider(ctrl *gomock.Controller) *MockRelayerProvider {
+	mock := &MockRelayerProvider{ctrl: ctrl}
+	mock.recorder = &MockRelayerProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRelayerProvider) EXPECT() *MockRelayerProviderMockRecorder {
+	return m.recorder
+}
+
+// Relayers mocks base method.
+func (m *MockRelayerProvider) Relayers() ([]common.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Relayers")
+	ret0, _ := ret[0].([]common.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Relayers indicates an expected call of Relayers.
+func (mr *MockRelayerProviderMockRecorder) Relayers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Relayers", reflect.TypeOf((*MockRelayerProvider)(nil).Relayers))
+}
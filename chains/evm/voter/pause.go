@@ -0,0 +1,314 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package voter
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-core/config/chain"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/util"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// maxDrainRetryPeriod is the longest time voter waits before voting for a parked message again
+	maxDrainRetryPeriod = 5 * time.Minute
+	// maxDrainAttempts is the number of times voting for a parked message is attempted before it is dropped
+	maxDrainAttempts = 5
+)
+
+var (
+	// DrainRetryPeriod is the time voter waits before voting for a parked message again
+	// after voting failed. It doubles with each consecutive failure.
+	DrainRetryPeriod = 5 * time.Second
+)
+
+type Voter interface {
+	VoteProposal(m *message.Message, chainConfig *chain.EVMConfig) error
+}
+
+type PausableBridge interface {
+	IsPaused() (bool, error)
+	ContractAddress() *common.Address
+}
+
+type MessageQueue interface {
+	Push(domainID uint8, bridge string, m *message.Message) error
+	Peek(domainID uint8, bridge string) (*message.Message, error)
+	Remove(domainID uint8, bridge string) error
+}
+
+// PauseAwareVoter tracks paused state of a destination bridge and parks
+// messages in a persistent queue while the bridge is paused instead of voting
+// for proposals that would fail. Parked messages are voted for in order
+// once the bridge is unpaused. Messages whose deposit is not confirmed on the
+// source chain yet are parked as well and voted for again every VerifyRetryPeriod.
+type PauseAwareVoter struct {
+	voter         Voter
+	bridge        PausableBridge
+	queue         MessageQueue
+	events        <-chan *bridge.Event
+	config        *chain.EVMConfig
+	domainID      uint8
+	bridgeAddress string
+	alerter       Alerter
+
+	paused   bool
+	draining bool
+	stop     <-chan struct{}
+	lock     sync.Mutex
+}
+
+// NewPauseAwareVoter creates an instance of PauseAwareVoter. Events channel should
+// receive bridge Paused and Unpaused events from the destination chain listener.
+func NewPauseAwareVoter(voter Voter, bridge PausableBridge, queue MessageQueue, events <-chan *bridge.Event, config *chain.EVMConfig) *PauseAwareVoter {
+	return &PauseAwareVoter{
+		voter:         voter,
+		bridge:        bridge,
+		queue:         queue,
+		events:        events,
+		config:        config,
+		domainID:      *config.GeneralChainConfig.Id,
+		bridgeAddress: bridge.ContractAddress().Hex(),
+		alerter:       &LogAlerter{},
+	}
+}
+
+// SetAlerter sets alerter that is notified about parked messages dropped after failing repeatedly
+func (v *PauseAwareVoter) SetAlerter(alerter Alerter) {
+	v.alerter = alerter
+}
+
+// Start seeds paused state from the bridge contract and updates it on Paused and Unpaused
+// events until stop channel is closed. Parked messages are voted for on each unpause.
+func (v *PauseAwareVoter) Start(stop <-chan struct{}) {
+	v.lock.Lock()
+	v.stop = stop
+	v.lock.Unlock()
+
+	v.refreshPausedState(false, stop)
+	for {
+		select {
+		case <-stop:
+			return
+		case evt, ok := <-v.events:
+			if !ok {
+				return
+			}
+			switch evt.Sig {
+			case util.Paused:
+				v.refreshPausedState(true, stop)
+			case util.Unpaused:
+				v.refreshPausedState(false, stop)
+			}
+		}
+	}
+}
+
+// VoteProposal votes for proposal if bridge is not paused, otherwise parks message
+// into queue. Message is also parked if voting failed because bridge got paused meanwhile
+// or because its deposit is not confirmed on the source chain yet.
+func (v *PauseAwareVoter) VoteProposal(m *message.Message, chainConfig *chain.EVMConfig) error {
+	parked, err := v.park(m)
+	if parked || err != nil {
+		return err
+	}
+
+	err = v.voter.VoteProposal(m, chainConfig)
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, ErrDepositNotConfirmed) {
+		return v.parkUnconfirmed(m)
+	}
+
+	paused, pausedErr := v.bridge.IsPaused()
+	if pausedErr != nil || !paused {
+		return err
+	}
+	v.setPaused(true)
+	_, err = v.park(m)
+	return err
+}
+
+// IsPaused returns last known paused state of the bridge
+func (v *PauseAwareVoter) IsPaused() bool {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.paused
+}
+
+func (v *PauseAwareVoter) park(m *message.Message) (bool, error) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	// messages are parked while draining as well to keep them in order
+	if !v.paused && !v.draining {
+		return false, nil
+	}
+
+	err := v.queue.Push(v.domainID, v.bridgeAddress, m)
+	if err != nil {
+		return false, err
+	}
+	log.Info().Uint8("domainID", v.domainID).Str("bridge", v.bridgeAddress).Uint64("nonce", m.DepositNonce).Uint8("src", m.Source).Msg("Bridge paused, message parked")
+	return true, nil
+}
+
+// parkUnconfirmed parks message whose deposit is not confirmed yet and starts draining
+// parked messages so that it is voted for again once the deposit is confirmed
+func (v *PauseAwareVoter) parkUnconfirmed(m *message.Message) error {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	err := v.queue.Push(v.domainID, v.bridgeAddress, m)
+	if err != nil {
+		return err
+	}
+	log.Debug().Uint8("domainID", v.domainID).Uint64("nonce", m.DepositNonce).Uint8("src", m.Source).Msg("Deposit not confirmed, message parked")
+	if !v.paused && !v.draining {
+		v.draining = true
+		go v.drain(v.stop)
+	}
+	return nil
+}
+
+func (v *PauseAwareVoter) setPaused(paused bool) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	v.paused = paused
+}
+
+// refreshPausedState reads paused state from the bridge contract and falls back to
+// provided state if contract call fails. Starts draining parked messages if bridge is unpaused.
+func (v *PauseAwareVoter) refreshPausedState(fallback bool, stop <-chan struct{}) {
+	paused, err := v.bridge.IsPaused()
+	if err != nil {
+		log.Warn().Err(err).Uint8("domainID", v.domainID).Msgf("Failed reading bridge paused state, assuming paused: %v", fallback)
+		paused = fallback
+	}
+
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	if paused != v.paused {
+		log.Info().Uint8("domainID", v.domainID).Bool("paused", paused).Msg("Bridge paused state changed")
+	}
+	v.paused = paused
+	if paused || v.draining {
+		return
+	}
+
+	v.draining = true
+	go v.drain(stop)
+}
+
+// drain votes for parked messages in order until queue is empty or bridge is paused again.
+// Message stays parked if voting fails and is voted for again after a backoff, or after VerifyRetryPeriod
+// if its deposit is not confirmed yet. Message that failed maxDrainAttempts times, or was not confirmed
+// after maxVerifyChecks attempts, is dropped and reported to alerter so that it does not block voting
+// for messages parked after it.
+func (v *PauseAwareVoter) drain(stop <-chan struct{}) {
+	backoff := DrainRetryPeriod
+	attempts := 0
+	for {
+		select {
+		case <-stop:
+			return
+		default:
+		}
+
+		v.lock.Lock()
+		if v.paused {
+			v.draining = false
+			v.lock.Unlock()
+			return
+		}
+		m, err := v.queue.Peek(v.domainID, v.bridgeAddress)
+		if err != nil || m == nil {
+			if err != nil {
+				log.Error().Err(err).Uint8("domainID", v.domainID).Msg("Failed reading parked message")
+			}
+			v.draining = false
+			v.lock.Unlock()
+			return
+		}
+		v.lock.Unlock()
+
+		err = v.voter.VoteProposal(m, v.config)
+		if err != nil {
+			paused, pausedErr := v.bridge.IsPaused()
+			if pausedErr == nil && paused {
+				// keep message parked until next unpause
+				v.setPaused(true)
+				continue
+			}
+			wait, maxAttempts := backoff, maxDrainAttempts
+			unconfirmed := errors.Is(err, ErrDepositNotConfirmed)
+			if unconfirmed {
+				wait, maxAttempts = VerifyRetryPeriod, maxVerifyChecks
+			}
+			attempts++
+			if attempts >= maxAttempts {
+				v.alerter.Alert(&Alert{
+					Kind:     AlertDroppedMessage,
+					Severity: SeverityHigh,
+					DomainID: v.domainID,
+					Message:  "Dropped parked message after repeated voting failures",
+					Details: map[string]interface{}{
+						"bridge":   v.bridgeAddress,
+						"src":      m.Source,
+						"nonce":    m.DepositNonce,
+						"attempts": attempts,
+						"error":    err.Error(),
+					},
+				})
+				attempts = 0
+				backoff = DrainRetryPeriod
+				if !v.removeParked() {
+					return
+				}
+				continue
+			}
+			if unconfirmed {
+				log.Debug().Err(err).Msgf("Deposit of parked message %v not confirmed, retrying in %s", m.String(), wait)
+			} else {
+				log.Error().Err(err).Msgf("writing parked message %v, retrying in %s", m.String(), wait)
+				backoff *= 2
+				if backoff > maxDrainRetryPeriod {
+					backoff = maxDrainRetryPeriod
+				}
+			}
+			select {
+			case <-stop:
+				return
+			case <-time.After(wait):
+			}
+			continue
+		}
+		attempts = 0
+		backoff = DrainRetryPeriod
+
+		if !v.removeParked() {
+			return
+		}
+	}
+}
+
+// removeParked removes message at the head of the queue and stops draining if removal failed
+func (v *PauseAwareVoter) removeParked() bool {
+	err := v.queue.Remove(v.domainID, v.bridgeAddress)
+	if err != nil {
+		log.Error().Err(err).Uint8("domainID", v.domainID).Msg("Failed removing parked message")
+		v.lock.Lock()
+		v.draining = false
+		v.lock.Unlock()
+		return false
+	}
+	return true
+}
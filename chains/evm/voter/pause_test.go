@@ -0,0 +1,257 @@
+package voter_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/voter"
+	mock_voter "github.com/ChainSafe/chainbridge-core/chains/evm/voter/mock"
+	"github.com/ChainSafe/chainbridge-core/config/chain"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/util"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type PauseAwareVoterTestSuite struct {
+	suite.Suite
+	pauseAwareVoter    *voter.PauseAwareVoter
+	mockVoter          *mock_voter.MockVoter
+	mockPausableBridge *mock_voter.MockPausableBridge
+	mockMessageQueue   *mock_voter.MockMessageQueue
+	mockAlerter        *mock_voter.MockAlerter
+	events             chan *bridge.Event
+	stop               chan struct{}
+	chainConfig        *chain.EVMConfig
+	domainID           uint8
+	bridgeAddress      common.Address
+}
+
+func TestRunPauseAwareVoterTestSuite(t *testing.T) {
+	suite.Run(t, new(PauseAwareVoterTestSuite))
+}
+
+func (s *PauseAwareVoterTestSuite) SetupSuite()    {}
+func (s *PauseAwareVoterTestSuite) TearDownSuite() {}
+func (s *PauseAwareVoterTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.mockVoter = mock_voter.NewMockVoter(gomockController)
+	s.mockPausableBridge = mock_voter.NewMockPausableBridge(gomockController)
+	s.bridgeAddress = common.HexToAddress("0xABCD")
+	s.mockPausableBridge.EXPECT().ContractAddress().Return(&s.bridgeAddress).AnyTimes()
+	s.mockMessageQueue = mock_voter.NewMockMessageQueue(gomockController)
+	s.events = make(chan *bridge.Event)
+	s.stop = make(chan struct{})
+	s.domainID = 1
+	s.chainConfig = &chain.EVMConfig{GeneralChainConfig: chain.GeneralChainConfig{Id: &s.domainID}}
+	s.pauseAwareVoter = voter.NewPauseAwareVoter(
+		s.mockVoter,
+		s.mockPausableBridge,
+		s.mockMessageQueue,
+		s.events,
+		s.chainConfig,
+	)
+	s.mockAlerter = mock_voter.NewMockAlerter(gomockController)
+	s.pauseAwareVoter.SetAlerter(s.mockAlerter)
+}
+func (s *PauseAwareVoterTestSuite) TearDownTest() {
+	close(s.stop)
+}
+
+func (s *PauseAwareVoterTestSuite) TestVoteProposal_NotPaused() {
+	m := &message.Message{DepositNonce: 1}
+	s.mockVoter.EXPECT().VoteProposal(m, s.chainConfig).Return(nil)
+
+	err := s.pauseAwareVoter.VoteProposal(m, s.chainConfig)
+
+	s.Nil(err)
+}
+
+func (s *PauseAwareVoterTestSuite) TestVoteProposal_FailedNotPaused() {
+	m := &message.Message{DepositNonce: 1}
+	s.mockVoter.EXPECT().VoteProposal(m, s.chainConfig).Return(errors.New("error"))
+	s.mockPausableBridge.EXPECT().IsPaused().Return(false, nil)
+
+	err := s.pauseAwareVoter.VoteProposal(m, s.chainConfig)
+
+	s.NotNil(err)
+}
+
+func (s *PauseAwareVoterTestSuite) TestVoteProposal_FailedBecauseBridgePaused() {
+	m := &message.Message{DepositNonce: 1}
+	s.mockVoter.EXPECT().VoteProposal(m, s.chainConfig).Return(errors.New("error"))
+	s.mockPausableBridge.EXPECT().IsPaused().Return(true, nil)
+	s.mockMessageQueue.EXPECT().Push(s.domainID, s.bridgeAddress.Hex(), m).Return(nil)
+
+	err := s.pauseAwareVoter.VoteProposal(m, s.chainConfig)
+
+	s.Nil(err)
+	s.True(s.pauseAwareVoter.IsPaused())
+}
+
+func (s *PauseAwareVoterTestSuite) TestStart_SeededPausedStateParksMessages() {
+	m := &message.Message{DepositNonce: 1}
+	seeded := make(chan struct{})
+	s.mockPausableBridge.EXPECT().IsPaused().DoAndReturn(func() (bool, error) {
+		defer close(seeded)
+		return true, nil
+	})
+	s.mockMessageQueue.EXPECT().Push(s.domainID, s.bridgeAddress.Hex(), m).Return(nil)
+
+	go s.pauseAwareVoter.Start(s.stop)
+	<-seeded
+	s.Eventually(s.pauseAwareVoter.IsPaused, time.Second, time.Millisecond)
+	err := s.pauseAwareVoter.VoteProposal(m, s.chainConfig)
+
+	s.Nil(err)
+}
+
+func (s *PauseAwareVoterTestSuite) TestStart_UnpausedEventDrainsQueue() {
+	m := &message.Message{DepositNonce: 1}
+	drained := make(chan struct{})
+	gomock.InOrder(
+		s.mockPausableBridge.EXPECT().IsPaused().Return(true, nil),
+		s.mockPausableBridge.EXPECT().IsPaused().Return(false, nil),
+	)
+	gomock.InOrder(
+		s.mockMessageQueue.EXPECT().Peek(s.domainID, s.bridgeAddress.Hex()).Return(m, nil),
+		s.mockVoter.EXPECT().VoteProposal(m, s.chainConfig).Return(nil),
+		s.mockMessageQueue.EXPECT().Remove(s.domainID, s.bridgeAddress.Hex()).Return(nil),
+		s.mockMessageQueue.EXPECT().Peek(s.domainID, s.bridgeAddress.Hex()).DoAndReturn(func(domainID uint8, bridge string) (*message.Message, error) {
+			defer close(drained)
+			return nil, nil
+		}),
+	)
+
+	go s.pauseAwareVoter.Start(s.stop)
+	s.events <- &bridge.Event{Sig: util.Unpaused, Data: &bridge.Unpaused{}}
+
+	select {
+	case <-drained:
+	case <-time.After(time.Second):
+		s.Fail("parked messages not drained")
+	}
+	s.False(s.pauseAwareVoter.IsPaused())
+}
+
+func (s *PauseAwareVoterTestSuite) TestStart_FailedParkedVoteKeptParkedAndRetried() {
+	voter.DrainRetryPeriod = time.Millisecond
+	m := &message.Message{DepositNonce: 1}
+	drained := make(chan struct{})
+	gomock.InOrder(
+		s.mockPausableBridge.EXPECT().IsPaused().Return(true, nil),
+		s.mockPausableBridge.EXPECT().IsPaused().Return(false, nil).Times(2),
+	)
+	gomock.InOrder(
+		s.mockMessageQueue.EXPECT().Peek(s.domainID, s.bridgeAddress.Hex()).Return(m, nil),
+		s.mockVoter.EXPECT().VoteProposal(m, s.chainConfig).Return(errors.New("error")),
+		s.mockMessageQueue.EXPECT().Peek(s.domainID, s.bridgeAddress.Hex()).Return(m, nil),
+		s.mockVoter.EXPECT().VoteProposal(m, s.chainConfig).Return(nil),
+		s.mockMessageQueue.EXPECT().Remove(s.domainID, s.bridgeAddress.Hex()).Return(nil),
+		s.mockMessageQueue.EXPECT().Peek(s.domainID, s.bridgeAddress.Hex()).DoAndReturn(func(domainID uint8, bridge string) (*message.Message, error) {
+			defer close(drained)
+			return nil, nil
+		}),
+	)
+
+	go s.pauseAwareVoter.Start(s.stop)
+	s.events <- &bridge.Event{Sig: util.Unpaused, Data: &bridge.Unpaused{}}
+
+	select {
+	case <-drained:
+	case <-time.After(time.Second):
+		s.Fail("parked messages not drained")
+	}
+}
+
+func (s *PauseAwareVoterTestSuite) TestStart_ReturnsWhenEventsChannelClosed() {
+	s.mockPausableBridge.EXPECT().IsPaused().Return(true, nil)
+	done := make(chan struct{})
+
+	go func() {
+		s.pauseAwareVoter.Start(s.stop)
+		close(done)
+	}()
+	close(s.events)
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		s.Fail("voter did not stop")
+	}
+}
+
+func (s *PauseAwareVoterTestSuite) TestStart_RepeatedlyFailingParkedMessageDropped() {
+	voter.DrainRetryPeriod = time.Millisecond
+	m := &message.Message{DepositNonce: 1}
+	next := &message.Message{DepositNonce: 2}
+	drained := make(chan struct{})
+	gomock.InOrder(
+		s.mockPausableBridge.EXPECT().IsPaused().Return(true, nil),
+		s.mockPausableBridge.EXPECT().IsPaused().Return(false, nil).Times(6),
+	)
+	s.mockMessageQueue.EXPECT().Peek(s.domainID, s.bridgeAddress.Hex()).Return(m, nil).Times(5)
+	s.mockVoter.EXPECT().VoteProposal(m, s.chainConfig).Return(errors.New("no handler")).Times(5)
+	gomock.InOrder(
+		s.mockAlerter.EXPECT().Alert(gomock.Any()).Do(func(a *voter.Alert) {
+			s.Equal(voter.AlertDroppedMessage, a.Kind)
+			s.Equal(uint64(1), a.Details["nonce"])
+		}),
+		s.mockMessageQueue.EXPECT().Remove(s.domainID, s.bridgeAddress.Hex()).Return(nil),
+		s.mockMessageQueue.EXPECT().Peek(s.domainID, s.bridgeAddress.Hex()).Return(next, nil),
+		s.mockVoter.EXPECT().VoteProposal(next, s.chainConfig).Return(nil),
+		s.mockMessageQueue.EXPECT().Remove(s.domainID, s.bridgeAddress.Hex()).Return(nil),
+		s.mockMessageQueue.EXPECT().Peek(s.domainID, s.bridgeAddress.Hex()).DoAndReturn(func(domainID uint8, bridge string) (*message.Message, error) {
+			defer close(drained)
+			return nil, nil
+		}),
+	)
+
+	go s.pauseAwareVoter.Start(s.stop)
+	s.events <- &bridge.Event{Sig: util.Unpaused, Data: &bridge.Unpaused{}}
+
+	select {
+	case <-drained:
+	case <-time.After(time.Second):
+		s.Fail("parked messages not drained")
+	}
+}
+
+func (s *PauseAwareVoterTestSuite) TestVoteProposal_DepositNotConfirmedParkedAndRetried() {
+	voter.VerifyRetryPeriod = time.Millisecond
+	m := &message.Message{DepositNonce: 1}
+	seeded := make(chan struct{})
+	drained := make(chan struct{})
+	s.mockPausableBridge.EXPECT().IsPaused().Return(false, nil).Times(2)
+	gomock.InOrder(
+		s.mockMessageQueue.EXPECT().Peek(s.domainID, s.bridgeAddress.Hex()).DoAndReturn(func(domainID uint8, bridge string) (*message.Message, error) {
+			defer close(seeded)
+			return nil, nil
+		}),
+		s.mockVoter.EXPECT().VoteProposal(m, s.chainConfig).Return(voter.ErrDepositNotConfirmed),
+		s.mockMessageQueue.EXPECT().Push(s.domainID, s.bridgeAddress.Hex(), m).Return(nil),
+		s.mockMessageQueue.EXPECT().Peek(s.domainID, s.bridgeAddress.Hex()).Return(m, nil),
+		s.mockVoter.EXPECT().VoteProposal(m, s.chainConfig).Return(voter.ErrDepositNotConfirmed),
+		s.mockMessageQueue.EXPECT().Peek(s.domainID, s.bridgeAddress.Hex()).Return(m, nil),
+		s.mockVoter.EXPECT().VoteProposal(m, s.chainConfig).Return(nil),
+		s.mockMessageQueue.EXPECT().Remove(s.domainID, s.bridgeAddress.Hex()).Return(nil),
+		s.mockMessageQueue.EXPECT().Peek(s.domainID, s.bridgeAddress.Hex()).DoAndReturn(func(domainID uint8, bridge string) (*message.Message, error) {
+			defer close(drained)
+			return nil, nil
+		}),
+	)
+
+	go s.pauseAwareVoter.Start(s.stop)
+	<-seeded
+	err := s.pauseAwareVoter.VoteProposal(m, s.chainConfig)
+
+	s.Nil(err)
+	select {
+	case <-drained:
+	case <-time.After(time.Second):
+		s.Fail("unconfirmed message not voted for again")
+	}
+}
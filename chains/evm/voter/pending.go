@@ -0,0 +1,120 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package voter
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// pendingVotesTTL is the time after which pending votes of a proposal
+// are discarded if they were not updated meanwhile
+const pendingVotesTTL = time.Minute * 30
+
+type pendingVote struct {
+	count   uint8
+	updated time.Time
+}
+
+// PendingVotes tracks voteProposal transactions that were sent by relayers
+// but are not mined yet, keyed by proposal ID. It is safe for concurrent use.
+type PendingVotes struct {
+	votes     map[common.Hash]*pendingVote
+	ttl       time.Duration
+	lastPrune time.Time
+	lock      sync.Mutex
+}
+
+// NewPendingVotes creates PendingVotes that discards entries not updated for ttl
+func NewPendingVotes(ttl time.Duration) *PendingVotes {
+	return &PendingVotes{
+		votes:     make(map[common.Hash]*pendingVote),
+		ttl:       ttl,
+		lastPrune: time.Now(),
+	}
+}
+
+// Increase adds a pending vote for proposal
+func (p *PendingVotes) Increase(propID common.Hash) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	now := time.Now()
+	p.prune(now)
+
+	v, ok := p.votes[propID]
+	if !ok {
+		v = &pendingVote{}
+		p.votes[propID] = v
+	}
+	if v.count < ^uint8(0) {
+		v.count++
+	}
+	v.updated = now
+}
+
+// Decrease removes a pending vote for proposal once vote transaction is mined or dropped
+func (p *PendingVotes) Decrease(propID common.Hash) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	v, ok := p.votes[propID]
+	if !ok {
+		return
+	}
+	v.count--
+	v.updated = time.Now()
+	if v.count == 0 {
+		delete(p.votes, propID)
+	}
+}
+
+// Count returns amount of pending votes for proposal
+func (p *PendingVotes) Count(propID common.Hash) uint8 {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	v, ok := p.votes[propID]
+	if !ok || p.expired(v, time.Now()) {
+		return 0
+	}
+	return v.count
+}
+
+// Remove discards all pending votes for proposal
+func (p *PendingVotes) Remove(propID common.Hash) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	delete(p.votes, propID)
+}
+
+// Len returns amount of proposals with pending votes
+func (p *PendingVotes) Len() int {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.prune(time.Now())
+	return len(p.votes)
+}
+
+func (p *PendingVotes) expired(v *pendingVote, now time.Time) bool {
+	return now.Sub(v.updated) > p.ttl
+}
+
+// prune removes expired entries at most once per ttl. Has to be called with lock held.
+func (p *PendingVotes) prune(now time.Time) {
+	if now.Sub(p.lastPrune) < p.ttl {
+		return
+	}
+
+	for id, v := range p.votes {
+		if p.expired(v, now) {
+			delete(p.votes, id)
+		}
+	}
+	p.lastPrune = now
+}
@@ -0,0 +1,91 @@
+package voter_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/voter"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type PendingVotesTestSuite struct {
+	suite.Suite
+	pendingVotes *voter.PendingVotes
+	propID       common.Hash
+}
+
+func TestRunPendingVotesTestSuite(t *testing.T) {
+	suite.Run(t, new(PendingVotesTestSuite))
+}
+
+func (s *PendingVotesTestSuite) SetupSuite()    {}
+func (s *PendingVotesTestSuite) TearDownSuite() {}
+func (s *PendingVotesTestSuite) SetupTest() {
+	s.pendingVotes = voter.NewPendingVotes(time.Minute)
+	s.propID = common.HexToHash("0x1")
+}
+func (s *PendingVotesTestSuite) TearDownTest() {}
+
+func (s *PendingVotesTestSuite) TestCount_UnknownProposal() {
+	s.Equal(uint8(0), s.pendingVotes.Count(s.propID))
+}
+
+func (s *PendingVotesTestSuite) TestIncreaseAndDecrease() {
+	s.pendingVotes.Increase(s.propID)
+	s.pendingVotes.Increase(s.propID)
+	s.pendingVotes.Decrease(s.propID)
+
+	s.Equal(uint8(1), s.pendingVotes.Count(s.propID))
+
+	s.pendingVotes.Decrease(s.propID)
+
+	s.Equal(uint8(0), s.pendingVotes.Count(s.propID))
+	s.Equal(0, s.pendingVotes.Len())
+}
+
+func (s *PendingVotesTestSuite) TestDecrease_AfterRemoveDoesNotUnderflow() {
+	s.pendingVotes.Increase(s.propID)
+	s.pendingVotes.Remove(s.propID)
+
+	s.pendingVotes.Decrease(s.propID)
+
+	s.Equal(uint8(0), s.pendingVotes.Count(s.propID))
+}
+
+func (s *PendingVotesTestSuite) TestCount_ExpiredEntry() {
+	pendingVotes := voter.NewPendingVotes(time.Millisecond * 10)
+	pendingVotes.Increase(s.propID)
+
+	time.Sleep(time.Millisecond * 20)
+
+	s.Equal(uint8(0), pendingVotes.Count(s.propID))
+	s.Equal(0, pendingVotes.Len())
+}
+
+func (s *PendingVotesTestSuite) TestConcurrentAccess() {
+	otherPropID := common.HexToHash("0x2")
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(3)
+		go func() {
+			defer wg.Done()
+			s.pendingVotes.Increase(s.propID)
+			s.pendingVotes.Decrease(s.propID)
+		}()
+		go func() {
+			defer wg.Done()
+			s.pendingVotes.Increase(otherPropID)
+		}()
+		go func() {
+			defer wg.Done()
+			s.pendingVotes.Count(s.propID)
+			s.pendingVotes.Remove(common.HexToHash("0x3"))
+		}()
+	}
+	wg.Wait()
+
+	s.Equal(uint8(0), s.pendingVotes.Count(s.propID))
+	s.Equal(uint8(100), s.pendingVotes.Count(otherPropID))
+}
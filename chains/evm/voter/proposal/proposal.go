@@ -1,16 +1,19 @@
 package proposal
 
 import (
+	"encoding/binary"
+
 	"github.com/ChainSafe/chainbridge-core/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 )
 
-func NewProposal(source uint8, depositNonce uint64, resourceId types.ResourceID, data []byte, handlerAddress, bridgeAddress common.Address, depositTxHash common.Hash, depositBlock uint64) *Proposal {
+func NewProposal(source, destination uint8, depositNonce uint64, resourceId types.ResourceID, data []byte, handlerAddress, bridgeAddress common.Address, depositTxHash common.Hash, depositBlock uint64) *Proposal {
 	return &Proposal{
 		DepositTxHash:  depositTxHash,
 		DepositBlock:   depositBlock,
 		Source:         source,
+		Destination:    destination,
 		DepositNonce:   depositNonce,
 		ResourceId:     resourceId,
 		Data:           data,
@@ -23,6 +26,7 @@ type Proposal struct {
 	DepositTxHash  common.Hash // transaction hash of the deposit transaction
 	DepositBlock   uint64      // Block the Deposit transaction were made
 	Source         uint8       // Source domainID where message was initiated
+	Destination    uint8       // Destination domainID where proposal is voted for
 	DepositNonce   uint64      // Nonce for the deposit
 	ResourceId     types.ResourceID
 	Payload        []interface{} // data associated with event sequence
@@ -31,12 +35,20 @@ type Proposal struct {
 	BridgeAddress  common.Address
 }
 
-// GetDataHash constructs and returns proposal data hash
+// GetDataHash constructs and returns proposal data hash the same way
+// bridge contract does, from handler address and proposal data.
+// The hash does not depend on resourceID, it is the key under which
+// getProposal and _hasVotedOnProposal store the proposal on the bridge.
 func (p *Proposal) GetDataHash() common.Hash {
-	return crypto.Keccak256Hash(append(p.ResourceId[:], p.Data...))
+	return crypto.Keccak256Hash(p.HandlerAddress.Bytes(), p.Data)
 }
 
-// GetID constructs proposal unique identifier
+// GetID constructs proposal unique identifier from source, destination,
+// deposit nonce, resourceID and data. It does not depend on handler address
+// so it can be computed from voteProposal calldata. It is not the data hash
+// of the proposal on the bridge, use GetDataHash for bridge calls.
 func (p *Proposal) GetID() common.Hash {
-	return crypto.Keccak256Hash(append([]byte{p.Source}, byte(p.DepositNonce)))
+	nonce := make([]byte, 8)
+	binary.BigEndian.PutUint64(nonce, p.DepositNonce)
+	return crypto.Keccak256Hash([]byte{p.Source, p.Destination}, nonce, p.ResourceId[:], p.Data)
 }
@@ -0,0 +1,73 @@
+package proposal_test
+
+import (
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/voter/proposal"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+)
+
+type ProposalTestSuite struct {
+	suite.Suite
+}
+
+func TestRunProposalTestSuite(t *testing.T) {
+	suite.Run(t, new(ProposalTestSuite))
+}
+
+func (s *ProposalTestSuite) SetupSuite()    {}
+func (s *ProposalTestSuite) TearDownSuite() {}
+func (s *ProposalTestSuite) SetupTest()     {}
+func (s *ProposalTestSuite) TearDownTest()  {}
+
+func (s *ProposalTestSuite) TestGetID_DifferentNoncesDoNotCollide() {
+	p1 := proposal.NewProposal(1, 2, 1, [32]byte{1}, []byte{1}, common.Address{}, common.Address{}, common.Hash{}, 0)
+	p2 := proposal.NewProposal(1, 2, 257, [32]byte{1}, []byte{1}, common.Address{}, common.Address{}, common.Hash{}, 0)
+
+	s.NotEqual(p1.GetID(), p2.GetID())
+}
+
+func (s *ProposalTestSuite) TestGetID_DependsOnDestinationAndData() {
+	p := proposal.NewProposal(1, 2, 1, [32]byte{1}, []byte{1}, common.Address{}, common.Address{}, common.Hash{}, 0)
+	otherDestination := proposal.NewProposal(1, 3, 1, [32]byte{1}, []byte{1}, common.Address{}, common.Address{}, common.Hash{}, 0)
+	otherData := proposal.NewProposal(1, 2, 1, [32]byte{1}, []byte{2}, common.Address{}, common.Address{}, common.Hash{}, 0)
+
+	s.NotEqual(p.GetID(), otherDestination.GetID())
+	s.NotEqual(p.GetID(), otherData.GetID())
+}
+
+func (s *ProposalTestSuite) TestGetID_SameProposal() {
+	p1 := proposal.NewProposal(1, 2, 1, [32]byte{1}, []byte{1}, common.Address{}, common.Address{}, common.Hash{}, 0)
+	p2 := proposal.NewProposal(1, 2, 1, [32]byte{1}, []byte{1}, common.HexToAddress("0x1"), common.Address{}, common.HexToHash("0x2"), 5)
+
+	s.Equal(p1.GetID(), p2.GetID())
+}
+
+func (s *ProposalTestSuite) TestGetDataHash_MatchesBridgeContract() {
+	p := proposal.NewProposal(1, 2, 1, [32]byte{1, 2}, []byte{9, 9, 9}, common.HexToAddress("0x9bC6E3BD51CD1b6F4c1B0BaE45a0b2c1D1e0fB24"), common.Address{}, common.Hash{}, 0)
+
+	s.Equal(
+		crypto.Keccak256Hash(common.HexToAddress("0x9bC6E3BD51CD1b6F4c1B0BaE45a0b2c1D1e0fB24").Bytes(), []byte{9, 9, 9}),
+		p.GetDataHash(),
+	)
+}
+
+func (s *ProposalTestSuite) TestGetDataHash_DoesNotDependOnResourceID() {
+	p1 := proposal.NewProposal(1, 2, 1, [32]byte{1}, []byte{1}, common.HexToAddress("0x1"), common.Address{}, common.Hash{}, 0)
+	p2 := proposal.NewProposal(1, 2, 1, [32]byte{2}, []byte{1}, common.HexToAddress("0x1"), common.Address{}, common.Hash{}, 0)
+	otherHandler := proposal.NewProposal(1, 2, 1, [32]byte{1}, []byte{1}, common.HexToAddress("0x2"), common.Address{}, common.Hash{}, 0)
+
+	s.Equal(p1.GetDataHash(), p2.GetDataHash())
+	s.NotEqual(p1.GetDataHash(), otherHandler.GetDataHash())
+}
+
+func (s *ProposalTestSuite) TestGetID_DependsOnResourceIDNotHandler() {
+	p := proposal.NewProposal(1, 2, 1, [32]byte{1}, []byte{1}, common.HexToAddress("0x1"), common.Address{}, common.Hash{}, 0)
+	otherResource := proposal.NewProposal(1, 2, 1, [32]byte{2}, []byte{1}, common.HexToAddress("0x1"), common.Address{}, common.Hash{}, 0)
+	otherHandler := proposal.NewProposal(1, 2, 1, [32]byte{1}, []byte{1}, common.HexToAddress("0x2"), common.Address{}, common.Hash{}, 0)
+
+	s.NotEqual(p.GetID(), otherResource.GetID())
+	s.Equal(p.GetID(), otherHandler.GetID())
+}
@@ -0,0 +1,92 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package voter
+
+import (
+	"sync"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+)
+
+// RelayerGetter reads relayers registered on the bridge
+type RelayerGetter interface {
+	GetRelayers() ([]common.Address, error)
+}
+
+// RelayerProvider provides relayers registered on the bridge
+type RelayerProvider interface {
+	Relayers() ([]common.Address, error)
+}
+
+// RelayerSet caches relayers registered on the bridge so that they are not read from
+// chain for every proposal. Cached relayers are read again after RelayerAdded or
+// RelayerRemoved event is received. It is safe for concurrent use.
+type RelayerSet struct {
+	bridge   RelayerGetter
+	events   <-chan *bridge.Event
+	relayers []common.Address
+	// generation is increased on every invalidation so that relayers read
+	// concurrently with an invalidation are not cached
+	generation uint64
+	lock       sync.Mutex
+}
+
+// NewRelayerSet creates a RelayerSet. Events channel should receive bridge RelayerAdded
+// and RelayerRemoved events from the destination chain listener.
+func NewRelayerSet(bridge RelayerGetter, events <-chan *bridge.Event) *RelayerSet {
+	return &RelayerSet{
+		bridge: bridge,
+		events: events,
+	}
+}
+
+// Start invalidates cached relayers on relayer set changes until stop channel is closed
+func (s *RelayerSet) Start(stop <-chan struct{}) {
+	for {
+		select {
+		case <-stop:
+			return
+		case evt, ok := <-s.events:
+			if !ok {
+				return
+			}
+			log.Debug().Msgf("Bridge relayers changed on %s event", evt.Sig)
+			s.invalidate()
+		}
+	}
+}
+
+// Relayers returns cached relayers registered on the bridge, reading them from chain if needed
+func (s *RelayerSet) Relayers() ([]common.Address, error) {
+	s.lock.Lock()
+	if s.relayers != nil {
+		relayers := s.relayers
+		s.lock.Unlock()
+		return relayers, nil
+	}
+	generation := s.generation
+	s.lock.Unlock()
+
+	relayers, err := s.bridge.GetRelayers()
+	if err != nil {
+		return nil, err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if generation == s.generation {
+		s.relayers = relayers
+	}
+	return relayers, nil
+}
+
+func (s *RelayerSet) invalidate() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.relayers = nil
+	s.generation++
+}
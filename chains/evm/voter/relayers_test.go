@@ -0,0 +1,96 @@
+package voter_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/voter"
+	mock_voter "github.com/ChainSafe/chainbridge-core/chains/evm/voter/mock"
+	"github.com/ChainSafe/chainbridge-core/util"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type RelayerSetTestSuite struct {
+	suite.Suite
+	relayerSet        *voter.RelayerSet
+	mockRelayerGetter *mock_voter.MockRelayerGetter
+	events            chan *bridge.Event
+	stop              chan struct{}
+}
+
+func TestRunRelayerSetTestSuite(t *testing.T) {
+	suite.Run(t, new(RelayerSetTestSuite))
+}
+
+func (s *RelayerSetTestSuite) SetupSuite()    {}
+func (s *RelayerSetTestSuite) TearDownSuite() {}
+func (s *RelayerSetTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.mockRelayerGetter = mock_voter.NewMockRelayerGetter(gomockController)
+	s.events = make(chan *bridge.Event)
+	s.stop = make(chan struct{})
+	s.relayerSet = voter.NewRelayerSet(s.mockRelayerGetter, s.events)
+}
+func (s *RelayerSetTestSuite) TearDownTest() {
+	close(s.stop)
+}
+
+func (s *RelayerSetTestSuite) TestRelayers_Cached() {
+	relayers := []common.Address{common.HexToAddress("0xa")}
+	s.mockRelayerGetter.EXPECT().GetRelayers().Return(relayers, nil).Times(1)
+
+	first, err := s.relayerSet.Relayers()
+	s.Nil(err)
+	second, err := s.relayerSet.Relayers()
+	s.Nil(err)
+
+	s.Equal(relayers, first)
+	s.Equal(relayers, second)
+}
+
+func (s *RelayerSetTestSuite) TestRelayers_FailedReadNotCached() {
+	relayers := []common.Address{common.HexToAddress("0xa")}
+	gomock.InOrder(
+		s.mockRelayerGetter.EXPECT().GetRelayers().Return(nil, errors.New("error")),
+		s.mockRelayerGetter.EXPECT().GetRelayers().Return(relayers, nil),
+	)
+
+	_, err := s.relayerSet.Relayers()
+	s.NotNil(err)
+	res, err := s.relayerSet.Relayers()
+
+	s.Nil(err)
+	s.Equal(relayers, res)
+}
+
+func (s *RelayerSetTestSuite) TestStart_RelayerEventsInvalidateCache() {
+	relayers := []common.Address{common.HexToAddress("0xa")}
+	added := []common.Address{common.HexToAddress("0xa"), common.HexToAddress("0xb")}
+	removed := []common.Address{common.HexToAddress("0xb")}
+	gomock.InOrder(
+		s.mockRelayerGetter.EXPECT().GetRelayers().Return(relayers, nil),
+		s.mockRelayerGetter.EXPECT().GetRelayers().Return(added, nil),
+		s.mockRelayerGetter.EXPECT().GetRelayers().Return(removed, nil),
+	)
+	go s.relayerSet.Start(s.stop)
+
+	res, err := s.relayerSet.Relayers()
+	s.Nil(err)
+	s.Equal(relayers, res)
+
+	s.events <- &bridge.Event{Sig: util.RelayerAdded, Data: &bridge.RelayerAdded{Relayer: added[1]}}
+	s.Eventually(func() bool {
+		res, err := s.relayerSet.Relayers()
+		return err == nil && len(res) == len(added)
+	}, time.Second, time.Millisecond)
+
+	s.events <- &bridge.Event{Sig: util.RelayerRemoved, Data: &bridge.RelayerRemoved{Relayer: added[0]}}
+	s.Eventually(func() bool {
+		res, err := s.relayerSet.Relayers()
+		return err == nil && len(res) == len(removed)
+	}, time.Second, time.Millisecond)
+}
@@ -0,0 +1,66 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package voter
+
+import (
+	"fmt"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/multicall"
+	"github.com/ChainSafe/chainbridge-core/store"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Revoter votes again for proposal of voteProposal calldata
+type Revoter interface {
+	Revote(calldata []byte) error
+}
+
+// VoteResender sends again votes of transactions that were dropped or replaced by other
+// transaction. Each vote goes through voter of its bridge so proposals relayer already
+// voted for or that were finalized meanwhile are not voted for again.
+type VoteResender struct {
+	voters    map[common.Address]Revoter
+	multicall *common.Address
+}
+
+func NewVoteResender() *VoteResender {
+	return &VoteResender{
+		voters: make(map[common.Address]Revoter),
+	}
+}
+
+// AddVoter makes resender send votes for proposals of bridge through voter
+func (r *VoteResender) AddVoter(bridgeAddress common.Address, voter Revoter) {
+	r.voters[bridgeAddress] = voter
+}
+
+// SetMulticall makes resender send again votes batched through multicall contract
+func (r *VoteResender) SetMulticall(address common.Address) {
+	r.multicall = &address
+}
+
+// Resend votes again for proposals voted for by transaction. Batched votes are voted for one by one.
+func (r *VoteResender) Resend(tx *store.SentTx) error {
+	calls := []multicall.Call{{Target: tx.To, CallData: tx.Data}}
+	if r.multicall != nil && tx.To == *r.multicall {
+		var err error
+		calls, err = multicall.UnpackAggregate(tx.Data)
+		if err != nil {
+			return err
+		}
+	}
+
+	var lastErr error
+	for _, call := range calls {
+		voter, ok := r.voters[call.Target]
+		if !ok {
+			return fmt.Errorf("transaction to %s is not a vote", call.Target.Hex())
+		}
+		err := voter.Revote(call.CallData)
+		if err != nil {
+			lastErr = err
+		}
+	}
+	return lastErr
+}
@@ -0,0 +1,71 @@
+package voter_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/consts"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/multicall"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/voter"
+	mock_voter "github.com/ChainSafe/chainbridge-core/chains/evm/voter/mock"
+	"github.com/ChainSafe/chainbridge-core/store"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type VoteResenderTestSuite struct {
+	suite.Suite
+	resender      *voter.VoteResender
+	mockRevoter   *mock_voter.MockRevoter
+	bridgeAddress common.Address
+	multicallAddr common.Address
+}
+
+func TestRunVoteResenderTestSuite(t *testing.T) {
+	suite.Run(t, new(VoteResenderTestSuite))
+}
+
+func (s *VoteResenderTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.mockRevoter = mock_voter.NewMockRevoter(gomockController)
+	s.bridgeAddress = common.HexToAddress("0xb")
+	s.multicallAddr = common.HexToAddress("0xc")
+	s.resender = voter.NewVoteResender()
+	s.resender.AddVoter(s.bridgeAddress, s.mockRevoter)
+	s.resender.SetMulticall(s.multicallAddr)
+}
+
+func (s *VoteResenderTestSuite) TestResend_Vote() {
+	s.mockRevoter.EXPECT().Revote([]byte{1}).Return(nil)
+
+	err := s.resender.Resend(&store.SentTx{To: s.bridgeAddress, Data: []byte{1}})
+
+	s.Nil(err)
+}
+
+func (s *VoteResenderTestSuite) TestResend_BatchedVotes() {
+	a, err := abi.JSON(strings.NewReader(consts.MulticallForwarderABI))
+	s.Nil(err)
+	data, err := a.Pack("aggregate", []multicall.Call{
+		{Target: s.bridgeAddress, CallData: []byte{1}},
+		{Target: s.bridgeAddress, CallData: []byte{2}},
+	})
+	s.Nil(err)
+	gomock.InOrder(
+		s.mockRevoter.EXPECT().Revote([]byte{1}).Return(errors.New("error")),
+		s.mockRevoter.EXPECT().Revote([]byte{2}).Return(nil),
+	)
+
+	err = s.resender.Resend(&store.SentTx{To: s.multicallAddr, Data: data})
+
+	s.NotNil(err)
+}
+
+func (s *VoteResenderTestSuite) TestResend_UnknownTarget() {
+	err := s.resender.Resend(&store.SentTx{To: common.HexToAddress("0xd"), Data: []byte{1}})
+
+	s.NotNil(err)
+}
@@ -0,0 +1,249 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package voter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/relayer/messageprocessors"
+	"github.com/ChainSafe/chainbridge-core/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	maxVerifyChecks = 10
+	// depositSearchBlocks is the number of latest confirmed blocks searched for deposit logs
+	depositSearchBlocks = 10000
+	// depositSearchRange is the number of blocks from which deposit logs are read in a single request
+	depositSearchRange = 1000
+)
+
+var (
+	// VerifyRetryPeriod is the time voter waits before verifying message again
+	// if deposit is not confirmed on the source chain yet
+	VerifyRetryPeriod = 15 * time.Second
+)
+
+// ErrDepositNotConfirmed is returned if deposit transaction is not found
+// or does not have enough confirmations on the source chain yet
+var ErrDepositNotConfirmed = errors.New("deposit not confirmed on source chain")
+
+// MessageVerifier checks that message matches a deposit made on the source chain
+type MessageVerifier interface {
+	Verify(m *message.Message) error
+}
+
+type SourceChainClient interface {
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*ethereumTypes.Receipt, error)
+	LatestBlock() (*big.Int, error)
+	FetchEventLogs(ctx context.Context, contractAddress common.Address, event string, startBlock *big.Int, endBlock *big.Int) ([]ethereumTypes.Log, error)
+}
+
+type DepositEventHandler interface {
+	HandleEvent(sourceID, destID uint8, nonce uint64, resourceID types.ResourceID, calldata, handlerResponse []byte, depositTxHash common.Hash, depositBlock uint64) (*message.Message, error)
+}
+
+type sourceBridge struct {
+	adapter      bridge.ABIAdapter
+	eventHandler DepositEventHandler
+}
+
+// DepositSource verifies messages against deposits made on a single source chain
+type DepositSource struct {
+	client             SourceChainClient
+	blockConfirmations *big.Int
+	bridges            map[common.Address]*sourceBridge
+}
+
+// NewDepositSource creates a DepositSource that requires deposits
+// to have blockConfirmations on the source chain
+func NewDepositSource(client SourceChainClient, blockConfirmations *big.Int) *DepositSource {
+	return &DepositSource{
+		client:             client,
+		blockConfirmations: blockConfirmations,
+		bridges:            make(map[common.Address]*sourceBridge),
+	}
+}
+
+// RegisterBridge registers bridge contract whose deposit logs are accepted. Event handler
+// converts deposit logs into messages the same way as the source chain listener.
+func (s *DepositSource) RegisterBridge(address common.Address, adapter bridge.ABIAdapter, eventHandler DepositEventHandler) {
+	s.bridges[address] = &sourceBridge{
+		adapter:      adapter,
+		eventHandler: eventHandler,
+	}
+}
+
+// Verify fetches receipt of the deposit transaction and checks that it contains a deposit
+// log that resolves to the same message. Message processors are applied to the resolved
+// message before comparison.
+func (s *DepositSource) Verify(m *message.Message, processors ...messageprocessors.MessageProcessor) error {
+	receipt, err := s.client.TransactionReceipt(context.Background(), m.DepositTxHash)
+	if err != nil {
+		return fmt.Errorf("%w: fetching receipt of %s failed: %s", ErrDepositNotConfirmed, m.DepositTxHash.Hex(), err)
+	}
+	if receipt.Status != ethereumTypes.ReceiptStatusSuccessful {
+		return fmt.Errorf("deposit transaction %s failed", m.DepositTxHash.Hex())
+	}
+	if receipt.BlockNumber.Uint64() != m.DepositBlock {
+		return fmt.Errorf("deposit transaction %s mined in block %d instead of %d", m.DepositTxHash.Hex(), receipt.BlockNumber.Uint64(), m.DepositBlock)
+	}
+
+	head, err := s.client.LatestBlock()
+	if err != nil {
+		return err
+	}
+	confirmations := big.NewInt(0).Sub(head, receipt.BlockNumber)
+	if confirmations.Cmp(s.blockConfirmations) == -1 {
+		return fmt.Errorf("%w: deposit %s has %s of %s confirmations", ErrDepositNotConfirmed, m.DepositTxHash.Hex(), confirmations, s.blockConfirmations)
+	}
+
+	for _, l := range receipt.Logs {
+		matches, err := s.matchesLog(m, l, processors)
+		if err != nil {
+			log.Warn().Err(err).Str("txHash", m.DepositTxHash.Hex()).Uint("logIndex", l.Index).Msg("Failed resolving deposit log")
+			continue
+		}
+		if matches {
+			return nil
+		}
+	}
+	return fmt.Errorf("no matching deposit log found in transaction %s for message %s", m.DepositTxHash.Hex(), m.String())
+}
+
+func (s *DepositSource) matchesLog(m *message.Message, l *ethereumTypes.Log, processors []messageprocessors.MessageProcessor) (bool, error) {
+	b, ok := s.bridges[l.Address]
+	if !ok || len(l.Topics) == 0 || l.Topics[0] != b.adapter.DepositEvent().GetTopic() {
+		return false, nil
+	}
+
+	dl, err := b.adapter.UnpackDepositLog(*l)
+	if err != nil {
+		return false, err
+	}
+	if dl.DestinationDomainID != m.Destination || dl.DepositNonce != m.DepositNonce || dl.ResourceID != m.ResourceId {
+		return false, nil
+	}
+
+	expected, err := b.message(m.Source, dl, l, processors)
+	if err != nil {
+		return false, err
+	}
+	if expected.Type != m.Type || !reflect.DeepEqual(expected.Payload, m.Payload) {
+		return false, fmt.Errorf("deposit data does not match message %s", m.String())
+	}
+	return true, nil
+}
+
+// Deposits searches deposit logs of the last depositSearchBlocks confirmed blocks for deposits
+// to destination with nonce and resolves them into messages from source domain. Message
+// processors are applied to resolved messages.
+func (s *DepositSource) Deposits(source, destination uint8, nonce uint64, processors ...messageprocessors.MessageProcessor) ([]*message.Message, error) {
+	head, err := s.client.LatestBlock()
+	if err != nil {
+		return nil, err
+	}
+	end := new(big.Int).Sub(head, s.blockConfirmations)
+	if end.Sign() < 0 {
+		return nil, nil
+	}
+	start := new(big.Int).Sub(end, big.NewInt(depositSearchBlocks-1))
+	if start.Sign() < 0 {
+		start = big.NewInt(0)
+	}
+
+	var messages []*message.Message
+	for address, b := range s.bridges {
+		for from := new(big.Int).Set(start); from.Cmp(end) <= 0; from = new(big.Int).Add(from, big.NewInt(depositSearchRange)) {
+			to := new(big.Int).Add(from, big.NewInt(depositSearchRange-1))
+			if to.Cmp(end) > 0 {
+				to = end
+			}
+			logs, err := s.client.FetchEventLogs(context.Background(), address, string(b.adapter.DepositEvent()), from, to)
+			if err != nil {
+				return nil, err
+			}
+			for i := range logs {
+				dl, err := b.adapter.UnpackDepositLog(logs[i])
+				if err != nil || dl.DestinationDomainID != destination || dl.DepositNonce != nonce {
+					continue
+				}
+				m, err := b.message(source, dl, &logs[i], processors)
+				if err != nil {
+					log.Warn().Err(err).Str("txHash", logs[i].TxHash.Hex()).Uint("logIndex", logs[i].Index).Msg("Failed resolving deposit log")
+					continue
+				}
+				messages = append(messages, m)
+			}
+		}
+	}
+	return messages, nil
+}
+
+// message converts deposit log into message the same way as the source chain listener
+// and applies message processors to it
+func (b *sourceBridge) message(source uint8, dl *evmclient.DepositLogs, l *ethereumTypes.Log, processors []messageprocessors.MessageProcessor) (*message.Message, error) {
+	m, err := b.eventHandler.HandleEvent(source, dl.DestinationDomainID, dl.DepositNonce, dl.ResourceID, dl.Data, dl.HandlerResponse, l.TxHash, l.BlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range processors {
+		err = p(m)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// DepositVerifier verifies messages against deposits on their source chains
+type DepositVerifier struct {
+	sources    map[uint8]*DepositSource
+	processors []messageprocessors.MessageProcessor
+}
+
+// NewDepositVerifier creates a DepositVerifier. Processors should be the same message
+// processors the relayer applies to messages before they are written to destination.
+func NewDepositVerifier(processors ...messageprocessors.MessageProcessor) *DepositVerifier {
+	return &DepositVerifier{
+		sources:    make(map[uint8]*DepositSource),
+		processors: processors,
+	}
+}
+
+// RegisterSource registers deposit source for domainID
+func (v *DepositVerifier) RegisterSource(domainID uint8, source *DepositSource) {
+	v.sources[domainID] = source
+}
+
+// Deposits returns messages resolved from deposits made on source domain to destination with nonce.
+// Usually there is a single deposit, more are returned only if several bridges are registered on source.
+func (v *DepositVerifier) Deposits(source, destination uint8, nonce uint64) ([]*message.Message, error) {
+	s, ok := v.sources[source]
+	if !ok {
+		return nil, fmt.Errorf("no deposit source registered for domain %d", source)
+	}
+	return s.Deposits(source, destination, nonce, v.processors...)
+}
+
+// Verify verifies message against its source chain. ErrDepositNotConfirmed is returned
+// if deposit is not found or does not have enough confirmations yet, verification
+// is not retried.
+func (v *DepositVerifier) Verify(m *message.Message) error {
+	source, ok := v.sources[m.Source]
+	if !ok {
+		return fmt.Errorf("no deposit source registered for domain %d", m.Source)
+	}
+	return source.Verify(m, v.processors...)
+}
@@ -0,0 +1,204 @@
+package voter_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/voter"
+	mock_voter "github.com/ChainSafe/chainbridge-core/chains/evm/voter/mock"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/util"
+	"github.com/ethereum/go-ethereum/common"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type DepositVerifierTestSuite struct {
+	suite.Suite
+	mockClient       *mock_voter.MockSourceChainClient
+	mockEventHandler *mock_voter.MockDepositEventHandler
+	bridgeAddress    common.Address
+	verifier         *voter.DepositVerifier
+	message          *message.Message
+}
+
+func TestRunDepositVerifierTestSuite(t *testing.T) {
+	suite.Run(t, new(DepositVerifierTestSuite))
+}
+
+func (s *DepositVerifierTestSuite) SetupSuite()    {}
+func (s *DepositVerifierTestSuite) TearDownSuite() {}
+func (s *DepositVerifierTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.mockClient = mock_voter.NewMockSourceChainClient(gomockController)
+	s.mockEventHandler = mock_voter.NewMockDepositEventHandler(gomockController)
+	s.bridgeAddress = common.HexToAddress("0x1")
+
+	source := voter.NewDepositSource(s.mockClient, big.NewInt(5))
+	source.RegisterBridge(s.bridgeAddress, bridge.DefaultABIAdapter(), s.mockEventHandler)
+	s.verifier = voter.NewDepositVerifier()
+	s.verifier.RegisterSource(1, source)
+
+	s.message = &message.Message{
+		DepositTxHash: common.HexToHash("0xabc"),
+		DepositBlock:  10,
+		Source:        1,
+		Destination:   2,
+		DepositNonce:  3,
+		ResourceId:    [32]byte{1},
+		Type:          message.FungibleTransfer,
+		Payload:       []interface{}{[]byte{1}, []byte{2}},
+	}
+}
+func (s *DepositVerifierTestSuite) TearDownTest() {}
+
+func (s *DepositVerifierTestSuite) receipt(status uint64, block int64) *ethereumTypes.Receipt {
+	data, err := bridge.DefaultABIAdapter().ABI().Events["Deposit"].Inputs.NonIndexed().Pack(
+		uint8(2), [32]byte{1}, uint64(3), []byte{5}, []byte{},
+	)
+	s.Nil(err)
+	return &ethereumTypes.Receipt{
+		Status:      status,
+		BlockNumber: big.NewInt(block),
+		Logs: []*ethereumTypes.Log{
+			{
+				Address:     s.bridgeAddress,
+				Topics:      []common.Hash{util.Deposit.GetTopic()},
+				Data:        data,
+				TxHash:      s.message.DepositTxHash,
+				BlockNumber: uint64(block),
+			},
+		},
+	}
+}
+
+func (s *DepositVerifierTestSuite) TestVerify_UnknownSource() {
+	s.message.Source = 5
+
+	err := s.verifier.Verify(s.message)
+
+	s.NotNil(err)
+}
+
+func (s *DepositVerifierTestSuite) TestVerify_ReceiptNotFound() {
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), s.message.DepositTxHash).Return(nil, errors.New("not found"))
+
+	err := s.verifier.Verify(s.message)
+
+	s.ErrorIs(err, voter.ErrDepositNotConfirmed)
+}
+
+func (s *DepositVerifierTestSuite) TestVerify_FailedTransaction() {
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), s.message.DepositTxHash).Return(s.receipt(0, 10), nil)
+
+	err := s.verifier.Verify(s.message)
+
+	s.NotNil(err)
+	s.False(errors.Is(err, voter.ErrDepositNotConfirmed))
+}
+
+func (s *DepositVerifierTestSuite) TestVerify_DifferentBlock() {
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), s.message.DepositTxHash).Return(s.receipt(1, 11), nil)
+
+	err := s.verifier.Verify(s.message)
+
+	s.NotNil(err)
+}
+
+func (s *DepositVerifierTestSuite) TestVerify_NotEnoughConfirmations() {
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), s.message.DepositTxHash).Return(s.receipt(1, 10), nil)
+	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(12), nil)
+
+	err := s.verifier.Verify(s.message)
+
+	s.ErrorIs(err, voter.ErrDepositNotConfirmed)
+}
+
+func (s *DepositVerifierTestSuite) TestVerify_Confirmed() {
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), s.message.DepositTxHash).Return(s.receipt(1, 10), nil)
+	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(15), nil)
+	s.mockEventHandler.EXPECT().HandleEvent(uint8(1), uint8(2), uint64(3), [32]byte{1}, []byte{5}, []byte{}, s.message.DepositTxHash, uint64(10)).Return(&message.Message{
+		Type:    message.FungibleTransfer,
+		Payload: []interface{}{[]byte{1}, []byte{2}},
+	}, nil)
+
+	err := s.verifier.Verify(s.message)
+
+	s.Nil(err)
+}
+
+func (s *DepositVerifierTestSuite) TestVerify_PayloadMismatch() {
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), s.message.DepositTxHash).Return(s.receipt(1, 10), nil)
+	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(20), nil)
+	s.mockEventHandler.EXPECT().HandleEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&message.Message{
+		Type:    message.FungibleTransfer,
+		Payload: []interface{}{[]byte{100}, []byte{2}},
+	}, nil)
+
+	err := s.verifier.Verify(s.message)
+
+	s.NotNil(err)
+}
+
+func (s *DepositVerifierTestSuite) TestVerify_NonceMismatch() {
+	s.message.DepositNonce = 4
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), s.message.DepositTxHash).Return(s.receipt(1, 10), nil)
+	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(20), nil)
+
+	err := s.verifier.Verify(s.message)
+
+	s.NotNil(err)
+}
+
+func (s *DepositVerifierTestSuite) TestVerify_AppliesMessageProcessors() {
+	source := voter.NewDepositSource(s.mockClient, big.NewInt(5))
+	source.RegisterBridge(s.bridgeAddress, bridge.DefaultABIAdapter(), s.mockEventHandler)
+	verifier := voter.NewDepositVerifier(func(m *message.Message) error {
+		m.Payload[0] = []byte{1}
+		return nil
+	})
+	verifier.RegisterSource(1, source)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), s.message.DepositTxHash).Return(s.receipt(1, 10), nil)
+	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(20), nil)
+	s.mockEventHandler.EXPECT().HandleEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&message.Message{
+		Type:    message.FungibleTransfer,
+		Payload: []interface{}{[]byte{100}, []byte{2}},
+	}, nil)
+
+	err := verifier.Verify(s.message)
+
+	s.Nil(err)
+}
+
+func (s *DepositVerifierTestSuite) TestDeposits_SearchesConfirmedBlocks() {
+	receipt := s.receipt(1, 10)
+	otherNonce := *receipt.Logs[0]
+	otherNonce.Data, _ = bridge.DefaultABIAdapter().ABI().Events["Deposit"].Inputs.NonIndexed().Pack(
+		uint8(2), [32]byte{1}, uint64(4), []byte{5}, []byte{},
+	)
+	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(1005), nil)
+	s.mockClient.EXPECT().FetchEventLogs(gomock.Any(), s.bridgeAddress, string(util.Deposit), big.NewInt(0), big.NewInt(999)).Return([]ethereumTypes.Log{otherNonce, *receipt.Logs[0]}, nil)
+	s.mockClient.EXPECT().FetchEventLogs(gomock.Any(), s.bridgeAddress, string(util.Deposit), big.NewInt(1000), big.NewInt(1000)).Return(nil, nil)
+	expected := &message.Message{
+		Type:    message.FungibleTransfer,
+		Payload: []interface{}{[]byte{1}, []byte{2}},
+	}
+	s.mockEventHandler.EXPECT().HandleEvent(uint8(1), uint8(2), uint64(3), [32]byte{1}, []byte{5}, []byte{}, s.message.DepositTxHash, uint64(10)).Return(expected, nil)
+
+	messages, err := s.verifier.Deposits(1, 2, 3)
+
+	s.Nil(err)
+	s.Equal([]*message.Message{expected}, messages)
+}
+
+func (s *DepositVerifierTestSuite) TestDeposits_FetchFailed() {
+	s.mockClient.EXPECT().LatestBlock().Return(big.NewInt(100), nil)
+	s.mockClient.EXPECT().FetchEventLogs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
+
+	_, err := s.verifier.Deposits(1, 2, 3)
+
+	s.NotNil(err)
+}
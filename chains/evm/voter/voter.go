@@ -7,20 +7,19 @@ import (
 	"context"
 	"fmt"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls"
-	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/consts"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
 	"github.com/ChainSafe/chainbridge-core/config/chain"
 	"math/big"
 	"math/rand"
-	"strings"
 	"time"
 
 	"github.com/ChainSafe/chainbridge-core/chains/evm/voter/proposal"
 	"github.com/ChainSafe/chainbridge-core/relayer/message"
-	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ChainSafe/chainbridge-core/types"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/rs/zerolog/log"
 )
 
@@ -35,9 +34,9 @@ var (
 )
 
 type ChainClient interface {
-	RelayerAddress() common.Address
+	RelayerAddresses() []common.Address
 	CallContract(ctx context.Context, callArgs map[string]interface{}, blockNumber *big.Int) ([]byte, error)
-	SubscribePendingTransactions(ctx context.Context, ch chan<- common.Hash) (*rpc.ClientSubscription, error)
+	SubscribePendingTransactions(ctx context.Context, ch chan<- common.Hash) (ethereum.Subscription, error)
 	TransactionByHash(ctx context.Context, hash common.Hash) (tx *ethereumTypes.Transaction, isPending bool, err error)
 	calls.ContractCallerDispatcher
 }
@@ -47,18 +46,57 @@ type MessageHandler interface {
 }
 
 type BridgeContract interface {
-	IsProposalVotedBy(by common.Address, p *proposal.Proposal) (bool, error)
+	ProposalState(by []common.Address, p *proposal.Proposal) (*bridge.ProposalState, error)
 	VoteProposal(proposal *proposal.Proposal, opts transactor.TransactOptions) (*common.Hash, error)
 	SimulateVoteProposal(proposal *proposal.Proposal) error
 	ProposalStatus(p *proposal.Proposal) (message.ProposalStatus, error)
-	GetThreshold() (uint8, error)
+	GetProposal(source uint8, depositNonce uint64, dataHash common.Hash) (message.ProposalStatus, error)
+	GetRelayers() ([]common.Address, error)
+	GetHandlerAddressForResourceID(resourceID types.ResourceID) (common.Address, error)
+	ContractAddress() *common.Address
+	ABIAdapter() bridge.ABIAdapter
+}
+
+// ProposalRecorder records proposals relayer votes for
+type ProposalRecorder interface {
+	Record(p *proposal.Proposal)
+}
+
+// BatchVoter sends votes for proposals in batches
+type BatchVoter interface {
+	Vote(prop *proposal.Proposal) (*common.Hash, error)
+}
+
+// SentTxStore tracks transactions relayer sent and are not mined yet
+type SentTxStore interface {
+	IsTracked(reference string) (bool, error)
+}
+
+// GasBudget reports if relayer spent its daily gas budget
+type GasBudget interface {
+	Exhausted() bool
+}
+
+// DataHashTracker returns data hashes relayers voted for deposit
+// mapped to transaction hash of a vote for each of them
+type DataHashTracker interface {
+	VotedDataHashes(source uint8, nonce uint64) map[common.Hash]common.Hash
 }
 
 type EVMVoter struct {
 	mh                   MessageHandler
 	client               ChainClient
 	bridgeContract       BridgeContract
-	pendingProposalVotes map[common.Hash]uint8
+	domainID             uint8
+	pendingProposalVotes *PendingVotes
+	verifier             MessageVerifier
+	votedDataHashes      DataHashTracker
+	alerter              Alerter
+	recorders            []ProposalRecorder
+	batcher              BatchVoter
+	sentTxs              []SentTxStore
+	budget               GasBudget
+	relayers             RelayerProvider
 }
 
 // NewVoterWithSubscription creates an instance of EVMVoter that votes for
@@ -68,12 +106,13 @@ type EVMVoter struct {
 // pending voteProposal transactions and avoids wasting gas on sending votes
 // for transactions that will fail.
 // Currently, officially supported only by Geth nodes.
-func NewVoterWithSubscription(mh MessageHandler, client ChainClient, bridgeContract BridgeContract) (*EVMVoter, error) {
+func NewVoterWithSubscription(mh MessageHandler, client ChainClient, bridgeContract BridgeContract, domainID uint8) (*EVMVoter, error) {
 	voter := &EVMVoter{
 		mh:                   mh,
 		client:               client,
 		bridgeContract:       bridgeContract,
-		pendingProposalVotes: make(map[common.Hash]uint8),
+		domainID:             domainID,
+		pendingProposalVotes: NewPendingVotes(pendingVotesTTL),
 	}
 
 	ch := make(chan common.Hash)
@@ -91,16 +130,64 @@ func NewVoterWithSubscription(mh MessageHandler, client ChainClient, bridgeContr
 // It is created without pending proposal subscription and is a fallback
 // for nodes that don't support pending transaction subscription and will vote
 // on proposals that already satisfy threshold.
-func NewVoter(mh MessageHandler, client ChainClient, bridgeContract BridgeContract) *EVMVoter {
+func NewVoter(mh MessageHandler, client ChainClient, bridgeContract BridgeContract, domainID uint8) *EVMVoter {
 	return &EVMVoter{
 		mh:                   mh,
 		client:               client,
 		bridgeContract:       bridgeContract,
-		pendingProposalVotes: make(map[common.Hash]uint8),
+		domainID:             domainID,
+		pendingProposalVotes: NewPendingVotes(pendingVotesTTL),
 	}
 }
 
-// VoteProposal checks if relayer already voted and is threshold
+// SetMessageVerifier makes voter verify messages against the source chain
+// before voting. Messages that fail verification are not voted for and error wrapping
+// ErrDepositNotConfirmed is returned for messages whose deposit is not confirmed yet.
+func (v *EVMVoter) SetMessageVerifier(verifier MessageVerifier) {
+	v.verifier = verifier
+}
+
+// SetDataHashTracker makes voter refuse voting for proposals if other relayers
+// already voted for the same deposit with different proposal data.
+// Mismatches are reported to alerter.
+func (v *EVMVoter) SetDataHashTracker(tracker DataHashTracker, alerter Alerter) {
+	if alerter == nil {
+		alerter = &LogAlerter{}
+	}
+	v.votedDataHashes = tracker
+	v.alerter = alerter
+}
+
+// AddProposalRecorder makes voter record proposals it votes for into recorder
+func (v *EVMVoter) AddProposalRecorder(recorder ProposalRecorder) {
+	v.recorders = append(v.recorders, recorder)
+}
+
+// SetBatchVoter makes voter send votes through batcher instead
+// of sending a transaction for each vote
+func (v *EVMVoter) SetBatchVoter(batcher BatchVoter) {
+	v.batcher = batcher
+}
+
+// AddSentTxStore makes voter skip proposals it already sent a vote
+// transaction for that is not mined yet. Store of each relayer key should be added.
+func (v *EVMVoter) AddSentTxStore(sentTxs SentTxStore) {
+	v.sentTxs = append(v.sentTxs, sentTxs)
+}
+
+// SetRelayerProvider makes voter read bridge relayers for ranked vote coordination
+// from provider instead of reading them from the bridge for every proposal
+func (v *EVMVoter) SetRelayerProvider(relayers RelayerProvider) {
+	v.relayers = relayers
+}
+
+// SetGasBudget makes voter skip votes that are not needed to reach threshold
+// once daily gas budget is exhausted
+func (v *EVMVoter) SetGasBudget(budget GasBudget) {
+	v.budget = budget
+}
+
+// VoteProposal checks if relayer already voted with any of its keys and is threshold
 // satisfied and casts a vote if it isn't.
 func (v *EVMVoter) VoteProposal(m *message.Message, chainConfig *chain.EVMConfig) error {
 	prop, err := v.mh.HandleMessage(m)
@@ -108,15 +195,48 @@ func (v *EVMVoter) VoteProposal(m *message.Message, chainConfig *chain.EVMConfig
 		return err
 	}
 
-	votedByTheRelayer, err := v.bridgeContract.IsProposalVotedBy(v.client.RelayerAddress(), prop)
+	state, err := v.bridgeContract.ProposalState(v.client.RelayerAddresses(), prop)
 	if err != nil {
 		return err
 	}
-	if votedByTheRelayer {
+	if state.HasVoted {
+		v.record(prop)
 		return nil
 	}
+	if state.Status.Status == message.ProposalStatusExecuted || state.Status.Status == message.ProposalStatusCanceled {
+		log.Debug().Uint8("src", prop.Source).Uint64("nonce", prop.DepositNonce).Msg("Proposal already finalized")
+		return nil
+	}
+
+	for _, sentTxs := range v.sentTxs {
+		sent, err := sentTxs.IsTracked(prop.GetID().Hex())
+		if err != nil {
+			return err
+		}
+		if sent {
+			log.Debug().Uint8("src", prop.Source).Uint64("nonce", prop.DepositNonce).Msg("Vote for proposal already sent and not mined yet")
+			return nil
+		}
+	}
 
-	shouldVote, err := v.shouldVoteForProposal(prop, 0)
+	if v.verifier != nil {
+		err = v.verifier.Verify(m)
+		if err != nil {
+			return fmt.Errorf("message verification failed: %w", err)
+		}
+	}
+
+	err = v.checkDataHash(prop)
+	if err != nil {
+		return err
+	}
+
+	var shouldVote bool
+	if chainConfig.VoteCoordination == chain.VoteCoordinationRanked {
+		shouldVote, err = v.shouldVoteRanked(prop, chainConfig.VoteTimeout)
+	} else {
+		shouldVote, err = v.shouldVoteForProposal(prop, 0)
+	}
 	if err != nil {
 		log.Error().Err(err)
 		return err
@@ -126,50 +246,112 @@ func (v *EVMVoter) VoteProposal(m *message.Message, chainConfig *chain.EVMConfig
 		log.Debug().Msgf("Proposal %+v already satisfies threshold", prop)
 		return nil
 	}
+
+	if v.budget != nil && v.budget.Exhausted() {
+		critical, err := v.isCriticalVote(prop)
+		if err != nil {
+			return err
+		}
+		if !critical {
+			log.Warn().Uint8("src", prop.Source).Uint64("nonce", prop.DepositNonce).Msg("Daily gas budget exhausted, skipping vote not needed to reach threshold")
+			return nil
+		}
+	}
 	err = v.repetitiveSimulateVote(prop, 0)
 	if err != nil {
 		log.Error().Err(err)
 		return err
 	}
 
-	transactOptions := transactor.TransactOptions{GasLimit: chainConfig.GasLimit.Uint64()}
-
-	hash, err := v.bridgeContract.VoteProposal(prop, transactOptions)
+	var hash *common.Hash
+	if v.batcher != nil {
+		hash, err = v.batcher.Vote(prop)
+	} else {
+		hash, err = v.bridgeContract.VoteProposal(prop, transactor.TransactOptions{
+			GasLimit:   chainConfig.HandlerGasLimit(prop.HandlerAddress.Hex()),
+			References: []string{prop.GetID().Hex()},
+		})
+	}
 	if err != nil {
 		return fmt.Errorf("voting failed. Err: %w", err)
 	}
 
 	log.Debug().Str("hash", hash.String()).Uint64("nonce", prop.DepositNonce).Msgf("Voted")
+	v.record(prop)
 	return nil
 }
 
+// Revote votes again for proposal of voteProposal calldata of a vote transaction that was dropped
+// or replaced by other transaction. Proposal is not voted for if relayer already voted for it,
+// it was finalized or a vote for it was sent and is not mined yet.
+func (v *EVMVoter) Revote(calldata []byte) error {
+	call, err := v.bridgeContract.ABIAdapter().UnpackVoteProposal(calldata)
+	if err != nil {
+		return err
+	}
+	handlerAddress, err := v.bridgeContract.GetHandlerAddressForResourceID(call.ResourceID)
+	if err != nil {
+		return err
+	}
+	prop := proposal.NewProposal(call.Source, v.domainID, call.DepositNonce, call.ResourceID, call.Data, handlerAddress, *v.bridgeContract.ContractAddress(), common.Hash{}, 0)
+
+	state, err := v.bridgeContract.ProposalState(v.client.RelayerAddresses(), prop)
+	if err != nil {
+		return err
+	}
+	if state.HasVoted || state.Status.Status == message.ProposalStatusExecuted || state.Status.Status == message.ProposalStatusCanceled {
+		log.Debug().Uint8("src", prop.Source).Uint64("nonce", prop.DepositNonce).Msg("Proposal already voted for or finalized, vote not resent")
+		return nil
+	}
+	for _, sentTxs := range v.sentTxs {
+		sent, err := sentTxs.IsTracked(prop.GetID().Hex())
+		if err != nil {
+			return err
+		}
+		if sent {
+			return nil
+		}
+	}
+
+	hash, err := v.bridgeContract.VoteProposal(prop, transactor.TransactOptions{
+		References: []string{prop.GetID().Hex()},
+	})
+	if err != nil {
+		return fmt.Errorf("voting failed. Err: %w", err)
+	}
+	log.Debug().Str("hash", hash.String()).Uint64("nonce", prop.DepositNonce).Msgf("Voted again")
+	v.record(prop)
+	return nil
+}
+
+func (v *EVMVoter) record(prop *proposal.Proposal) {
+	for _, r := range v.recorders {
+		r.Record(prop)
+	}
+}
+
 // shouldVoteForProposal checks if proposal already has threshold with pending
 // proposal votes from other relayers.
 // Only works properly in conjuction with NewVoterWithSubscription as without a subscription
 // no pending txs would be received and pending vote count would be 0.
 func (v *EVMVoter) shouldVoteForProposal(prop *proposal.Proposal, tries int) (bool, error) {
 	propID := prop.GetID()
-	defer delete(v.pendingProposalVotes, propID)
+	defer v.pendingProposalVotes.Remove(propID)
 
 	// random delay to prevent all relayers checking for pending votes
 	// at the same time and all of them sending another tx
 	Sleep(time.Duration(rand.Intn(shouldVoteCheckPeriod)) * time.Second)
 
-	ps, err := v.bridgeContract.ProposalStatus(prop)
+	state, err := v.bridgeContract.ProposalState(v.client.RelayerAddresses(), prop)
 	if err != nil {
 		return false, err
 	}
 
-	if ps.Status == message.ProposalStatusExecuted || ps.Status == message.ProposalStatusCanceled {
+	if state.HasVoted || state.Status.Status == message.ProposalStatusExecuted || state.Status.Status == message.ProposalStatusCanceled {
 		return false, nil
 	}
 
-	threshold, err := v.bridgeContract.GetThreshold()
-	if err != nil {
-		return false, err
-	}
-
-	if ps.YesVotesTotal+v.pendingProposalVotes[propID] >= threshold && tries < maxShouldVoteChecks {
+	if state.Status.YesVotesTotal+v.pendingProposalVotes.Count(propID) >= state.Threshold && tries < maxShouldVoteChecks {
 		// Wait until proposal status is finalized to prevent missing votes
 		// in case of dropped txs
 		tries++
@@ -179,6 +361,15 @@ func (v *EVMVoter) shouldVoteForProposal(prop *proposal.Proposal, tries int) (bo
 	return true, nil
 }
 
+// isCriticalVote checks if relayer vote would make proposal reach threshold
+func (v *EVMVoter) isCriticalVote(prop *proposal.Proposal) (bool, error) {
+	state, err := v.bridgeContract.ProposalState(v.client.RelayerAddresses(), prop)
+	if err != nil {
+		return false, err
+	}
+	return state.Status.YesVotesTotal+1 >= state.Threshold, nil
+}
+
 // repetitiveSimulateVote repeatedly tries(5 times) to simulate vore proposal call until it succeeds
 func (v *EVMVoter) repetitiveSimulateVote(prop *proposal.Proposal, tries int) error {
 	err := v.bridgeContract.SimulateVoteProposal(prop)
@@ -204,49 +395,31 @@ func (v *EVMVoter) trackProposalPendingVotes(ch chan common.Hash) {
 			continue
 		}
 
-		a, err := abi.JSON(strings.NewReader(consts.BridgeABI))
-		if err != nil {
-			log.Error().Err(err)
-			continue
-		}
-
-		if len(txData.Data()) < 4 {
-			continue
-		}
-
-		m, err := a.MethodById(txData.Data()[:4])
+		call, err := v.bridgeContract.ABIAdapter().UnpackVoteProposal(txData.Data())
 		if err != nil {
 			continue
 		}
 
-		data, err := m.Inputs.UnpackValues(txData.Data()[4:])
-		if err != nil {
-			log.Error().Err(err)
-			continue
-		}
-
-		if m.Name == "voteProposal" {
-			source := data[0].(uint8)
-			depositNonce := data[1].(uint64)
-			prop := proposal.Proposal{
-				Source:       source,
-				DepositNonce: depositNonce,
-			}
-
-			go v.increaseProposalVoteCount(msg, prop.GetID())
+		prop := proposal.Proposal{
+			Source:       call.Source,
+			Destination:  v.domainID,
+			DepositNonce: call.DepositNonce,
+			ResourceId:   call.ResourceID,
+			Data:         call.Data,
 		}
+		go v.increaseProposalVoteCount(msg, prop.GetID())
 	}
 }
 
 // increaseProposalVoteCount increases pending proposal vote for target proposal
 // and decreases it when transaction is mined.
 func (v *EVMVoter) increaseProposalVoteCount(hash common.Hash, propID common.Hash) {
-	v.pendingProposalVotes[propID]++
+	v.pendingProposalVotes.Increase(propID)
 
 	_, err := v.client.WaitAndReturnTxReceipt(hash)
 	if err != nil {
 		log.Error().Err(err)
 	}
 
-	v.pendingProposalVotes[propID]--
+	v.pendingProposalVotes.Decrease(propID)
 }
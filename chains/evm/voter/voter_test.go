@@ -2,12 +2,17 @@ package voter_test
 
 import (
 	"errors"
+	"math/big"
 	"testing"
 	"time"
 
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/consts"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/voter"
 	mock_voter "github.com/ChainSafe/chainbridge-core/chains/evm/voter/mock"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/voter/proposal"
+	"github.com/ChainSafe/chainbridge-core/config/chain"
 	"github.com/ChainSafe/chainbridge-core/relayer/message"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/golang/mock/gomock"
@@ -20,6 +25,7 @@ type VoterTestSuite struct {
 	mockMessageHandler *mock_voter.MockMessageHandler
 	mockClient         *mock_voter.MockChainClient
 	mockBridgeContract *mock_voter.MockBridgeContract
+	chainConfig        *chain.EVMConfig
 }
 
 func TestRunVoterTestSuite(t *testing.T) {
@@ -37,15 +43,29 @@ func (s *VoterTestSuite) SetupTest() {
 		s.mockMessageHandler,
 		s.mockClient,
 		s.mockBridgeContract,
+		1,
 	)
+	s.chainConfig = &chain.EVMConfig{GasLimit: big.NewInt(consts.DefaultGasLimit)}
 	voter.Sleep = func(d time.Duration) {}
 }
 func (s *VoterTestSuite) TearDownTest() {}
 
+func (s *VoterTestSuite) expectState(state *bridge.ProposalState, times int) {
+	s.mockClient.EXPECT().RelayerAddresses().Times(times).Return([]common.Address{{}})
+	s.mockBridgeContract.EXPECT().ProposalState(gomock.Any(), gomock.Any()).Times(times).Return(state, nil)
+}
+
+func activeState(yesVotes, threshold uint8) *bridge.ProposalState {
+	return &bridge.ProposalState{
+		Status:    message.ProposalStatus{Status: message.ProposalStatusActive, YesVotesTotal: yesVotes},
+		Threshold: threshold,
+	}
+}
+
 func (s *VoterTestSuite) TestVoteProposal_HandleMessageError() {
 	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(nil, errors.New("error"))
 
-	err := s.voter.VoteProposal(&message.Message{})
+	err := s.voter.VoteProposal(&message.Message{}, s.chainConfig)
 
 	s.NotNil(err)
 }
@@ -55,14 +75,11 @@ func (s *VoterTestSuite) TestVoteProposal_SimulateVoteProposalError() {
 		Source:       0,
 		DepositNonce: 0,
 	}, nil)
-	s.mockClient.EXPECT().RelayerAddress().Return(common.Address{})
 
-	s.mockBridgeContract.EXPECT().IsProposalVotedBy(gomock.Any(), gomock.Any()).Return(false, nil)
-	s.mockBridgeContract.EXPECT().ProposalStatus(gomock.Any()).Return(message.ProposalStatus{Status: message.ProposalStatusActive}, nil)
-	s.mockBridgeContract.EXPECT().GetThreshold().Return(uint8(1), nil)
+	s.expectState(activeState(0, 1), 2)
 	s.mockBridgeContract.EXPECT().SimulateVoteProposal(gomock.Any()).Times(6).Return(errors.New("error"))
 
-	err := s.voter.VoteProposal(&message.Message{})
+	err := s.voter.VoteProposal(&message.Message{}, s.chainConfig)
 
 	s.NotNil(err)
 }
@@ -72,28 +89,25 @@ func (s *VoterTestSuite) TestVoteProposal_SimulateVoteProposal() {
 		Source:       0,
 		DepositNonce: 0,
 	}, nil)
-	s.mockClient.EXPECT().RelayerAddress().Return(common.Address{})
 
-	s.mockBridgeContract.EXPECT().IsProposalVotedBy(gomock.Any(), gomock.Any()).Return(false, nil)
-	s.mockBridgeContract.EXPECT().ProposalStatus(gomock.Any()).Return(message.ProposalStatus{Status: message.ProposalStatusActive}, nil)
-	s.mockBridgeContract.EXPECT().GetThreshold().Return(uint8(1), nil)
+	s.expectState(activeState(0, 1), 2)
 	s.mockBridgeContract.EXPECT().SimulateVoteProposal(gomock.Any()).Times(1).Return(nil)
 	s.mockBridgeContract.EXPECT().VoteProposal(gomock.Any(), gomock.Any()).Return(&common.Hash{}, nil)
 
-	err := s.voter.VoteProposal(&message.Message{})
+	err := s.voter.VoteProposal(&message.Message{}, s.chainConfig)
 
 	s.Nil(err)
 }
 
-func (s *VoterTestSuite) TestVoteProposal_IsProposalVotedByError() {
+func (s *VoterTestSuite) TestVoteProposal_ProposalStateError() {
 	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(&proposal.Proposal{
 		Source:       0,
 		DepositNonce: 0,
 	}, nil)
-	s.mockClient.EXPECT().RelayerAddress().Return(common.Address{})
-	s.mockBridgeContract.EXPECT().IsProposalVotedBy(gomock.Any(), gomock.Any()).Return(false, errors.New("error"))
+	s.mockClient.EXPECT().RelayerAddresses().Return([]common.Address{{}})
+	s.mockBridgeContract.EXPECT().ProposalState(gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
 
-	err := s.voter.VoteProposal(&message.Message{})
+	err := s.voter.VoteProposal(&message.Message{}, s.chainConfig)
 
 	s.NotNil(err)
 }
@@ -103,10 +117,9 @@ func (s *VoterTestSuite) TestVoteProposal_ProposalAlreadyVoted() {
 		Source:       0,
 		DepositNonce: 0,
 	}, nil)
-	s.mockClient.EXPECT().RelayerAddress().Return(common.Address{})
-	s.mockBridgeContract.EXPECT().IsProposalVotedBy(gomock.Any(), gomock.Any()).Return(true, nil)
+	s.expectState(&bridge.ProposalState{HasVoted: true}, 1)
 
-	err := s.voter.VoteProposal(&message.Message{})
+	err := s.voter.VoteProposal(&message.Message{}, s.chainConfig)
 
 	s.Nil(err)
 }
@@ -116,11 +129,13 @@ func (s *VoterTestSuite) TestVoteProposal_ProposalStatusFail() {
 		Source:       0,
 		DepositNonce: 0,
 	}, nil)
-	s.mockClient.EXPECT().RelayerAddress().Return(common.Address{})
-	s.mockBridgeContract.EXPECT().IsProposalVotedBy(gomock.Any(), gomock.Any()).Return(false, nil)
-	s.mockBridgeContract.EXPECT().ProposalStatus(gomock.Any()).Return(message.ProposalStatus{}, errors.New("error"))
+	s.mockClient.EXPECT().RelayerAddresses().Times(2).Return([]common.Address{{}})
+	gomock.InOrder(
+		s.mockBridgeContract.EXPECT().ProposalState(gomock.Any(), gomock.Any()).Return(activeState(0, 1), nil),
+		s.mockBridgeContract.EXPECT().ProposalState(gomock.Any(), gomock.Any()).Return(nil, errors.New("error")),
+	)
 
-	err := s.voter.VoteProposal(&message.Message{})
+	err := s.voter.VoteProposal(&message.Message{}, s.chainConfig)
 
 	s.NotNil(err)
 }
@@ -130,26 +145,200 @@ func (s *VoterTestSuite) TestVoteProposal_ExecutedProposal() {
 		Source:       0,
 		DepositNonce: 0,
 	}, nil)
-	s.mockClient.EXPECT().RelayerAddress().Return(common.Address{})
-	s.mockBridgeContract.EXPECT().IsProposalVotedBy(gomock.Any(), gomock.Any()).Return(false, nil)
-	s.mockBridgeContract.EXPECT().ProposalStatus(gomock.Any()).Return(message.ProposalStatus{Status: message.ProposalStatusExecuted}, nil)
+	s.expectState(&bridge.ProposalState{Status: message.ProposalStatus{Status: message.ProposalStatusExecuted}}, 1)
 
-	err := s.voter.VoteProposal(&message.Message{})
+	err := s.voter.VoteProposal(&message.Message{}, s.chainConfig)
 
 	s.Nil(err)
 }
 
-func (s *VoterTestSuite) TestVoteProposal_GetThresholdFail() {
+func (s *VoterTestSuite) TestVoteProposal_VotedWhileWaiting() {
 	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(&proposal.Proposal{
 		Source:       0,
 		DepositNonce: 0,
 	}, nil)
-	s.mockClient.EXPECT().RelayerAddress().Return(common.Address{})
-	s.mockBridgeContract.EXPECT().IsProposalVotedBy(gomock.Any(), gomock.Any()).Return(false, nil)
-	s.mockBridgeContract.EXPECT().ProposalStatus(gomock.Any()).Return(message.ProposalStatus{Status: message.ProposalStatusActive}, nil)
-	s.mockBridgeContract.EXPECT().GetThreshold().Return(uint8(0), errors.New("error"))
+	s.mockClient.EXPECT().RelayerAddresses().Times(2).Return([]common.Address{{}})
+	gomock.InOrder(
+		s.mockBridgeContract.EXPECT().ProposalState(gomock.Any(), gomock.Any()).Return(activeState(0, 1), nil),
+		s.mockBridgeContract.EXPECT().ProposalState(gomock.Any(), gomock.Any()).Return(&bridge.ProposalState{HasVoted: true}, nil),
+	)
 
-	err := s.voter.VoteProposal(&message.Message{})
+	err := s.voter.VoteProposal(&message.Message{}, s.chainConfig)
+
+	s.Nil(err)
+}
+
+func (s *VoterTestSuite) TestVoteProposal_VerificationFailed() {
+	mockVerifier := mock_voter.NewMockMessageVerifier(gomock.NewController(s.T()))
+	s.voter.SetMessageVerifier(mockVerifier)
+	m := &message.Message{DepositNonce: 1}
+	s.mockMessageHandler.EXPECT().HandleMessage(m).Return(&proposal.Proposal{
+		Source:       0,
+		DepositNonce: 1,
+	}, nil)
+	s.expectState(activeState(0, 1), 1)
+	mockVerifier.EXPECT().Verify(m).Return(errors.New("error"))
+
+	err := s.voter.VoteProposal(m, s.chainConfig)
 
 	s.NotNil(err)
 }
+
+func (s *VoterTestSuite) TestVoteProposal_DepositNotConfirmed_ReturnsError() {
+	mockVerifier := mock_voter.NewMockMessageVerifier(gomock.NewController(s.T()))
+	s.voter.SetMessageVerifier(mockVerifier)
+	m := &message.Message{DepositNonce: 1}
+	s.mockMessageHandler.EXPECT().HandleMessage(m).Return(&proposal.Proposal{
+		Source:       0,
+		DepositNonce: 1,
+	}, nil)
+	s.expectState(activeState(0, 1), 1)
+	mockVerifier.EXPECT().Verify(m).Return(voter.ErrDepositNotConfirmed)
+
+	err := s.voter.VoteProposal(m, s.chainConfig)
+
+	s.ErrorIs(err, voter.ErrDepositNotConfirmed)
+}
+
+func (s *VoterTestSuite) TestVoteProposal_RecordsVotedProposal() {
+	mockRecorder := mock_voter.NewMockProposalRecorder(gomock.NewController(s.T()))
+	s.voter.AddProposalRecorder(mockRecorder)
+	prop := &proposal.Proposal{
+		Source:       0,
+		DepositNonce: 1,
+	}
+	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(prop, nil)
+	s.expectState(activeState(0, 1), 2)
+	s.mockBridgeContract.EXPECT().SimulateVoteProposal(gomock.Any()).Return(nil)
+	s.mockBridgeContract.EXPECT().VoteProposal(gomock.Any(), gomock.Any()).Return(&common.Hash{}, nil)
+	mockRecorder.EXPECT().Record(prop)
+
+	err := s.voter.VoteProposal(&message.Message{}, s.chainConfig)
+
+	s.Nil(err)
+}
+
+func (s *VoterTestSuite) TestVoteProposal_BatchVoter() {
+	mockBatchVoter := mock_voter.NewMockBatchVoter(gomock.NewController(s.T()))
+	s.voter.SetBatchVoter(mockBatchVoter)
+	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(&proposal.Proposal{
+		Source:       0,
+		DepositNonce: 0,
+	}, nil)
+	s.expectState(activeState(0, 1), 2)
+	s.mockBridgeContract.EXPECT().SimulateVoteProposal(gomock.Any()).Return(nil)
+	mockBatchVoter.EXPECT().Vote(gomock.Any()).Return(&common.Hash{}, nil)
+
+	err := s.voter.VoteProposal(&message.Message{}, s.chainConfig)
+
+	s.Nil(err)
+}
+
+func (s *VoterTestSuite) TestVoteProposal_VoteAlreadySent() {
+	mockSentTxStore := mock_voter.NewMockSentTxStore(gomock.NewController(s.T()))
+	s.voter.AddSentTxStore(mockSentTxStore)
+	prop := &proposal.Proposal{
+		Source:       0,
+		DepositNonce: 0,
+	}
+	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(prop, nil)
+	s.expectState(activeState(0, 1), 1)
+	mockSentTxStore.EXPECT().IsTracked(prop.GetID().Hex()).Return(true, nil)
+
+	err := s.voter.VoteProposal(&message.Message{}, s.chainConfig)
+
+	s.Nil(err)
+}
+
+func (s *VoterTestSuite) TestVoteProposal_VoteAlreadySentWithPoolKey() {
+	gomockController := gomock.NewController(s.T())
+	mockSentTxStore := mock_voter.NewMockSentTxStore(gomockController)
+	mockPoolKeySentTxStore := mock_voter.NewMockSentTxStore(gomockController)
+	s.voter.AddSentTxStore(mockSentTxStore)
+	s.voter.AddSentTxStore(mockPoolKeySentTxStore)
+	prop := &proposal.Proposal{
+		Source:       0,
+		DepositNonce: 0,
+	}
+	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(prop, nil)
+	s.expectState(activeState(0, 1), 1)
+	mockSentTxStore.EXPECT().IsTracked(prop.GetID().Hex()).Return(false, nil)
+	mockPoolKeySentTxStore.EXPECT().IsTracked(prop.GetID().Hex()).Return(true, nil)
+
+	err := s.voter.VoteProposal(&message.Message{}, s.chainConfig)
+
+	s.Nil(err)
+}
+
+func (s *VoterTestSuite) TestVoteProposal_GasBudgetExhausted_SkipsNonCriticalVote() {
+	mockGasBudget := mock_voter.NewMockGasBudget(gomock.NewController(s.T()))
+	s.voter.SetGasBudget(mockGasBudget)
+	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(&proposal.Proposal{
+		Source:       0,
+		DepositNonce: 0,
+	}, nil)
+	s.expectState(activeState(1, 3), 3)
+	mockGasBudget.EXPECT().Exhausted().Return(true)
+
+	err := s.voter.VoteProposal(&message.Message{}, s.chainConfig)
+
+	s.Nil(err)
+}
+
+func (s *VoterTestSuite) TestVoteProposal_GasBudgetExhausted_SendsCriticalVote() {
+	mockGasBudget := mock_voter.NewMockGasBudget(gomock.NewController(s.T()))
+	s.voter.SetGasBudget(mockGasBudget)
+	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(&proposal.Proposal{
+		Source:       0,
+		DepositNonce: 0,
+	}, nil)
+	s.expectState(activeState(2, 3), 3)
+	mockGasBudget.EXPECT().Exhausted().Return(true)
+	s.mockBridgeContract.EXPECT().SimulateVoteProposal(gomock.Any()).Return(nil)
+	s.mockBridgeContract.EXPECT().VoteProposal(gomock.Any(), gomock.Any()).Return(&common.Hash{}, nil)
+
+	err := s.voter.VoteProposal(&message.Message{}, s.chainConfig)
+
+	s.Nil(err)
+}
+
+func (s *VoterTestSuite) revoteCalldata(prop *proposal.Proposal) []byte {
+	adapter, err := bridge.GetABIAdapter("v2")
+	s.Nil(err)
+	s.mockBridgeContract.EXPECT().ABIAdapter().Return(adapter)
+	calldata, err := adapter.PackVoteProposal(prop)
+	s.Nil(err)
+	return calldata
+}
+
+func (s *VoterTestSuite) TestRevote_AlreadyVoted() {
+	bridgeAddress := common.HexToAddress("0xb")
+	calldata := s.revoteCalldata(&proposal.Proposal{Source: 2, DepositNonce: 3, Data: []byte{1}})
+	s.mockBridgeContract.EXPECT().GetHandlerAddressForResourceID(gomock.Any()).Return(common.HexToAddress("0xa"), nil)
+	s.mockBridgeContract.EXPECT().ContractAddress().Return(&bridgeAddress)
+	s.expectState(&bridge.ProposalState{HasVoted: true}, 1)
+
+	err := s.voter.Revote(calldata)
+
+	s.Nil(err)
+}
+
+func (s *VoterTestSuite) TestRevote_VotesAgain() {
+	bridgeAddress := common.HexToAddress("0xb")
+	calldata := s.revoteCalldata(&proposal.Proposal{Source: 2, DepositNonce: 3, Data: []byte{1}})
+	s.mockBridgeContract.EXPECT().GetHandlerAddressForResourceID(gomock.Any()).Return(common.HexToAddress("0xa"), nil)
+	s.mockBridgeContract.EXPECT().ContractAddress().Return(&bridgeAddress)
+	s.expectState(activeState(0, 2), 1)
+	s.mockBridgeContract.EXPECT().VoteProposal(gomock.Any(), gomock.Any()).DoAndReturn(func(prop *proposal.Proposal, opts transactor.TransactOptions) (*common.Hash, error) {
+		s.Equal(uint8(2), prop.Source)
+		s.Equal(uint8(1), prop.Destination)
+		s.Equal(uint64(3), prop.DepositNonce)
+		s.Equal(common.HexToAddress("0xa"), prop.HandlerAddress)
+		s.Equal([]string{prop.GetID().Hex()}, opts.References)
+		return &common.Hash{}, nil
+	})
+
+	err := s.voter.Revote(calldata)
+
+	s.Nil(err)
+}
@@ -4,15 +4,17 @@ import (
 	"fmt"
 
 	"github.com/ChainSafe/chainbridge-core/flags"
+	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
 )
 
 type GeneralChainConfig struct {
-	Name           string `mapstructure:"name"`
-	Id             *uint8 `mapstructure:"id"`
-	Endpoint       string `mapstructure:"endpoint"`
-	From           string `mapstructure:"from"`
-	Type           string `mapstructure:"type"`
+	Name           string   `mapstructure:"name"`
+	Id             *uint8   `mapstructure:"id"`
+	Endpoint       string   `mapstructure:"-"`        // Endpoint is the first of configured endpoints
+	Endpoints      []string `mapstructure:"endpoint"` // Endpoints can be configured as a single URL or a list of URLs
+	From           string   `mapstructure:"from"`
+	Type           string   `mapstructure:"type"`
 	KeystorePath   string
 	Insecure       bool
 	BlockstorePath string
@@ -25,9 +27,14 @@ func (c *GeneralChainConfig) Validate() error {
 	if c.Id == nil {
 		return fmt.Errorf("required field domain.Id empty for chain %v", c.Id)
 	}
-	if c.Endpoint == "" {
+	if c.Endpoint == "" && len(c.Endpoints) == 0 {
 		return fmt.Errorf("required field chain.Endpoint empty for chain %v", *c.Id)
 	}
+	for _, endpoint := range c.Endpoints {
+		if endpoint == "" {
+			return fmt.Errorf("empty url in chain.Endpoint for chain %v", *c.Id)
+		}
+	}
 	if c.Name == "" {
 		return fmt.Errorf("required field chain.Name empty for chain %v", *c.Id)
 	}
@@ -48,3 +55,31 @@ func (c *GeneralChainConfig) ParseFlags() {
 	c.FreshStart = viper.GetBool(flags.FreshStartFlagName)
 	c.LatestBlock = viper.GetBool(flags.LatestBlockFlagName)
 }
+
+// EndpointURLs returns all configured endpoints in order of preference
+func (c *GeneralChainConfig) EndpointURLs() []string {
+	if len(c.Endpoints) == 0 {
+		return []string{c.Endpoint}
+	}
+	return c.Endpoints
+}
+
+func (c *GeneralChainConfig) parseEndpoints() {
+	if len(c.Endpoints) > 0 {
+		c.Endpoint = c.Endpoints[0]
+	}
+}
+
+// decodeChainConfig decodes raw chain config into config struct.
+// Endpoint can be configured either as a URL or a list of URLs.
+func decodeChainConfig(chainConfig map[string]interface{}, config interface{}) error {
+	if endpoint, ok := chainConfig["endpoint"].(string); ok {
+		raw := make(map[string]interface{}, len(chainConfig))
+		for k, v := range chainConfig {
+			raw[k] = v
+		}
+		raw["endpoint"] = []string{endpoint}
+		chainConfig = raw
+	}
+	return mapstructure.Decode(chainConfig, config)
+}
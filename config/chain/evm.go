@@ -3,58 +3,325 @@ package chain
 import (
 	"fmt"
 	"math/big"
+	"strings"
 	"time"
 
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/consts"
-	"github.com/mitchellh/mapstructure"
+	"github.com/ethereum/go-ethereum/common"
 )
 
+const (
+	// ExecutorElectionAlways makes relayer execute every passed proposal
+	ExecutorElectionAlways = "always"
+	// ExecutorElectionRelayerIndex makes relayer execute passed proposals with deposit nonce
+	// matching its index and execute other proposals only if they remain unexecuted after executor timeout
+	ExecutorElectionRelayerIndex = "index"
+)
+
+const (
+	// VoteCoordinationPending makes relayers delay votes randomly and skip voting if
+	// pending votes of other relayers satisfy threshold
+	VoteCoordinationPending = "pending"
+	// only first threshold relayers vote immediately and each next relayer votes one vote timeout later
+	// only first threshold relayers vote immediately and others vote after vote timeout
+	VoteCoordinationRanked = "ranked"
+)
+
+const (
+	// GasPricerStatic uses gas price suggested by the node for legacy transactions
+	GasPricerStatic = "static"
+	// GasPricerLondon uses priority fee suggested by the node and twice the base fee for EIP-1559 transactions
+	GasPricerLondon = "london"
+	// GasPricerFeeHistory uses priority fees paid in recent blocks and base fee projected for inclusion horizon for EIP-1559 transactions
+	GasPricerFeeHistory = "feeHistory"
+	// GasPricerOracle uses prices returned by HTTP gas oracle and falls back to static or london gas pricer
+	GasPricerOracle = "oracle"
+)
+
+const (
+	HandlerTypeERC20   = "erc20"
+	HandlerTypeERC721  = "erc721"
+	HandlerTypeGeneric = "generic"
+)
+
+const (
+	defaultExecutorTimeout = 5 * time.Minute
+	defaultVoteTimeout     = 2 * time.Minute
+	defaultVoteBatchSize   = 10
+	defaultVoteBatchWindow = 5 * time.Second
+	defaultTxReplacement   = 3 * time.Minute
+)
+
+// GasOracleConfig describes HTTP gas oracle used by oracle gas pricer
+type GasOracleConfig struct {
+	URL          string
+	GasPricePath string // GasPricePath is the dot separated JSON path of legacy gas price in oracle response
+	TipCapPath   string // TipCapPath is the dot separated JSON path of priority fee, used together with FeeCapPath
+	FeeCapPath   string
+	Unit         string
+	CacheTTL     time.Duration
+	MaxDeviation float64 // MaxDeviation is the factor by which oracle prices can deviate before they are treated as outliers
+	Fallback     string  // Fallback is the gas pricer used when oracle is unavailable or returns outliers
+}
+
+// BridgeConfig describes a single bridge contract deployment on an EVM chain
+type BridgeConfig struct {
+	Address         string
+	Erc20Handlers   []string
+	Erc721Handlers  []string
+	GenericHandlers []string
+	StartBlock      *big.Int
+	Version         string // Version of the bridge contract, detected from deployed code hash if empty
+}
+
 type EVMConfig struct {
 	GeneralChainConfig GeneralChainConfig
-	Bridge             string
-	Erc20Handlers      []string
-	Erc721Handlers     []string
+	Bridge             string // Bridge is the address of the first configured bridge
+	// Erc20Handlers are ERC20 handlers of the first configured bridge.
+	//
+	// Deprecated: use handlers of each bridge from Bridges.
+	Erc20Handlers []string
+	// Erc721Handlers are ERC721 handlers of the first configured bridge.
+	//
+	// Deprecated: use handlers of each bridge from Bridges.
+	Erc721Handlers []string
+	// GenericHandlers are generic handlers of the first configured bridge.
+	//
+	// Deprecated: use handlers of each bridge from Bridges.
 	GenericHandlers    []string
-	MaxGasPrice        *big.Int
-	GasMultiplier      *big.Float
-	GasLimit           *big.Int
+	Bridges            []BridgeConfig
+	GasPricer          string
+	MaxGasPrice        *big.Int   // MaxGasPrice is the upper limit of gas price or fee cap, gas price is not capped if nil
+	GasMultiplier      *big.Float // GasMultiplier multiplies gas price or priority fee suggested by the gas pricer
+	MinGasTip          *big.Int   // MinGasTip is the lowest priority fee or gas price of legacy transactions, not applied if nil
+	FeeHistoryBlocks   uint64     // FeeHistoryBlocks is the number of recent blocks feeHistory gas pricer reads priority fees from
+	RewardPercentile   float64    // RewardPercentile is the percentile of priority fees paid in a block used by feeHistory gas pricer, defined by speed if zero
+	InclusionBlocks    uint64     // InclusionBlocks is the number of blocks feeHistory gas pricer projects base fee increase for, defined by speed if zero
+	GasPriceSpeed      string     // GasPriceSpeed selects reward percentile and inclusion blocks of feeHistory gas pricer
+	GasOracle          GasOracleConfig
+	GasLimit           *big.Int          // GasLimit is the highest gas limit of transactions with estimated gas limit
+	MinGasLimit        uint64            // MinGasLimit is the lowest gas limit of transactions with estimated gas limit
+	GasLimitMultiplier float64           // GasLimitMultiplier is applied to estimated gas
+	HandlerGasLimits   map[string]uint64 // HandlerGasLimits are fixed gas limits of proposals by handler type used instead of estimated gas
 	StartBlock         *big.Int
 	BlockConfirmations *big.Int
 	BlockRetryInterval time.Duration
+	VerifyDeposits     bool // VerifyDeposits enables verification of messages on source chain before voting
+	ExecuteProposals   bool // ExecuteProposals enables execution of passed proposals by the relayer
+	ExecutorElection   string
+	RelayerIndex       uint8
+	RelayerCount       uint8
+	ExecutorTimeout    time.Duration
+	VoteCoordination   string
+	VoteTimeout        time.Duration
+	Multicall          string // Multicall is the address of multicall forwarder used for batched votes, votes are not batched if empty
+	VoteBatchSize      int
+	VoteBatchWindow    time.Duration
+	TxReplacement      time.Duration // TxReplacement is the time after which transaction that is not mined is resent with bumped fees
+	MinBalance         *big.Int      // MinBalance is the relayer balance in wei below which warnings are logged, disabled if nil
+	DailyGasBudget     *big.Int      // DailyGasBudget is the amount of wei relayer spends on fees per day before skipping votes not needed to reach threshold, unlimited if nil
+	RPCTimeout         time.Duration // RPCTimeout is the timeout of a single call to an endpoint
+	RPCRateLimit       float64       // RPCRateLimit is the number of calls per second sent to each endpoint, unlimited if zero
+	RPCRetries         int           // RPCRetries is the number of times calls that failed with transient errors are retried
+	Signer             string        // Signer is the URL of remote signing service holding key of from address, key is loaded from keystore if empty
+	KeyPool            []string      // KeyPool holds addresses of additional relayer keys that transactions are distributed across together with from key, keys have to be bridge relayers
+}
+
+type RawBridgeConfig struct {
+	Address         string   `mapstructure:"address"`
+	Erc20Handlers   []string `mapstructure:"erc20Handlers"`
+	Erc721Handlers  []string `mapstructure:"erc721Handlers"`
+	GenericHandlers []string `mapstructure:"genericHandlers"`
+	StartBlock      int64    `mapstructure:"startBlock"`
+	Version         string   `mapstructure:"version"`
+}
+
+type RawGasOracleConfig struct {
+	URL          string  `mapstructure:"url"`
+	GasPricePath string  `mapstructure:"gasPricePath"`
+	TipCapPath   string  `mapstructure:"tipCapPath"`
+	FeeCapPath   string  `mapstructure:"feeCapPath"`
+	Unit         string  `mapstructure:"unit"`
+	CacheTTL     uint64  `mapstructure:"cacheTTL"`
+	MaxDeviation float64 `mapstructure:"maxDeviation"`
+	Fallback     string  `mapstructure:"fallback"`
+}
+
+func (c *RawGasOracleConfig) Validate() error {
+	if c.URL == "" {
+		return fmt.Errorf("required field gasOracle.url empty")
+	}
+	if c.GasPricePath == "" && (c.TipCapPath == "" || c.FeeCapPath == "") {
+		return fmt.Errorf("either gasOracle.gasPricePath or both gasOracle.tipCapPath and gasOracle.feeCapPath are required")
+	}
+	switch c.Unit {
+	case "", consts.GasOracleUnitWei, consts.GasOracleUnitGwei:
+	default:
+		return fmt.Errorf("unknown gasOracle.unit %s", c.Unit)
+	}
+	switch c.Fallback {
+	case "", GasPricerStatic, GasPricerLondon:
+	default:
+		return fmt.Errorf("gasOracle.fallback has to be %s or %s", GasPricerStatic, GasPricerLondon)
+	}
+	if c.MaxDeviation != 0 && c.MaxDeviation <= 1 {
+		return fmt.Errorf("gasOracle.maxDeviation has to be >1")
+	}
+	return nil
 }
 
 type RawEVMConfig struct {
 	GeneralChainConfig `mapstructure:",squash"`
-	Bridge             string   `mapstructure:"bridge"`
-	Erc20Handlers      []string `mapstructure:"erc20Handlers"`
-	Erc721Handlers     []string `mapstructure:"erc721Handlers"`
-	GenericHandlers    []string `mapstructure:"genericHandlers"`
-	MaxGasPrice        int64    `mapstructure:"maxGasPrice"`
-	GasMultiplier      float64  `mapstructure:"gasMultiplier"`
-	GasLimit           int64    `mapstructure:"gasLimit"`
-	StartBlock         int64    `mapstructure:"startBlock"`
-	BlockConfirmations int64    `mapstructure:"blockConfirmations"`
-	BlockRetryInterval uint64   `mapstructure:"blockRetryInterval"`
+	Bridge             string             `mapstructure:"bridge"`
+	Erc20Handlers      []string           `mapstructure:"erc20Handlers"`
+	Erc721Handlers     []string           `mapstructure:"erc721Handlers"`
+	GenericHandlers    []string           `mapstructure:"genericHandlers"`
+	BridgeVersion      string             `mapstructure:"bridgeVersion"`
+	Bridges            []RawBridgeConfig  `mapstructure:"bridges"`
+	GasPricer          string             `mapstructure:"gasPricer"`
+	MaxGasPrice        int64              `mapstructure:"maxGasPrice"`
+	GasMultiplier      float64            `mapstructure:"gasMultiplier"`
+	MinGasTip          int64              `mapstructure:"minGasTip"`
+	FeeHistoryBlocks   uint64             `mapstructure:"feeHistoryBlocks"`
+	RewardPercentile   float64            `mapstructure:"rewardPercentile"`
+	InclusionBlocks    uint64             `mapstructure:"inclusionBlocks"`
+	GasPriceSpeed      string             `mapstructure:"gasPriceSpeed"`
+	GasOracle          RawGasOracleConfig `mapstructure:"gasOracle"`
+	GasLimit           int64              `mapstructure:"gasLimit"`
+	MinGasLimit        uint64             `mapstructure:"minGasLimit"`
+	GasLimitMultiplier float64            `mapstructure:"gasLimitMultiplier"`
+	HandlerGasLimits   map[string]uint64  `mapstructure:"handlerGasLimits"`
+	StartBlock         int64              `mapstructure:"startBlock"`
+	BlockConfirmations int64              `mapstructure:"blockConfirmations"`
+	BlockRetryInterval uint64             `mapstructure:"blockRetryInterval"`
+	VerifyDeposits     bool               `mapstructure:"verifyDeposits"`
+	ExecuteProposals   bool               `mapstructure:"executeProposals"`
+	ExecutorElection   string             `mapstructure:"executorElection"`
+	RelayerIndex       uint8              `mapstructure:"relayerIndex"`
+	RelayerCount       uint8              `mapstructure:"relayerCount"`
+	ExecutorTimeout    uint64             `mapstructure:"executorTimeout"`
+	VoteCoordination   string             `mapstructure:"voteCoordination"`
+	VoteTimeout        uint64             `mapstructure:"voteTimeout"`
+	Multicall          string             `mapstructure:"multicall"`
+	VoteBatchSize      int                `mapstructure:"voteBatchSize"`
+	VoteBatchWindow    uint64             `mapstructure:"voteBatchWindow"`
+	TxReplacement      uint64             `mapstructure:"txReplacement"`
+	MinBalance         string             `mapstructure:"minBalance"`
+	DailyGasBudget     string             `mapstructure:"dailyGasBudget"`
+	RPCTimeout         uint64             `mapstructure:"rpcTimeout"`
+	RPCRateLimit       float64            `mapstructure:"rpcRateLimit"`
+	RPCRetries         int                `mapstructure:"rpcRetries"`
+	Signer             string             `mapstructure:"signer"`
+	KeyPool            []string           `mapstructure:"keyPool"`
 }
 
 func (c *RawEVMConfig) Validate() error {
 	if err := c.GeneralChainConfig.Validate(); err != nil {
 		return err
 	}
-	if c.Bridge == "" {
+	if c.Bridge == "" && len(c.Bridges) == 0 {
 		return fmt.Errorf("required field chain.Bridge empty for chain %v", *c.Id)
 	}
+	addresses := make(map[string]bool)
+	if c.Bridge != "" {
+		addresses[strings.ToLower(c.Bridge)] = true
+	}
+	for _, b := range c.Bridges {
+		if b.Address == "" {
+			return fmt.Errorf("required field chain.Bridges.Address empty for chain %v", *c.Id)
+		}
+		if addresses[strings.ToLower(b.Address)] {
+			return fmt.Errorf("bridge %s configured more than once for chain %v", b.Address, *c.Id)
+		}
+		addresses[strings.ToLower(b.Address)] = true
+	}
 	if c.BlockConfirmations != 0 && c.BlockConfirmations < 1 {
 		return fmt.Errorf("blockConfirmations has to be >=1")
 	}
+	switch c.ExecutorElection {
+	case "", ExecutorElectionAlways:
+	case ExecutorElectionRelayerIndex:
+		if c.RelayerIndex >= c.RelayerCount {
+			return fmt.Errorf("relayerIndex has to be lower than relayerCount for chain %v", *c.Id)
+		}
+	default:
+		return fmt.Errorf("unknown executorElection %s for chain %v", c.ExecutorElection, *c.Id)
+	}
+	switch c.VoteCoordination {
+	case "", VoteCoordinationPending, VoteCoordinationRanked:
+	default:
+		return fmt.Errorf("unknown voteCoordination %s for chain %v", c.VoteCoordination, *c.Id)
+	}
+	switch c.GasPricer {
+	case "", GasPricerStatic, GasPricerLondon, GasPricerFeeHistory:
+	case GasPricerOracle:
+		if err := c.GasOracle.Validate(); err != nil {
+			return fmt.Errorf("%w for chain %v", err, *c.Id)
+		}
+	default:
+		return fmt.Errorf("unknown gasPricer %s for chain %v", c.GasPricer, *c.Id)
+	}
+	switch c.GasPriceSpeed {
+	case "", consts.GasPriceSpeedSlow, consts.GasPriceSpeedNormal, consts.GasPriceSpeedFast:
+	default:
+		return fmt.Errorf("unknown gasPriceSpeed %s for chain %v", c.GasPriceSpeed, *c.Id)
+	}
+	if c.MinGasTip < 0 {
+		return fmt.Errorf("minGasTip has to be >=0")
+	}
+	if c.RewardPercentile < 0 || c.RewardPercentile > 100 {
+		return fmt.Errorf("rewardPercentile has to be between 0 and 100")
+	}
+	for handlerType := range c.HandlerGasLimits {
+		switch handlerType {
+		case HandlerTypeERC20, HandlerTypeERC721, HandlerTypeGeneric:
+		default:
+			return fmt.Errorf("unknown handler type %s in handlerGasLimits for chain %v", handlerType, *c.Id)
+		}
+	}
+	if _, ok := parseWei(c.MinBalance); !ok {
+		return fmt.Errorf("minBalance has to be a non negative integer amount of wei")
+	}
+	if _, ok := parseWei(c.DailyGasBudget); !ok {
+		return fmt.Errorf("dailyGasBudget has to be a non negative integer amount of wei")
+	}
+	if c.GasLimitMultiplier < 0 {
+		return fmt.Errorf("gasLimitMultiplier has to be >=0")
+	}
+	if c.VoteBatchSize < 0 {
+		return fmt.Errorf("voteBatchSize has to be >=0")
+	}
+	if c.RPCRateLimit < 0 {
+		return fmt.Errorf("rpcRateLimit has to be >=0")
+	}
+	if c.RPCRetries < 0 {
+		return fmt.Errorf("rpcRetries has to be >=0")
+	}
+	if c.Signer != "" && !common.IsHexAddress(c.From) {
+		return fmt.Errorf("from has to be an address of the key held by remote signer for chain %v", *c.Id)
+	}
+	keys := make(map[common.Address]bool)
+	for _, address := range c.KeyPool {
+		if !common.IsHexAddress(address) {
+			return fmt.Errorf("keyPool has to contain only addresses for chain %v", *c.Id)
+		}
+		if common.HexToAddress(address) == common.HexToAddress(c.From) {
+			return fmt.Errorf("keyPool must not contain from address for chain %v", *c.Id)
+		}
+		if keys[common.HexToAddress(address)] {
+			return fmt.Errorf("keyPool must not contain duplicate addresses for chain %v", *c.Id)
+		}
+		keys[common.HexToAddress(address)] = true
+	}
 	return nil
 }
 
 // NewEVMConfig decodes and validates an instance of an EVMConfig from
-// raw chain config
+// raw chain config.
+// Bridge and handlers defined directly in chain config are added as the first bridge definition.
 func NewEVMConfig(chainConfig map[string]interface{}) (*EVMConfig, error) {
 	var c RawEVMConfig
-	err := mapstructure.Decode(chainConfig, &c)
+	err := decodeChainConfig(chainConfig, &c)
 	if err != nil {
 		return nil, err
 	}
@@ -65,24 +332,92 @@ func NewEVMConfig(chainConfig map[string]interface{}) (*EVMConfig, error) {
 	}
 
 	c.GeneralChainConfig.ParseFlags()
+	c.GeneralChainConfig.parseEndpoints()
 	config := &EVMConfig{
 		GeneralChainConfig: c.GeneralChainConfig,
-		Erc20Handlers:      c.Erc20Handlers,
-		Erc721Handlers:     c.Erc721Handlers,
-		GenericHandlers:    c.GenericHandlers,
-		Bridge:             c.Bridge,
 		BlockRetryInterval: consts.DefaultBlockRetryInterval,
 		GasLimit:           big.NewInt(consts.DefaultGasLimit),
-		MaxGasPrice:        big.NewInt(consts.DefaultGasPrice),
+		MinGasLimit:        consts.DefaultMinGasLimit,
+		GasLimitMultiplier: consts.DefaultGasLimitMultiplier,
+		HandlerGasLimits:   c.HandlerGasLimits,
+		GasPricer:          GasPricerLondon,
 		GasMultiplier:      big.NewFloat(consts.DefaultGasMultiplier),
+		FeeHistoryBlocks:   consts.DefaultFeeHistoryBlocks,
+		RewardPercentile:   c.RewardPercentile,
+		InclusionBlocks:    c.InclusionBlocks,
+		GasPriceSpeed:      consts.GasPriceSpeedNormal,
+		GasOracle: GasOracleConfig{
+			URL:          c.GasOracle.URL,
+			GasPricePath: c.GasOracle.GasPricePath,
+			TipCapPath:   c.GasOracle.TipCapPath,
+			FeeCapPath:   c.GasOracle.FeeCapPath,
+			Unit:         c.GasOracle.Unit,
+			CacheTTL:     time.Duration(c.GasOracle.CacheTTL) * time.Second,
+			MaxDeviation: c.GasOracle.MaxDeviation,
+			Fallback:     c.GasOracle.Fallback,
+		},
 		StartBlock:         big.NewInt(c.StartBlock),
 		BlockConfirmations: big.NewInt(consts.DefaultBlockConfirmations),
+		VerifyDeposits:     c.VerifyDeposits,
+		ExecuteProposals:   c.ExecuteProposals,
+		ExecutorElection:   ExecutorElectionAlways,
+		RelayerIndex:       c.RelayerIndex,
+		RelayerCount:       c.RelayerCount,
+		ExecutorTimeout:    defaultExecutorTimeout,
+		VoteCoordination:   VoteCoordinationPending,
+		VoteTimeout:        defaultVoteTimeout,
+		Multicall:          c.Multicall,
+		VoteBatchSize:      defaultVoteBatchSize,
+		VoteBatchWindow:    defaultVoteBatchWindow,
+		TxReplacement:      defaultTxReplacement,
+		RPCTimeout:         consts.DefaultRPCTimeout,
+		RPCRateLimit:       c.RPCRateLimit,
+		RPCRetries:         consts.DefaultRPCRetries,
+		Signer:             c.Signer,
+		KeyPool:            c.KeyPool,
+	}
+
+	if c.Bridge != "" {
+		config.Bridges = append(config.Bridges, BridgeConfig{
+			Address:         c.Bridge,
+			Erc20Handlers:   c.Erc20Handlers,
+			Erc721Handlers:  c.Erc721Handlers,
+			GenericHandlers: c.GenericHandlers,
+			StartBlock:      big.NewInt(c.StartBlock),
+			Version:         c.BridgeVersion,
+		})
 	}
+	for _, b := range c.Bridges {
+		bridgeConfig := BridgeConfig{
+			Address:         b.Address,
+			Erc20Handlers:   b.Erc20Handlers,
+			Erc721Handlers:  b.Erc721Handlers,
+			GenericHandlers: b.GenericHandlers,
+			StartBlock:      big.NewInt(c.StartBlock),
+			Version:         b.Version,
+		}
+		if b.StartBlock != 0 {
+			bridgeConfig.StartBlock = big.NewInt(b.StartBlock)
+		}
+		config.Bridges = append(config.Bridges, bridgeConfig)
+	}
+	config.Bridge = config.Bridges[0].Address
+	config.Erc20Handlers = config.Bridges[0].Erc20Handlers
+	config.Erc721Handlers = config.Bridges[0].Erc721Handlers
+	config.GenericHandlers = config.Bridges[0].GenericHandlers
 
 	if c.GasLimit != 0 {
 		config.GasLimit = big.NewInt(c.GasLimit)
 	}
 
+	if c.MinGasLimit != 0 {
+		config.MinGasLimit = c.MinGasLimit
+	}
+
+	if c.GasLimitMultiplier != 0 {
+		config.GasLimitMultiplier = c.GasLimitMultiplier
+	}
+
 	if c.MaxGasPrice != 0 {
 		config.MaxGasPrice = big.NewInt(c.MaxGasPrice)
 	}
@@ -91,6 +426,22 @@ func NewEVMConfig(chainConfig map[string]interface{}) (*EVMConfig, error) {
 		config.GasMultiplier = big.NewFloat(c.GasMultiplier)
 	}
 
+	if c.GasPricer != "" {
+		config.GasPricer = c.GasPricer
+	}
+
+	if c.MinGasTip != 0 {
+		config.MinGasTip = big.NewInt(c.MinGasTip)
+	}
+
+	if c.FeeHistoryBlocks != 0 {
+		config.FeeHistoryBlocks = c.FeeHistoryBlocks
+	}
+
+	if c.GasPriceSpeed != "" {
+		config.GasPriceSpeed = c.GasPriceSpeed
+	}
+
 	if c.BlockConfirmations != 0 {
 		config.BlockConfirmations = big.NewInt(c.BlockConfirmations)
 	}
@@ -99,5 +450,75 @@ func NewEVMConfig(chainConfig map[string]interface{}) (*EVMConfig, error) {
 		config.BlockRetryInterval = time.Duration(c.BlockRetryInterval) * time.Second
 	}
 
+	if c.ExecutorElection != "" {
+		config.ExecutorElection = c.ExecutorElection
+	}
+
+	if c.ExecutorTimeout != 0 {
+		config.ExecutorTimeout = time.Duration(c.ExecutorTimeout) * time.Second
+	}
+
+	if c.VoteCoordination != "" {
+		config.VoteCoordination = c.VoteCoordination
+	}
+
+	if c.VoteTimeout != 0 {
+		config.VoteTimeout = time.Duration(c.VoteTimeout) * time.Second
+	}
+
+	if c.VoteBatchSize != 0 {
+		config.VoteBatchSize = c.VoteBatchSize
+	}
+
+	if c.VoteBatchWindow != 0 {
+		config.VoteBatchWindow = time.Duration(c.VoteBatchWindow) * time.Second
+	}
+
+	if c.TxReplacement != 0 {
+		config.TxReplacement = time.Duration(c.TxReplacement) * time.Second
+	}
+
+	if c.RPCTimeout != 0 {
+		config.RPCTimeout = time.Duration(c.RPCTimeout) * time.Second
+	}
+
+	if c.RPCRetries != 0 {
+		config.RPCRetries = c.RPCRetries
+	}
+
+	config.MinBalance, _ = parseWei(c.MinBalance)
+	config.DailyGasBudget, _ = parseWei(c.DailyGasBudget)
+
 	return config, nil
 }
+
+// HandlerGasLimit returns fixed gas limit of proposals for handler configured on any of the chain
+// bridges. Returns zero if gas limit of proposals should be estimated.
+func (c *EVMConfig) HandlerGasLimit(handler string) uint64 {
+	for _, b := range c.Bridges {
+		for handlerType, handlers := range map[string][]string{
+			HandlerTypeERC20:   b.Erc20Handlers,
+			HandlerTypeERC721:  b.Erc721Handlers,
+			HandlerTypeGeneric: b.GenericHandlers,
+		} {
+			for _, h := range handlers {
+				if strings.EqualFold(h, handler) {
+					return c.HandlerGasLimits[handlerType]
+				}
+			}
+		}
+	}
+	return 0
+}
+
+// parseWei parses decimal amount of wei, returns nil amount if value is empty
+func parseWei(value string) (*big.Int, bool) {
+	if value == "" {
+		return nil, true
+	}
+	amount, ok := new(big.Int).SetString(value, 10)
+	if !ok || amount.Sign() < 0 {
+		return nil, false
+	}
+	return amount, true
+}
@@ -78,21 +78,38 @@ func (s *NewEVMConfigTestSuite) Test_ValidConfig() {
 	s.Nil(err)
 	s.Equal(*actualConfig, chain.EVMConfig{
 		GeneralChainConfig: chain.GeneralChainConfig{
-			Name:     "evm1",
-			From:     "address",
-			Endpoint: "ws://domain.com",
-			Id:       id,
+			Name:      "evm1",
+			From:      "address",
+			Endpoint:  "ws://domain.com",
+			Endpoints: []string{"ws://domain.com"},
+			Id:        id,
+		},
+		Bridge: "bridgeAddress",
+		Bridges: []chain.BridgeConfig{
+			{
+				Address:    "bridgeAddress",
+				StartBlock: big.NewInt(0),
+			},
 		},
-		Bridge:             "bridgeAddress",
-		Erc20Handler:       "",
-		Erc721Handler:      "",
-		GenericHandler:     "",
 		GasLimit:           big.NewInt(consts.DefaultGasLimit),
-		MaxGasPrice:        big.NewInt(consts.DefaultGasPrice),
+		MinGasLimit:        consts.DefaultMinGasLimit,
+		GasLimitMultiplier: consts.DefaultGasLimitMultiplier,
+		GasPricer:          chain.GasPricerLondon,
 		GasMultiplier:      big.NewFloat(consts.DefaultGasMultiplier),
+		FeeHistoryBlocks:   consts.DefaultFeeHistoryBlocks,
+		GasPriceSpeed:      consts.GasPriceSpeedNormal,
 		StartBlock:         big.NewInt(0),
 		BlockConfirmations: big.NewInt(consts.DefaultBlockConfirmations),
 		BlockRetryInterval: time.Duration(5) * time.Second,
+		ExecutorElection:   chain.ExecutorElectionAlways,
+		ExecutorTimeout:    5 * time.Minute,
+		VoteCoordination:   chain.VoteCoordinationPending,
+		VoteTimeout:        2 * time.Minute,
+		VoteBatchSize:      10,
+		VoteBatchWindow:    5 * time.Second,
+		TxReplacement:      3 * time.Minute,
+		RPCTimeout:         consts.DefaultRPCTimeout,
+		RPCRetries:         consts.DefaultRPCRetries,
 	})
 }
 
@@ -103,12 +120,38 @@ func (s *NewEVMConfigTestSuite) Test_ValidConfigWithCustomTxParams() {
 		"name":               "evm1",
 		"from":               "address",
 		"bridge":             "bridgeAddress",
+		"gasPricer":          "feeHistory",
 		"maxGasPrice":        1000,
 		"gasMultiplier":      1000,
+		"minGasTip":          10,
+		"feeHistoryBlocks":   5,
+		"rewardPercentile":   90,
+		"inclusionBlocks":    3,
+		"gasPriceSpeed":      "fast",
 		"gasLimit":           1000,
+		"minGasLimit":        100,
+		"gasLimitMultiplier": 1.5,
+		"handlerGasLimits":   map[string]interface{}{"generic": 900},
 		"startBlock":         1000,
 		"blockConfirmations": 10,
 		"blockRetryInterval": 10,
+		"verifyDeposits":     true,
+		"executeProposals":   true,
+		"executorElection":   "index",
+		"relayerIndex":       1,
+		"relayerCount":       3,
+		"executorTimeout":    60,
+		"voteCoordination":   "ranked",
+		"voteTimeout":        30,
+		"multicall":          "multicallAddress",
+		"voteBatchSize":      5,
+		"voteBatchWindow":    2,
+		"txReplacement":      60,
+		"minBalance":         "1000000000000000000",
+		"dailyGasBudget":     "50000000000000000000",
+		"rpcTimeout":         10,
+		"rpcRateLimit":       25,
+		"rpcRetries":         5,
 	}
 
 	actualConfig, err := chain.NewEVMConfig(rawConfig)
@@ -118,20 +161,457 @@ func (s *NewEVMConfigTestSuite) Test_ValidConfigWithCustomTxParams() {
 	s.Nil(err)
 	s.Equal(*actualConfig, chain.EVMConfig{
 		GeneralChainConfig: chain.GeneralChainConfig{
-			Name:     "evm1",
-			From:     "address",
-			Endpoint: "ws://domain.com",
-			Id:       id,
+			Name:      "evm1",
+			From:      "address",
+			Endpoint:  "ws://domain.com",
+			Endpoints: []string{"ws://domain.com"},
+			Id:        id,
+		},
+		Bridge: "bridgeAddress",
+		Bridges: []chain.BridgeConfig{
+			{
+				Address:    "bridgeAddress",
+				StartBlock: big.NewInt(1000),
+			},
 		},
-		Bridge:             "bridgeAddress",
-		Erc20Handler:       "",
-		Erc721Handler:      "",
-		GenericHandler:     "",
 		GasLimit:           big.NewInt(1000),
+		MinGasLimit:        100,
+		GasLimitMultiplier: 1.5,
+		HandlerGasLimits:   map[string]uint64{"generic": 900},
+		GasPricer:          chain.GasPricerFeeHistory,
 		MaxGasPrice:        big.NewInt(1000),
 		GasMultiplier:      big.NewFloat(1000),
+		MinGasTip:          big.NewInt(10),
+		FeeHistoryBlocks:   5,
+		RewardPercentile:   90,
+		InclusionBlocks:    3,
+		GasPriceSpeed:      consts.GasPriceSpeedFast,
 		StartBlock:         big.NewInt(1000),
 		BlockConfirmations: big.NewInt(10),
 		BlockRetryInterval: time.Duration(10) * time.Second,
+		VerifyDeposits:     true,
+		ExecuteProposals:   true,
+		ExecutorElection:   chain.ExecutorElectionRelayerIndex,
+		RelayerIndex:       1,
+		RelayerCount:       3,
+		ExecutorTimeout:    time.Duration(60) * time.Second,
+		VoteCoordination:   chain.VoteCoordinationRanked,
+		VoteTimeout:        time.Duration(30) * time.Second,
+		Multicall:          "multicallAddress",
+		VoteBatchSize:      5,
+		VoteBatchWindow:    time.Duration(2) * time.Second,
+		TxReplacement:      time.Duration(60) * time.Second,
+		MinBalance:         big.NewInt(1000000000000000000),
+		DailyGasBudget:     new(big.Int).Mul(big.NewInt(50), big.NewInt(1000000000000000000)),
+		RPCTimeout:         time.Duration(10) * time.Second,
+		RPCRateLimit:       25,
+		RPCRetries:         5,
+	})
+}
+
+func (s *NewEVMConfigTestSuite) Test_InvalidExecutorElection() {
+	_, err := chain.NewEVMConfig(map[string]interface{}{
+		"id":               1,
+		"endpoint":         "ws://domain.com",
+		"name":             "evm1",
+		"from":             "address",
+		"bridge":           "bridgeAddress",
+		"executorElection": "random",
+	})
+
+	s.NotNil(err)
+}
+
+func (s *NewEVMConfigTestSuite) Test_InvalidVoteCoordination() {
+	_, err := chain.NewEVMConfig(map[string]interface{}{
+		"id":               1,
+		"endpoint":         "ws://domain.com",
+		"name":             "evm1",
+		"from":             "address",
+		"bridge":           "bridgeAddress",
+		"voteCoordination": "random",
+	})
+
+	s.NotNil(err)
+}
+
+func (s *NewEVMConfigTestSuite) Test_MultipleEndpoints() {
+	config, err := chain.NewEVMConfig(map[string]interface{}{
+		"id":       1,
+		"endpoint": []interface{}{"ws://domain.com", "https://backup.domain.com"},
+		"name":     "evm1",
+		"from":     "address",
+		"bridge":   "bridgeAddress",
+	})
+
+	s.Nil(err)
+	s.Equal("ws://domain.com", config.GeneralChainConfig.Endpoint)
+	s.Equal([]string{"ws://domain.com", "https://backup.domain.com"}, config.GeneralChainConfig.EndpointURLs())
+}
+
+func (s *NewEVMConfigTestSuite) Test_SingleValueForListField() {
+	_, err := chain.NewEVMConfig(map[string]interface{}{
+		"id":       1,
+		"endpoint": "ws://domain.com",
+		"name":     "evm1",
+		"from":     "address",
+		"bridge":   "bridgeAddress",
+		"keyPool":  "address",
+	})
+
+	s.NotNil(err)
+}
+
+func (s *NewEVMConfigTestSuite) Test_EmptyEndpointInList() {
+	_, err := chain.NewEVMConfig(map[string]interface{}{
+		"id":       1,
+		"endpoint": []interface{}{"ws://domain.com", ""},
+		"name":     "evm1",
+		"from":     "address",
+		"bridge":   "bridgeAddress",
 	})
+
+	s.NotNil(err)
+}
+
+func (s *NewEVMConfigTestSuite) Test_InvalidGasPricer() {
+	_, err := chain.NewEVMConfig(map[string]interface{}{
+		"id":        1,
+		"endpoint":  "ws://domain.com",
+		"name":      "evm1",
+		"from":      "address",
+		"bridge":    "bridgeAddress",
+		"gasPricer": "random",
+	})
+
+	s.NotNil(err)
+}
+
+func (s *NewEVMConfigTestSuite) Test_GasOracle() {
+	config, err := chain.NewEVMConfig(map[string]interface{}{
+		"id":        1,
+		"endpoint":  "ws://domain.com",
+		"name":      "evm1",
+		"from":      "address",
+		"bridge":    "bridgeAddress",
+		"gasPricer": "oracle",
+		"gasOracle": map[string]interface{}{
+			"url":          "http://oracle.com",
+			"tipCapPath":   "fast.maxPriorityFee",
+			"feeCapPath":   "fast.maxFee",
+			"unit":         "wei",
+			"cacheTTL":     30,
+			"maxDeviation": 2,
+			"fallback":     "static",
+		},
+	})
+
+	s.Nil(err)
+	s.Equal(config.GasOracle, chain.GasOracleConfig{
+		URL:          "http://oracle.com",
+		TipCapPath:   "fast.maxPriorityFee",
+		FeeCapPath:   "fast.maxFee",
+		Unit:         "wei",
+		CacheTTL:     30 * time.Second,
+		MaxDeviation: 2,
+		Fallback:     chain.GasPricerStatic,
+	})
+}
+
+func (s *NewEVMConfigTestSuite) Test_InvalidGasOracle() {
+	_, err := chain.NewEVMConfig(map[string]interface{}{
+		"id":        1,
+		"endpoint":  "ws://domain.com",
+		"name":      "evm1",
+		"from":      "address",
+		"bridge":    "bridgeAddress",
+		"gasPricer": "oracle",
+		"gasOracle": map[string]interface{}{
+			"url":        "http://oracle.com",
+			"tipCapPath": "fast.maxPriorityFee",
+		},
+	})
+
+	s.NotNil(err)
+}
+
+func (s *NewEVMConfigTestSuite) Test_InvalidGasPriceSpeed() {
+	_, err := chain.NewEVMConfig(map[string]interface{}{
+		"id":            1,
+		"endpoint":      "ws://domain.com",
+		"name":          "evm1",
+		"from":          "address",
+		"bridge":        "bridgeAddress",
+		"gasPriceSpeed": "instant",
+	})
+
+	s.NotNil(err)
+}
+
+func (s *NewEVMConfigTestSuite) Test_InvalidRewardPercentile() {
+	_, err := chain.NewEVMConfig(map[string]interface{}{
+		"id":               1,
+		"endpoint":         "ws://domain.com",
+		"name":             "evm1",
+		"from":             "address",
+		"bridge":           "bridgeAddress",
+		"rewardPercentile": 101,
+	})
+
+	s.NotNil(err)
+}
+
+func (s *NewEVMConfigTestSuite) Test_InvalidDailyGasBudget() {
+	_, err := chain.NewEVMConfig(map[string]interface{}{
+		"id":             1,
+		"endpoint":       "ws://domain.com",
+		"name":           "evm1",
+		"from":           "address",
+		"bridge":         "bridgeAddress",
+		"dailyGasBudget": "1 ether",
+	})
+
+	s.NotNil(err)
+}
+
+func (s *NewEVMConfigTestSuite) Test_InvalidRPCRateLimit() {
+	_, err := chain.NewEVMConfig(map[string]interface{}{
+		"id":           1,
+		"endpoint":     "ws://domain.com",
+		"name":         "evm1",
+		"from":         "address",
+		"bridge":       "bridgeAddress",
+		"rpcRateLimit": -1,
+	})
+
+	s.NotNil(err)
+	s.Equal(err.Error(), "rpcRateLimit has to be >=0")
+}
+
+func (s *NewEVMConfigTestSuite) Test_RemoteSignerInvalidFrom() {
+	_, err := chain.NewEVMConfig(map[string]interface{}{
+		"id":       1,
+		"endpoint": "ws://domain.com",
+		"name":     "evm1",
+		"from":     "address",
+		"bridge":   "bridgeAddress",
+		"signer":   "http://signer.com",
+	})
+
+	s.NotNil(err)
+	s.Equal(err.Error(), "from has to be an address of the key held by remote signer for chain 1")
+}
+
+func (s *NewEVMConfigTestSuite) Test_RemoteSigner() {
+	actualConfig, err := chain.NewEVMConfig(map[string]interface{}{
+		"id":       1,
+		"endpoint": "ws://domain.com",
+		"name":     "evm1",
+		"from":     "0x0E223343BE5E126d7Cd1F6228F8F86fA04aD80fe",
+		"bridge":   "bridgeAddress",
+		"signer":   "http://signer.com",
+	})
+
+	s.Nil(err)
+	s.Equal("http://signer.com", actualConfig.Signer)
+}
+
+func (s *NewEVMConfigTestSuite) Test_InvalidKeyPoolAddress() {
+	_, err := chain.NewEVMConfig(map[string]interface{}{
+		"id":       1,
+		"endpoint": "ws://domain.com",
+		"name":     "evm1",
+		"from":     "0x0E223343BE5E126d7Cd1F6228F8F86fA04aD80fe",
+		"bridge":   "bridgeAddress",
+		"keyPool":  []string{"address"},
+	})
+
+	s.NotNil(err)
+	s.Equal(err.Error(), "keyPool has to contain only addresses for chain 1")
+}
+
+func (s *NewEVMConfigTestSuite) Test_KeyPoolContainsFrom() {
+	_, err := chain.NewEVMConfig(map[string]interface{}{
+		"id":       1,
+		"endpoint": "ws://domain.com",
+		"name":     "evm1",
+		"from":     "0x0E223343BE5E126d7Cd1F6228F8F86fA04aD80fe",
+		"bridge":   "bridgeAddress",
+		"keyPool":  []string{"0x0e223343be5e126d7cd1f6228f8f86fa04ad80fe"},
+	})
+
+	s.NotNil(err)
+	s.Equal(err.Error(), "keyPool must not contain from address for chain 1")
+}
+
+func (s *NewEVMConfigTestSuite) Test_KeyPoolDuplicateAddresses() {
+	_, err := chain.NewEVMConfig(map[string]interface{}{
+		"id":       1,
+		"endpoint": "ws://domain.com",
+		"name":     "evm1",
+		"from":     "0x0E223343BE5E126d7Cd1F6228F8F86fA04aD80fe",
+		"bridge":   "bridgeAddress",
+		"keyPool":  []string{"0x6e4B0B1B4B6fEa8A4AC4D1C0C3b1f0f45a6cD0B1", "0x6e4b0b1b4b6fea8a4ac4d1c0c3b1f0f45a6cd0b1"},
+	})
+
+	s.NotNil(err)
+	s.Equal(err.Error(), "keyPool must not contain duplicate addresses for chain 1")
+}
+
+func (s *NewEVMConfigTestSuite) Test_KeyPool() {
+	actualConfig, err := chain.NewEVMConfig(map[string]interface{}{
+		"id":       1,
+		"endpoint": "ws://domain.com",
+		"name":     "evm1",
+		"from":     "0x0E223343BE5E126d7Cd1F6228F8F86fA04aD80fe",
+		"bridge":   "bridgeAddress",
+		"keyPool":  []string{"0x6e4B0B1B4B6fEa8A4AC4D1C0C3b1f0f45a6cD0B1"},
+	})
+
+	s.Nil(err)
+	s.Equal([]string{"0x6e4B0B1B4B6fEa8A4AC4D1C0C3b1f0f45a6cD0B1"}, actualConfig.KeyPool)
+}
+
+func (s *NewEVMConfigTestSuite) Test_InvalidVoteBatchSize() {
+	_, err := chain.NewEVMConfig(map[string]interface{}{
+		"id":            1,
+		"endpoint":      "ws://domain.com",
+		"name":          "evm1",
+		"from":          "address",
+		"bridge":        "bridgeAddress",
+		"voteBatchSize": -1,
+	})
+
+	s.NotNil(err)
+}
+
+func (s *NewEVMConfigTestSuite) Test_InvalidHandlerGasLimitType() {
+	_, err := chain.NewEVMConfig(map[string]interface{}{
+		"id":               1,
+		"endpoint":         "ws://domain.com",
+		"name":             "evm1",
+		"from":             "address",
+		"bridge":           "bridgeAddress",
+		"handlerGasLimits": map[string]interface{}{"erc1155": 100},
+	})
+
+	s.NotNil(err)
+}
+
+func (s *NewEVMConfigTestSuite) Test_HandlerGasLimit() {
+	config, err := chain.NewEVMConfig(map[string]interface{}{
+		"id":              1,
+		"endpoint":        "ws://domain.com",
+		"name":            "evm1",
+		"from":            "address",
+		"bridge":          "bridgeAddress",
+		"erc20Handlers":   []string{"0xAbCd"},
+		"genericHandlers": []string{"0x1234"},
+		"bridges": []interface{}{
+			map[string]interface{}{
+				"address":         "secondBridgeAddress",
+				"genericHandlers": []string{"0x5678"},
+			},
+		},
+		"handlerGasLimits": map[string]interface{}{"generic": 900},
+	})
+	s.Nil(err)
+
+	s.Equal(uint64(900), config.HandlerGasLimit("0x1234"))
+	s.Equal(uint64(900), config.HandlerGasLimit("0x5678"))
+	s.Equal(uint64(0), config.HandlerGasLimit("0xabcd"))
+	s.Equal(uint64(0), config.HandlerGasLimit("0x9999"))
+}
+
+func (s *NewEVMConfigTestSuite) Test_InvalidRelayerIndex() {
+	_, err := chain.NewEVMConfig(map[string]interface{}{
+		"id":               1,
+		"endpoint":         "ws://domain.com",
+		"name":             "evm1",
+		"from":             "address",
+		"bridge":           "bridgeAddress",
+		"executorElection": "index",
+		"relayerIndex":     3,
+		"relayerCount":     3,
+	})
+
+	s.NotNil(err)
+}
+
+func (s *NewEVMConfigTestSuite) Test_MissingBridgeAddress() {
+	_, err := chain.NewEVMConfig(map[string]interface{}{
+		"id":       1,
+		"endpoint": "ws://domain.com",
+		"name":     "evm1",
+		"from":     "address",
+		"bridges": []interface{}{
+			map[string]interface{}{
+				"erc20Handlers": []string{"erc20HandlerAddress"},
+			},
+		},
+	})
+
+	s.NotNil(err)
+}
+
+func (s *NewEVMConfigTestSuite) Test_DuplicateBridge() {
+	_, err := chain.NewEVMConfig(map[string]interface{}{
+		"id":       1,
+		"endpoint": "ws://domain.com",
+		"name":     "evm1",
+		"from":     "address",
+		"bridge":   "bridgeAddress",
+		"bridges": []interface{}{
+			map[string]interface{}{
+				"address": "BridgeAddress",
+			},
+		},
+	})
+
+	s.NotNil(err)
+}
+
+func (s *NewEVMConfigTestSuite) Test_ValidConfigWithMultipleBridges() {
+	rawConfig := map[string]interface{}{
+		"id":            1,
+		"endpoint":      "ws://domain.com",
+		"name":          "evm1",
+		"from":          "address",
+		"bridge":        "bridgeAddress",
+		"erc20Handlers": []string{"erc20HandlerAddress"},
+		"startBlock":    1000,
+		"bridgeVersion": "v1",
+		"bridges": []interface{}{
+			map[string]interface{}{
+				"address":         "secondBridgeAddress",
+				"erc721Handlers":  []string{"erc721HandlerAddress"},
+				"genericHandlers": []string{"genericHandlerAddress"},
+				"startBlock":      2000,
+				"version":         "v2",
+			},
+		},
+	}
+
+	actualConfig, err := chain.NewEVMConfig(rawConfig)
+
+	s.Nil(err)
+	s.Equal("bridgeAddress", actualConfig.Bridge)
+	s.Equal([]chain.BridgeConfig{
+		{
+			Address:       "bridgeAddress",
+			Erc20Handlers: []string{"erc20HandlerAddress"},
+			StartBlock:    big.NewInt(1000),
+			Version:       "v1",
+		},
+		{
+			Address:         "secondBridgeAddress",
+			Erc721Handlers:  []string{"erc721HandlerAddress"},
+			GenericHandlers: []string{"genericHandlerAddress"},
+			StartBlock:      big.NewInt(2000),
+			Version:         "v2",
+		},
+	}, actualConfig.Bridges)
+	s.Equal([]string{"erc20HandlerAddress"}, actualConfig.Erc20Handlers)
+	s.Nil(actualConfig.Erc721Handlers)
+	s.Nil(actualConfig.GenericHandlers)
 }
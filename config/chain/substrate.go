@@ -1,9 +1,8 @@
 package chain
 
 import (
+	"fmt"
 	"math/big"
-
-	"github.com/mitchellh/mapstructure"
 )
 
 type SubstrateConfig struct {
@@ -20,7 +19,7 @@ type RawSubstrateConfig struct {
 
 func NewSubstrateConfig(chainCOnfig map[string]interface{}) (*SubstrateConfig, error) {
 	var c RawSubstrateConfig
-	err := mapstructure.Decode(chainCOnfig, &c)
+	err := decodeChainConfig(chainCOnfig, &c)
 	if err != nil {
 		return nil, err
 	}
@@ -29,8 +28,13 @@ func NewSubstrateConfig(chainCOnfig map[string]interface{}) (*SubstrateConfig, e
 	if err != nil {
 		return nil, err
 	}
+	// substrate chains connect to a single endpoint and do not fail over to other endpoints
+	if len(c.Endpoints) > 1 {
+		return nil, fmt.Errorf("multiple endpoints are not supported for substrate chain %v", *c.Id)
+	}
 
 	c.GeneralChainConfig.ParseFlags()
+	c.GeneralChainConfig.parseEndpoints()
 	config := &SubstrateConfig{
 		GeneralChainConfig: c.GeneralChainConfig,
 		StartBlock:         big.NewInt(c.StartBlock),
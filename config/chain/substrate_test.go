@@ -35,6 +35,17 @@ func (s *NewSubstrateConfigTestSuite) Test_FailedGeneralConfigValidation() {
 	s.NotNil(err)
 }
 
+func (s *NewSubstrateConfigTestSuite) Test_MultipleEndpoints() {
+	_, err := chain.NewSubstrateConfig(map[string]interface{}{
+		"id":       1,
+		"endpoint": []interface{}{"ws://domain.com", "ws://backup.domain.com"},
+		"name":     "substrate1",
+		"from":     "address",
+	})
+
+	s.NotNil(err)
+}
+
 func (s *NewSubstrateConfigTestSuite) Test_ValidConfig() {
 	rawConfig := map[string]interface{}{
 		"id":       1,
@@ -51,10 +62,11 @@ func (s *NewSubstrateConfigTestSuite) Test_ValidConfig() {
 	s.Nil(err)
 	s.Equal(*actualConfig, chain.SubstrateConfig{
 		GeneralChainConfig: chain.GeneralChainConfig{
-			Name:     "evm1",
-			From:     "address",
-			Endpoint: "ws://domain.com",
-			Id:       id,
+			Name:      "evm1",
+			From:      "address",
+			Endpoint:  "ws://domain.com",
+			Endpoints: []string{"ws://domain.com"},
+			Id:        id,
 		},
 		StartBlock:      big.NewInt(0),
 		UseExtendedCall: false,
@@ -11,12 +11,13 @@ import (
 	"syscall"
 
 	"github.com/ChainSafe/chainbridge-core/chains/evm"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/voter"
 	"github.com/ChainSafe/chainbridge-core/config"
 	"github.com/ChainSafe/chainbridge-core/flags"
 	"github.com/ChainSafe/chainbridge-core/lvldb"
 	"github.com/ChainSafe/chainbridge-core/opentelemetry"
 	"github.com/ChainSafe/chainbridge-core/relayer"
-	"github.com/ChainSafe/chainbridge-core/store"
+	"github.com/ChainSafe/chainbridge-core/relayer/messageprocessors"
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/viper"
 )
@@ -31,28 +32,42 @@ func Run() error {
 	if err != nil {
 		panic(err)
 	}
-	blockstore := store.NewBlockStore(db)
-
 	chains := []relayer.RelayedChain{}
+	evmChains := []*evm.EVMChain{}
 	for _, chainConfig := range configuration.ChainConfigs {
 		switch chainConfig["type"] {
 		case "evm":
 			{
-				chain, err := evm.SetupDefaultEVMChain(chainConfig, evmtransaction.NewTransaction, blockstore)
+				chain, err := evm.SetupDefaultEVMChain(chainConfig, evmtransaction.NewTransaction, db)
 				if err != nil {
 					panic(err)
 				}
 
 				chains = append(chains, chain)
+				evmChains = append(evmChains, chain)
 			}
 		default:
 			panic(fmt.Errorf("Type '%s' not recognized", chainConfig["type"]))
 		}
 	}
 
+	// deposits are verified after the same processing relayer applies to messages
+	var messageProcessors []messageprocessors.MessageProcessor
+	verifier := voter.NewDepositVerifier(messageProcessors...)
+	for _, c := range evmChains {
+		verifier.RegisterSource(c.DomainID(), c.DepositSource())
+	}
+	telemetry := &opentelemetry.ConsoleTelemetry{}
+	for _, c := range evmChains {
+		c.SetMessageVerifier(verifier)
+		c.SetDepositFetcher(verifier)
+		c.SetMetrics(telemetry)
+	}
+
 	r := relayer.NewRelayer(
 		chains,
-		&opentelemetry.ConsoleTelemetry{},
+		telemetry,
+		messageProcessors...,
 	)
 
 	errChn := make(chan error)
@@ -2,7 +2,11 @@ package opentelemetry
 
 import (
 	"context"
+	"math/big"
+	"sync"
 
+	"github.com/ethereum/go-ethereum/common"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
 	"go.opentelemetry.io/otel/metric"
@@ -15,16 +19,118 @@ import (
 
 type ChainbridgeMetrics struct {
 	DepositEventCount metric.Int64Counter
+	GasSpent          metric.Float64Counter
+	RelayerBalance    metric.Float64GaugeObserver
+	RelayerLowBalance metric.Int64GaugeObserver
+	EndpointRequests  metric.Int64Counter
+	EndpointErrors    metric.Int64Counter
+	EndpointLatency   metric.Float64Histogram
+	EndpointErrorRate metric.Float64GaugeObserver
+
+	balances       map[balanceKey]float64
+	lowBalances    map[balanceKey]bool
+	balancesLock   sync.Mutex
+	errorRates     map[endpointKey]float64
+	errorRatesLock sync.Mutex
+}
+
+type balanceKey struct {
+	domainID uint8
+	account  common.Address
+}
+
+type endpointKey struct {
+	domainID uint8
+	endpoint string
 }
 
 // NewChainbridgeMetrics creates an instance of ChainbridgeMetrics
 // with provided OpenTelemetry meter
 func NewChainbridgeMetrics(meter metric.Meter) *ChainbridgeMetrics {
-	return &ChainbridgeMetrics{
+	m := &ChainbridgeMetrics{
 		DepositEventCount: metric.Must(meter).NewInt64Counter(
 			"chainbridge.DepositEventCount",
 			metric.WithDescription("Number of deposit events across all chains"),
 		),
+		GasSpent: metric.Must(meter).NewFloat64Counter(
+			"chainbridge.GasSpent",
+			metric.WithDescription("Wei spent on transaction fees per domain"),
+		),
+		EndpointRequests: metric.Must(meter).NewInt64Counter(
+			"chainbridge.EndpointRequests",
+			metric.WithDescription("Number of RPC requests per domain, endpoint and method"),
+		),
+		EndpointErrors: metric.Must(meter).NewInt64Counter(
+			"chainbridge.EndpointErrors",
+			metric.WithDescription("Number of failed RPC requests per domain, endpoint and method"),
+		),
+		EndpointLatency: metric.Must(meter).NewFloat64Histogram(
+			"chainbridge.EndpointLatency",
+			metric.WithDescription("Latency of RPC requests in seconds per domain, endpoint and method"),
+		),
+		balances:    make(map[balanceKey]float64),
+		lowBalances: make(map[balanceKey]bool),
+		errorRates:  make(map[endpointKey]float64),
+	}
+	m.RelayerBalance = metric.Must(meter).NewFloat64GaugeObserver(
+		"chainbridge.RelayerBalance",
+		m.observeBalances,
+		metric.WithDescription("Relayer balance in wei per domain and account"),
+	)
+	m.RelayerLowBalance = metric.Must(meter).NewInt64GaugeObserver(
+		"chainbridge.RelayerLowBalance",
+		m.observeLowBalances,
+		metric.WithDescription("1 if relayer balance is below configured threshold per domain and account"),
+	)
+	m.EndpointErrorRate = metric.Must(meter).NewFloat64GaugeObserver(
+		"chainbridge.EndpointErrorRate",
+		m.observeErrorRates,
+		metric.WithDescription("Recent share of failed RPC requests per domain and endpoint"),
+	)
+	return m
+}
+
+// SetEndpointErrorRate stores last known error rate of the endpoint reported by error rate gauge
+func (m *ChainbridgeMetrics) SetEndpointErrorRate(domainID uint8, endpoint string, errorRate float64) {
+	m.errorRatesLock.Lock()
+	defer m.errorRatesLock.Unlock()
+	m.errorRates[endpointKey{domainID: domainID, endpoint: endpoint}] = errorRate
+}
+
+// SetRelayerBalance stores last known balance of the relayer account on the domain reported by balance gauges
+func (m *ChainbridgeMetrics) SetRelayerBalance(domainID uint8, account common.Address, balance *big.Int, low bool) {
+	m.balancesLock.Lock()
+	defer m.balancesLock.Unlock()
+	key := balanceKey{domainID: domainID, account: account}
+	m.balances[key], _ = new(big.Float).SetInt(balance).Float64()
+	m.lowBalances[key] = low
+}
+
+func (m *ChainbridgeMetrics) observeBalances(ctx context.Context, result metric.Float64ObserverResult) {
+	m.balancesLock.Lock()
+	defer m.balancesLock.Unlock()
+	for key, balance := range m.balances {
+		result.Observe(balance, attribute.Int("domainID", int(key.domainID)), attribute.String("account", key.account.Hex()))
+	}
+}
+
+func (m *ChainbridgeMetrics) observeLowBalances(ctx context.Context, result metric.Int64ObserverResult) {
+	m.balancesLock.Lock()
+	defer m.balancesLock.Unlock()
+	for key, low := range m.lowBalances {
+		var value int64
+		if low {
+			value = 1
+		}
+		result.Observe(value, attribute.Int("domainID", int(key.domainID)), attribute.String("account", key.account.Hex()))
+	}
+}
+
+func (m *ChainbridgeMetrics) observeErrorRates(ctx context.Context, result metric.Float64ObserverResult) {
+	m.errorRatesLock.Lock()
+	defer m.errorRatesLock.Unlock()
+	for key, errorRate := range m.errorRates {
+		result.Observe(errorRate, attribute.Int("domainID", int(key.domainID)), attribute.String("endpoint", key.endpoint))
 	}
 }
 
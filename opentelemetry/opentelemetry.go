@@ -2,10 +2,14 @@ package opentelemetry
 
 import (
 	"context"
+	"math/big"
 	"net/url"
+	"time"
 
 	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
 )
 
@@ -44,6 +48,36 @@ func (t *OpenTelemetry) TrackDepositMessage(m *message.Message) {
 	t.metrics.DepositEventCount.Add(context.Background(), 1)
 }
 
+// TrackRelayerBalance updates balance gauges of the relayer account on the domain
+func (t *OpenTelemetry) TrackRelayerBalance(domainID uint8, account common.Address, balance *big.Int, low bool) {
+	t.metrics.SetRelayerBalance(domainID, account, balance, low)
+}
+
+// TrackGasSpent adds fee paid for relayer transaction to gas spent counter of the domain
+func (t *OpenTelemetry) TrackGasSpent(domainID uint8, spent *big.Int) {
+	value, _ := new(big.Float).SetInt(spent).Float64()
+	t.metrics.GasSpent.Add(context.Background(), value, attribute.Int("domainID", int(domainID)))
+}
+
+// TrackEndpointRequest counts RPC requests and failed RPC requests of the endpoint and records their latency
+func (t *OpenTelemetry) TrackEndpointRequest(domainID uint8, endpoint string, method string, latency time.Duration, failed bool) {
+	attributes := []attribute.KeyValue{
+		attribute.Int("domainID", int(domainID)),
+		attribute.String("endpoint", endpoint),
+		attribute.String("method", method),
+	}
+	t.metrics.EndpointRequests.Add(context.Background(), 1, attributes...)
+	t.metrics.EndpointLatency.Record(context.Background(), latency.Seconds(), attributes...)
+	if failed {
+		t.metrics.EndpointErrors.Add(context.Background(), 1, attributes...)
+	}
+}
+
+// TrackEndpointErrorRate updates error rate gauge of the endpoint
+func (t *OpenTelemetry) TrackEndpointErrorRate(domainID uint8, endpoint string, errorRate float64) {
+	t.metrics.SetEndpointErrorRate(domainID, endpoint, errorRate)
+}
+
 // ConsoleTelemetry is telemetry that logs metrics and should be used
 // when metrics sending to OpenTelemetry should be disabled
 type ConsoleTelemetry struct{}
@@ -51,3 +85,18 @@ type ConsoleTelemetry struct{}
 func (t *ConsoleTelemetry) TrackDepositMessage(m *message.Message) {
 	log.Info().Msgf("Deposit message: %v", m.String())
 }
+
+func (t *ConsoleTelemetry) TrackRelayerBalance(domainID uint8, account common.Address, balance *big.Int, low bool) {
+	log.Debug().Msgf("Relayer %s balance on domain %d: %s", account.Hex(), domainID, balance.String())
+}
+
+func (t *ConsoleTelemetry) TrackGasSpent(domainID uint8, spent *big.Int) {
+	log.Debug().Msgf("Gas spent on domain %d: %s", domainID, spent.String())
+}
+
+func (t *ConsoleTelemetry) TrackEndpointRequest(domainID uint8, endpoint string, method string, latency time.Duration, failed bool) {
+}
+
+func (t *ConsoleTelemetry) TrackEndpointErrorRate(domainID uint8, endpoint string, errorRate float64) {
+	log.Debug().Msgf("Error rate of endpoint %s on domain %d: %.2f", endpoint, domainID, errorRate)
+}
@@ -4,10 +4,10 @@
 package store
 
 import (
-	"bytes"
 	"errors"
 	"fmt"
 	"math/big"
+	"strings"
 
 	"github.com/syndtr/goleveldb/leveldb"
 )
@@ -24,33 +24,37 @@ func NewBlockStore(db KeyValueReaderWriter) *BlockStore {
 
 // StoreBlock stores block number per domainID into blockstore
 func (bs *BlockStore) StoreBlock(block *big.Int, domainID uint8) error {
-	key := bytes.Buffer{}
-	keyS := fmt.Sprintf("chain:%d:block", domainID)
-	key.WriteString(keyS)
-
-	err := bs.db.SetByKey(key.Bytes(), block.Bytes())
-	if err != nil {
-		return err
-	}
+	return bs.db.SetByKey(chainBlockKey(domainID), block.Bytes())
+}
 
-	return nil
+// StoreBridgeBlock stores block number per domainID and bridge address into blockstore
+// so that each bridge deployed on the same chain has its own cursor
+func (bs *BlockStore) StoreBridgeBlock(block *big.Int, domainID uint8, bridge string) error {
+	return bs.db.SetByKey(bridgeBlockKey(domainID, bridge), block.Bytes())
 }
 
 // GetLastStoredBlock queries the blockstore and returns latest known block
 func (bs *BlockStore) GetLastStoredBlock(domainID uint8) (*big.Int, error) {
-	key := bytes.Buffer{}
-	keyS := fmt.Sprintf("chain:%d:block", domainID)
-	key.WriteString(keyS)
-
-	v, err := bs.db.GetByKey(key.Bytes())
+	block, err := bs.getBlock(chainBlockKey(domainID))
 	if err != nil {
 		if errors.Is(err, leveldb.ErrNotFound) {
 			return big.NewInt(0), nil
 		}
 		return nil, err
 	}
+	return block, nil
+}
 
-	block := big.NewInt(0).SetBytes(v)
+// GetLastStoredBridgeBlock queries the blockstore and returns latest known block for bridge.
+// If bridge has no stored block, falls back to the block stored per domainID.
+func (bs *BlockStore) GetLastStoredBridgeBlock(domainID uint8, bridge string) (*big.Int, error) {
+	block, err := bs.getBlock(bridgeBlockKey(domainID, bridge))
+	if err != nil {
+		if errors.Is(err, leveldb.ErrNotFound) {
+			return bs.GetLastStoredBlock(domainID)
+		}
+		return nil, err
+	}
 	return block, nil
 }
 
@@ -70,9 +74,49 @@ func (bs *BlockStore) GetStartBlock(domainID uint8, startBlock *big.Int, latest
 		return nil, err
 	}
 
+	return maxBlock(latestBlock, startBlock), nil
+}
+
+// GetBridgeStartBlock works as GetStartBlock, but uses the latest known block of the bridge
+func (bs *BlockStore) GetBridgeStartBlock(domainID uint8, bridge string, startBlock *big.Int, latest bool, fresh bool) (*big.Int, error) {
+	if latest {
+		return nil, nil
+	}
+
+	if fresh {
+		return startBlock, nil
+	}
+
+	latestBlock, err := bs.GetLastStoredBridgeBlock(domainID, bridge)
+	if err != nil {
+		return nil, err
+	}
+
+	return maxBlock(latestBlock, startBlock), nil
+}
+
+func (bs *BlockStore) getBlock(key []byte) (*big.Int, error) {
+	v, err := bs.db.GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	block := big.NewInt(0).SetBytes(v)
+	return block, nil
+}
+
+func maxBlock(latestBlock, startBlock *big.Int) *big.Int {
 	if latestBlock.Cmp(startBlock) == 1 {
-		return latestBlock, nil
+		return latestBlock
 	} else {
-		return startBlock, nil
+		return startBlock
 	}
 }
+
+func chainBlockKey(domainID uint8) []byte {
+	return []byte(fmt.Sprintf("chain:%d:block", domainID))
+}
+
+func bridgeBlockKey(domainID uint8, bridge string) []byte {
+	return []byte(fmt.Sprintf("chain:%d:bridge:%s:block", domainID, strings.ToLower(bridge)))
+}
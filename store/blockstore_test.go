@@ -120,3 +120,49 @@ func (s *BlockStoreTestSuite) TestGetStartBlock_StartBlockLtLastStoredBlock() {
 	s.Nil(err)
 	s.Equal(block, big.NewInt(5))
 }
+
+func (s *BlockStoreTestSuite) TestStoreBridgeBlock_SuccessfulStore() {
+	key := "chain:5:bridge:0xabcd:block"
+	s.keyValueReaderWriter.EXPECT().SetByKey([]byte(key), []byte{1}).Return(nil)
+
+	err := s.blockStore.StoreBridgeBlock(big.NewInt(1), 5, "0xABCD")
+
+	s.Nil(err)
+}
+
+func (s *BlockStoreTestSuite) TestGetLastStoredBridgeBlock_SuccessfulFetch() {
+	key := "chain:5:bridge:0xabcd:block"
+	s.keyValueReaderWriter.EXPECT().GetByKey([]byte(key)).Return([]byte{7}, nil)
+
+	block, err := s.blockStore.GetLastStoredBridgeBlock(5, "0xabcd")
+
+	s.Nil(err)
+	s.Equal(block, big.NewInt(7))
+}
+
+func (s *BlockStoreTestSuite) TestGetLastStoredBridgeBlock_FallbackToChainBlock() {
+	s.keyValueReaderWriter.EXPECT().GetByKey([]byte("chain:5:bridge:0xabcd:block")).Return(nil, leveldb.ErrNotFound)
+	s.keyValueReaderWriter.EXPECT().GetByKey([]byte("chain:5:block")).Return([]byte{5}, nil)
+
+	block, err := s.blockStore.GetLastStoredBridgeBlock(5, "0xabcd")
+
+	s.Nil(err)
+	s.Equal(block, big.NewInt(5))
+}
+
+func (s *BlockStoreTestSuite) TestGetLastStoredBridgeBlock_FailedFetch() {
+	s.keyValueReaderWriter.EXPECT().GetByKey([]byte("chain:5:bridge:0xabcd:block")).Return(nil, errors.New("error"))
+
+	_, err := s.blockStore.GetLastStoredBridgeBlock(5, "0xabcd")
+
+	s.NotNil(err)
+}
+
+func (s *BlockStoreTestSuite) TestGetBridgeStartBlock_StartBlockLtLastStoredBlock() {
+	s.keyValueReaderWriter.EXPECT().GetByKey([]byte("chain:5:bridge:0xabcd:block")).Return([]byte{5}, nil)
+
+	block, err := s.blockStore.GetBridgeStartBlock(5, "0xabcd", big.NewInt(2), false, false)
+
+	s.Nil(err)
+	s.Equal(block, big.NewInt(5))
+}
@@ -0,0 +1,67 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package store
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+// GasSpendStore persists native currency spent on transaction fees per day on a single domain
+type GasSpendStore struct {
+	db       KeyValueReaderWriter
+	domainID uint8
+	lock     sync.Mutex
+}
+
+func NewGasSpendStore(db KeyValueReaderWriter, domainID uint8) *GasSpendStore {
+	return &GasSpendStore{
+		db:       db,
+		domainID: domainID,
+	}
+}
+
+// AddSpent adds amount to fees spent on the day and returns total spent on the day
+func (s *GasSpendStore) AddSpent(day string, amount *big.Int) (*big.Int, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	spent, err := s.spent(day)
+	if err != nil {
+		return nil, err
+	}
+	spent.Add(spent, amount)
+	err = s.db.SetByKey(s.spentKey(day), spent.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	return spent, nil
+}
+
+// Spent returns fees spent on the day
+func (s *GasSpendStore) Spent(day string) (*big.Int, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.spent(day)
+}
+
+func (s *GasSpendStore) spent(day string) (*big.Int, error) {
+	v, err := s.db.GetByKey(s.spentKey(day))
+	if err != nil {
+		if errors.Is(err, leveldb.ErrNotFound) {
+			return big.NewInt(0), nil
+		}
+		return nil, err
+	}
+	return new(big.Int).SetBytes(v), nil
+}
+
+func (s *GasSpendStore) spentKey(day string) []byte {
+	return []byte(fmt.Sprintf("chain:%d:gas:spent:%s", s.domainID, day))
+}
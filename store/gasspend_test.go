@@ -0,0 +1,54 @@
+package store_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/lvldb"
+	"github.com/ChainSafe/chainbridge-core/store"
+	"github.com/stretchr/testify/suite"
+)
+
+type GasSpendStoreTestSuite struct {
+	suite.Suite
+	db            *lvldb.LVLDB
+	gasSpendStore *store.GasSpendStore
+}
+
+func TestRunGasSpendStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(GasSpendStoreTestSuite))
+}
+
+func (s *GasSpendStoreTestSuite) SetupTest() {
+	db, err := lvldb.NewLvlDB(s.T().TempDir())
+	s.Nil(err)
+	s.db = db
+	s.gasSpendStore = store.NewGasSpendStore(db, 1)
+}
+func (s *GasSpendStoreTestSuite) TearDownTest() {
+	s.db.Close()
+}
+
+func (s *GasSpendStoreTestSuite) TestSpent_NothingSpent() {
+	spent, err := s.gasSpendStore.Spent("2021-12-01")
+
+	s.Nil(err)
+	s.Equal(big.NewInt(0), spent)
+}
+
+func (s *GasSpendStoreTestSuite) TestAddSpent_SumsSpentPerDay() {
+	_, err := s.gasSpendStore.AddSpent("2021-12-01", big.NewInt(100))
+	s.Nil(err)
+	total, err := s.gasSpendStore.AddSpent("2021-12-01", big.NewInt(50))
+	s.Nil(err)
+	_, err = s.gasSpendStore.AddSpent("2021-12-02", big.NewInt(10))
+	s.Nil(err)
+
+	spent, err := s.gasSpendStore.Spent("2021-12-01")
+	s.Nil(err)
+	s.Equal(big.NewInt(150), total)
+	s.Equal(big.NewInt(150), spent)
+	otherDomainSpent, err := store.NewGasSpendStore(s.db, 2).Spent("2021-12-01")
+	s.Nil(err)
+	s.Equal(0, otherDomainSpent.Sign())
+}
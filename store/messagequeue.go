@@ -0,0 +1,150 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package store
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+func init() {
+	// message payload is stored as []interface{} so concrete types have to be registered
+	gob.Register([]byte{})
+}
+
+// MessageQueue is a persistent FIFO queue of messages per domainID and bridge address
+// used to park messages that can not be written to destination at the moment
+type MessageQueue struct {
+	db   KeyValueReaderWriter
+	lock sync.Mutex
+}
+
+func NewMessageQueue(db KeyValueReaderWriter) *MessageQueue {
+	return &MessageQueue{
+		db: db,
+	}
+}
+
+// Push appends message to the end of the queue for domainID and bridge
+func (q *MessageQueue) Push(domainID uint8, bridge string, m *message.Message) error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	tail, err := q.getIndex(domainID, bridge, "tail")
+	if err != nil {
+		return err
+	}
+
+	var value bytes.Buffer
+	err = gob.NewEncoder(&value).Encode(m)
+	if err != nil {
+		return err
+	}
+
+	err = q.db.SetByKey(messageKey(domainID, bridge, tail), value.Bytes())
+	if err != nil {
+		return err
+	}
+
+	return q.db.SetByKey(indexKey(domainID, bridge, "tail"), tail.Add(tail, big.NewInt(1)).Bytes())
+}
+
+// Peek returns first message in the queue for domainID and bridge without removing it.
+// Returns nil if queue is empty.
+func (q *MessageQueue) Peek(domainID uint8, bridge string) (*message.Message, error) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	head, tail, err := q.bounds(domainID, bridge)
+	if err != nil {
+		return nil, err
+	}
+	if head.Cmp(tail) >= 0 {
+		return nil, nil
+	}
+
+	v, err := q.db.GetByKey(messageKey(domainID, bridge, head))
+	if err != nil {
+		return nil, err
+	}
+
+	var m message.Message
+	err = gob.NewDecoder(bytes.NewReader(v)).Decode(&m)
+	if err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// Remove removes first message from the queue for domainID and bridge
+func (q *MessageQueue) Remove(domainID uint8, bridge string) error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	head, tail, err := q.bounds(domainID, bridge)
+	if err != nil {
+		return err
+	}
+	if head.Cmp(tail) >= 0 {
+		return errors.New("message queue is empty")
+	}
+
+	err = q.db.DeleteByKey(messageKey(domainID, bridge, head))
+	if err != nil {
+		return err
+	}
+
+	return q.db.SetByKey(indexKey(domainID, bridge, "head"), head.Add(head, big.NewInt(1)).Bytes())
+}
+
+// Len returns number of messages in the queue for domainID and bridge
+func (q *MessageQueue) Len(domainID uint8, bridge string) (uint64, error) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	head, tail, err := q.bounds(domainID, bridge)
+	if err != nil {
+		return 0, err
+	}
+	return big.NewInt(0).Sub(tail, head).Uint64(), nil
+}
+
+func (q *MessageQueue) bounds(domainID uint8, bridge string) (*big.Int, *big.Int, error) {
+	head, err := q.getIndex(domainID, bridge, "head")
+	if err != nil {
+		return nil, nil, err
+	}
+	tail, err := q.getIndex(domainID, bridge, "tail")
+	if err != nil {
+		return nil, nil, err
+	}
+	return head, tail, nil
+}
+
+func (q *MessageQueue) getIndex(domainID uint8, bridge string, name string) (*big.Int, error) {
+	v, err := q.db.GetByKey(indexKey(domainID, bridge, name))
+	if err != nil {
+		if errors.Is(err, leveldb.ErrNotFound) {
+			return big.NewInt(0), nil
+		}
+		return nil, err
+	}
+	return big.NewInt(0).SetBytes(v), nil
+}
+
+func indexKey(domainID uint8, bridge string, name string) []byte {
+	return []byte(fmt.Sprintf("chain:%d:bridge:%s:queue:%s", domainID, strings.ToLower(bridge), name))
+}
+
+func messageKey(domainID uint8, bridge string, index *big.Int) []byte {
+	return []byte(fmt.Sprintf("chain:%d:bridge:%s:queue:message:%s", domainID, strings.ToLower(bridge), index.String()))
+}
@@ -0,0 +1,120 @@
+package store_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/lvldb"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/store"
+	mock_store "github.com/ChainSafe/chainbridge-core/store/mock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+const testBridge = "0xABCD"
+
+type MessageQueueTestSuite struct {
+	suite.Suite
+	db           *lvldb.LVLDB
+	messageQueue *store.MessageQueue
+}
+
+func TestRunMessageQueueTestSuite(t *testing.T) {
+	suite.Run(t, new(MessageQueueTestSuite))
+}
+
+func (s *MessageQueueTestSuite) SetupSuite()    {}
+func (s *MessageQueueTestSuite) TearDownSuite() {}
+func (s *MessageQueueTestSuite) SetupTest() {
+	db, err := lvldb.NewLvlDB(s.T().TempDir())
+	s.Nil(err)
+	s.db = db
+	s.messageQueue = store.NewMessageQueue(db)
+}
+func (s *MessageQueueTestSuite) TearDownTest() {
+	s.db.Close()
+}
+
+func (s *MessageQueueTestSuite) TestPeek_EmptyQueue() {
+	m, err := s.messageQueue.Peek(1, testBridge)
+
+	s.Nil(err)
+	s.Nil(m)
+}
+
+func (s *MessageQueueTestSuite) TestRemove_EmptyQueue() {
+	err := s.messageQueue.Remove(1, testBridge)
+
+	s.NotNil(err)
+}
+
+func (s *MessageQueueTestSuite) TestPushPeekRemove_KeepsOrderAndPayload() {
+	first := &message.Message{
+		DepositTxHash: common.HexToHash("0x1"),
+		Source:        2,
+		Destination:   1,
+		DepositNonce:  1,
+		ResourceId:    [32]byte{1},
+		Payload:       []interface{}{[]byte{1}, []byte{2, 3}},
+		Type:          message.FungibleTransfer,
+	}
+	second := &message.Message{
+		Source:       2,
+		Destination:  1,
+		DepositNonce: 2,
+		Payload:      []interface{}{[]byte{4}},
+		Type:         message.GenericTransfer,
+	}
+
+	s.Nil(s.messageQueue.Push(1, testBridge, first))
+	s.Nil(s.messageQueue.Push(1, testBridge, second))
+	length, err := s.messageQueue.Len(1, testBridge)
+	s.Nil(err)
+	s.Equal(uint64(2), length)
+
+	m, err := s.messageQueue.Peek(1, testBridge)
+	s.Nil(err)
+	s.Equal(first, m)
+
+	s.Nil(s.messageQueue.Remove(1, testBridge))
+	m, err = s.messageQueue.Peek(1, testBridge)
+	s.Nil(err)
+	s.Equal(second, m)
+
+	s.Nil(s.messageQueue.Remove(1, testBridge))
+	length, err = s.messageQueue.Len(1, testBridge)
+	s.Nil(err)
+	s.Equal(uint64(0), length)
+}
+
+func (s *MessageQueueTestSuite) TestPush_QueuesAreSeparatedByDomain() {
+	s.Nil(s.messageQueue.Push(1, testBridge, &message.Message{DepositNonce: 1}))
+
+	m, err := s.messageQueue.Peek(2, testBridge)
+
+	s.Nil(err)
+	s.Nil(m)
+}
+
+func (s *MessageQueueTestSuite) TestPush_QueuesAreSeparatedByBridge() {
+	s.Nil(s.messageQueue.Push(1, testBridge, &message.Message{DepositNonce: 1}))
+
+	m, err := s.messageQueue.Peek(1, "0x1234")
+
+	s.Nil(err)
+	s.Nil(m)
+}
+
+func (s *MessageQueueTestSuite) TestPush_FailedStore() {
+	gomockController := gomock.NewController(s.T())
+	keyValueReaderWriter := mock_store.NewMockKeyValueReaderWriter(gomockController)
+	keyValueReaderWriter.EXPECT().GetByKey([]byte("chain:1:bridge:0xabcd:queue:tail")).Return([]byte{}, nil)
+	keyValueReaderWriter.EXPECT().SetByKey([]byte("chain:1:bridge:0xabcd:queue:message:0"), gomock.Any()).Return(errors.New("error"))
+	messageQueue := store.NewMessageQueue(keyValueReaderWriter)
+
+	err := messageQueue.Push(1, testBridge, &message.Message{})
+
+	s.NotNil(err)
+}
@@ -0,0 +1,266 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package store
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+// SentTx is a transaction sent by the relayer that is not confirmed yet
+type SentTx struct {
+	Nonce      uint64
+	Hashes     []common.Hash // Hashes of the transaction and its replacements, the last one was sent latest
+	To         common.Address
+	Data       []byte
+	References []string // References identify what transaction was sent for, for example proposals it votes for
+}
+
+// TxStore persists transactions sent by the relayer on a single domain
+// until they are mined so they can be reconciled after restart
+type TxStore struct {
+	db     KeyValueReaderWriter
+	prefix string
+	sent   map[uint64]bool // sent holds nonces of transactions tracked by this relayer run
+	lock   sync.Mutex
+}
+
+func NewTxStore(db KeyValueReaderWriter, domainID uint8) *TxStore {
+	return &TxStore{
+		db:     db,
+		prefix: fmt.Sprintf("chain:%d:tx", domainID),
+		sent:   make(map[uint64]bool),
+	}
+}
+
+// NewAccountTxStore creates TxStore of transactions sent by account on the domain.
+// Used for keys of relayer key pool as each of them has its own nonce sequence.
+func NewAccountTxStore(db KeyValueReaderWriter, domainID uint8, account common.Address) *TxStore {
+	return &TxStore{
+		db:     db,
+		prefix: fmt.Sprintf("chain:%d:account:%s:tx", domainID, account.Hex()),
+		sent:   make(map[uint64]bool),
+	}
+}
+
+// Track stores sent transaction by its nonce. Transaction previously tracked
+// with the same nonce is overwritten together with its references.
+func (s *TxStore) Track(tx *SentTx) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	nonces, err := s.index()
+	if err != nil {
+		return err
+	}
+	if _, ok := nonces[tx.Nonce]; ok {
+		old, err := s.getTx(tx.Nonce)
+		if err != nil && !errors.Is(err, leveldb.ErrNotFound) {
+			return err
+		}
+		if old != nil {
+			for _, ref := range old.References {
+				err = s.db.DeleteByKey(s.referenceKey(ref))
+				if err != nil {
+					return err
+				}
+			}
+		}
+	} else {
+		nonces[tx.Nonce] = true
+		err = s.setIndex(nonces)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, ref := range tx.References {
+		err = s.db.SetByKey(s.referenceKey(ref), big.NewInt(0).SetUint64(tx.Nonce).Bytes())
+		if err != nil {
+			return err
+		}
+	}
+	s.sent[tx.Nonce] = true
+	return s.setTx(tx)
+}
+
+// Replace records hash of transaction that replaced tracked transaction with nonce
+func (s *TxStore) Replace(nonce uint64, hash common.Hash) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	tx, err := s.getTx(nonce)
+	if err != nil {
+		return err
+	}
+	tx.Hashes = append(tx.Hashes, hash)
+	return s.setTx(tx)
+}
+
+// Remove stops tracking transaction with nonce
+func (s *TxStore) Remove(nonce uint64) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.sent, nonce)
+	return s.remove(nonce)
+}
+
+// RemoveRestored stops tracking transaction with nonce sent by previous relayer run.
+// Transaction is kept if the nonce was used by this run meanwhile.
+func (s *TxStore) RemoveRestored(nonce uint64) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.sent[nonce] {
+		return nil
+	}
+	return s.remove(nonce)
+}
+
+func (s *TxStore) remove(nonce uint64) error {
+	tx, err := s.getTx(nonce)
+	if err != nil {
+		if errors.Is(err, leveldb.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	for _, ref := range tx.References {
+		err = s.db.DeleteByKey(s.referenceKey(ref))
+		if err != nil {
+			return err
+		}
+	}
+	err = s.db.DeleteByKey(s.txKey(nonce))
+	if err != nil {
+		return err
+	}
+
+	nonces, err := s.index()
+	if err != nil {
+		return err
+	}
+	delete(nonces, nonce)
+	return s.setIndex(nonces)
+}
+
+// Pending returns all tracked transactions ordered by nonce
+func (s *TxStore) Pending() ([]*SentTx, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.pending(false)
+}
+
+// Restored returns tracked transactions sent by previous relayer runs ordered by nonce.
+// Transactions sent by this run are awaited by transactor that sent them and are not returned.
+func (s *TxStore) Restored() ([]*SentTx, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.pending(true)
+}
+
+func (s *TxStore) pending(restoredOnly bool) ([]*SentTx, error) {
+	nonces, err := s.index()
+	if err != nil {
+		return nil, err
+	}
+	txs := make([]*SentTx, 0, len(nonces))
+	for nonce := range nonces {
+		if restoredOnly && s.sent[nonce] {
+			continue
+		}
+		tx, err := s.getTx(nonce)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, tx)
+	}
+	sort.Slice(txs, func(i, j int) bool { return txs[i].Nonce < txs[j].Nonce })
+	return txs, nil
+}
+
+// IsTracked returns true if a tracked transaction was sent for reference
+func (s *TxStore) IsTracked(reference string) (bool, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	_, err := s.db.GetByKey(s.referenceKey(reference))
+	if err != nil {
+		if errors.Is(err, leveldb.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *TxStore) getTx(nonce uint64) (*SentTx, error) {
+	v, err := s.db.GetByKey(s.txKey(nonce))
+	if err != nil {
+		return nil, err
+	}
+	var tx SentTx
+	err = gob.NewDecoder(bytes.NewReader(v)).Decode(&tx)
+	if err != nil {
+		return nil, err
+	}
+	return &tx, nil
+}
+
+func (s *TxStore) setTx(tx *SentTx) error {
+	var value bytes.Buffer
+	err := gob.NewEncoder(&value).Encode(tx)
+	if err != nil {
+		return err
+	}
+	return s.db.SetByKey(s.txKey(tx.Nonce), value.Bytes())
+}
+
+func (s *TxStore) index() (map[uint64]bool, error) {
+	nonces := make(map[uint64]bool)
+	v, err := s.db.GetByKey(s.indexKey())
+	if err != nil {
+		if errors.Is(err, leveldb.ErrNotFound) {
+			return nonces, nil
+		}
+		return nil, err
+	}
+	err = gob.NewDecoder(bytes.NewReader(v)).Decode(&nonces)
+	if err != nil {
+		return nil, err
+	}
+	return nonces, nil
+}
+
+func (s *TxStore) setIndex(nonces map[uint64]bool) error {
+	var value bytes.Buffer
+	err := gob.NewEncoder(&value).Encode(nonces)
+	if err != nil {
+		return err
+	}
+	return s.db.SetByKey(s.indexKey(), value.Bytes())
+}
+
+func (s *TxStore) indexKey() []byte {
+	return []byte(fmt.Sprintf("%s:index", s.prefix))
+}
+
+func (s *TxStore) txKey(nonce uint64) []byte {
+	return []byte(fmt.Sprintf("%s:nonce:%d", s.prefix, nonce))
+}
+
+func (s *TxStore) referenceKey(reference string) []byte {
+	return []byte(fmt.Sprintf("%s:reference:%s", s.prefix, reference))
+}
@@ -0,0 +1,163 @@
+package store_test
+
+import (
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/lvldb"
+	"github.com/ChainSafe/chainbridge-core/store"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type TxStoreTestSuite struct {
+	suite.Suite
+	db      *lvldb.LVLDB
+	txStore *store.TxStore
+}
+
+func TestRunTxStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(TxStoreTestSuite))
+}
+
+func (s *TxStoreTestSuite) SetupTest() {
+	db, err := lvldb.NewLvlDB(s.T().TempDir())
+	s.Nil(err)
+	s.db = db
+	s.txStore = store.NewTxStore(db, 1)
+}
+func (s *TxStoreTestSuite) TearDownTest() {
+	s.db.Close()
+}
+
+func (s *TxStoreTestSuite) TestPending_EmptyStore() {
+	txs, err := s.txStore.Pending()
+
+	s.Nil(err)
+	s.Len(txs, 0)
+}
+
+func (s *TxStoreTestSuite) TestTrackAndReplace_OrderedByNonce() {
+	second := &store.SentTx{
+		Nonce:      6,
+		Hashes:     []common.Hash{common.HexToHash("0x2")},
+		To:         common.HexToAddress("0xabcd"),
+		Data:       []byte{1, 2},
+		References: []string{"ref2", "ref3"},
+	}
+	first := &store.SentTx{
+		Nonce:      5,
+		Hashes:     []common.Hash{common.HexToHash("0x1")},
+		References: []string{"ref1"},
+	}
+	s.Nil(s.txStore.Track(second))
+	s.Nil(s.txStore.Track(first))
+	s.Nil(s.txStore.Replace(5, common.HexToHash("0x11")))
+
+	txs, err := s.txStore.Pending()
+
+	s.Nil(err)
+	s.Len(txs, 2)
+	s.Equal(uint64(5), txs[0].Nonce)
+	s.Equal([]common.Hash{common.HexToHash("0x1"), common.HexToHash("0x11")}, txs[0].Hashes)
+	s.Equal(second, txs[1])
+}
+
+func (s *TxStoreTestSuite) TestIsTracked() {
+	s.Nil(s.txStore.Track(&store.SentTx{Nonce: 5, References: []string{"ref1", "ref2"}}))
+
+	tracked, err := s.txStore.IsTracked("ref2")
+	s.Nil(err)
+	s.True(tracked)
+
+	tracked, err = s.txStore.IsTracked("ref3")
+	s.Nil(err)
+	s.False(tracked)
+}
+
+func (s *TxStoreTestSuite) TestTrack_SameNonceReplacesReferences() {
+	s.Nil(s.txStore.Track(&store.SentTx{Nonce: 5, References: []string{"ref1"}}))
+	s.Nil(s.txStore.Track(&store.SentTx{Nonce: 5, References: []string{"ref2"}}))
+
+	tracked, err := s.txStore.IsTracked("ref1")
+	s.Nil(err)
+	s.False(tracked)
+	tracked, err = s.txStore.IsTracked("ref2")
+	s.Nil(err)
+	s.True(tracked)
+	txs, err := s.txStore.Pending()
+	s.Nil(err)
+	s.Len(txs, 1)
+}
+
+func (s *TxStoreTestSuite) TestRemove_RemovesTxAndReferences() {
+	s.Nil(s.txStore.Track(&store.SentTx{Nonce: 5, References: []string{"ref1"}}))
+	s.Nil(s.txStore.Track(&store.SentTx{Nonce: 6, References: []string{"ref2"}}))
+
+	s.Nil(s.txStore.Remove(5))
+	s.Nil(s.txStore.Remove(7))
+
+	txs, err := s.txStore.Pending()
+	s.Nil(err)
+	s.Len(txs, 1)
+	s.Equal(uint64(6), txs[0].Nonce)
+	tracked, err := s.txStore.IsTracked("ref1")
+	s.Nil(err)
+	s.False(tracked)
+}
+
+func (s *TxStoreTestSuite) TestTrack_SeparatedByDomain() {
+	s.Nil(s.txStore.Track(&store.SentTx{Nonce: 5, References: []string{"ref1"}}))
+
+	otherStore := store.NewTxStore(s.db, 2)
+	txs, err := otherStore.Pending()
+	s.Nil(err)
+	s.Len(txs, 0)
+	tracked, err := otherStore.IsTracked("ref1")
+	s.Nil(err)
+	s.False(tracked)
+}
+
+func (s *TxStoreTestSuite) TestTrack_SeparatedByAccount() {
+	s.Nil(s.txStore.Track(&store.SentTx{Nonce: 5, References: []string{"ref1"}}))
+
+	accountStore := store.NewAccountTxStore(s.db, 1, common.HexToAddress("0x1"))
+	txs, err := accountStore.Pending()
+	s.Nil(err)
+	s.Len(txs, 0)
+	tracked, err := accountStore.IsTracked("ref1")
+	s.Nil(err)
+	s.False(tracked)
+
+	s.Nil(accountStore.Track(&store.SentTx{Nonce: 5, References: []string{"ref2"}}))
+	txs, err = s.txStore.Pending()
+	s.Nil(err)
+	s.Len(txs, 1)
+	s.Equal([]string{"ref1"}, txs[0].References)
+}
+
+func (s *TxStoreTestSuite) TestRestored_SkipsTransactionsSentByThisRun() {
+	s.Nil(s.txStore.Track(&store.SentTx{Nonce: 1, Hashes: []common.Hash{{1}}}))
+	s.Nil(s.txStore.Track(&store.SentTx{Nonce: 2, Hashes: []common.Hash{{2}}}))
+	restarted := store.NewTxStore(s.db, 1)
+	s.Nil(restarted.Track(&store.SentTx{Nonce: 2, Hashes: []common.Hash{{3}}}))
+
+	txs, err := restarted.Restored()
+
+	s.Nil(err)
+	s.Len(txs, 1)
+	s.Equal(uint64(1), txs[0].Nonce)
+}
+
+func (s *TxStoreTestSuite) TestRemoveRestored_KeepsTransactionSentByThisRun() {
+	s.Nil(s.txStore.Track(&store.SentTx{Nonce: 1, Hashes: []common.Hash{{1}}}))
+	restarted := store.NewTxStore(s.db, 1)
+	s.Nil(restarted.Track(&store.SentTx{Nonce: 2, Hashes: []common.Hash{{2}}}))
+
+	s.Nil(restarted.RemoveRestored(1))
+	s.Nil(restarted.RemoveRestored(2))
+
+	txs, err := restarted.Pending()
+	s.Nil(err)
+	s.Len(txs, 1)
+	s.Equal(uint64(2), txs[0].Nonce)
+}
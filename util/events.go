@@ -15,9 +15,15 @@ func (es EventSig) GetTopic() common.Hash {
 }
 
 const (
-	Deposit       EventSig = "Deposit(uint8,bytes32,uint64,address,bytes,bytes)"
-	ProposalEvent EventSig = "ProposalEvent(uint8,uint64,uint8,bytes32)"
-	ProposalVote  EventSig = "ProposalVote(uint8,uint64,uint8,bytes32)"
+	Deposit                 EventSig = "Deposit(uint8,bytes32,uint64,address,bytes,bytes)"
+	ProposalEvent           EventSig = "ProposalEvent(uint8,uint64,uint8,bytes32)"
+	ProposalVote            EventSig = "ProposalVote(uint8,uint64,uint8,bytes32)"
+	RelayerAdded            EventSig = "RelayerAdded(address)"
+	RelayerRemoved          EventSig = "RelayerRemoved(address)"
+	RelayerThresholdChanged EventSig = "RelayerThresholdChanged(uint256)"
+	Paused                  EventSig = "Paused(address)"
+	Unpaused                EventSig = "Unpaused(address)"
+	FailedHandlerExecution  EventSig = "FailedHandlerExecution(bytes)"
 )
 
 type ProposalStatus int